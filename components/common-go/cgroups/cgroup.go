@@ -90,7 +90,8 @@ func ReadFlatKeyedFile(path string) (map[string]uint64, error) {
 }
 
 // Read the total stalled time in microseconds for full and some
-// It is not necessary to read avg10, avg60 and avg300 as these
+// as well as the share of stalled time during the last ten seconds.
+// It is not necessary to read avg60 and avg300 as these
 // are only for convenience. They are calculated as the rate during
 // the desired time frame.
 func ReadPSIValue(path string) (PSI, error) {
@@ -118,12 +119,23 @@ func ReadPSIValue(path string) (PSI, error) {
 			return PSI{}, fmt.Errorf("could not parse total stalled time: %w", err)
 		}
 
+		var avg10 float64
+		if j := strings.Index(line, "avg10="); j != -1 {
+			field, _, _ := strings.Cut(line[j+6:], " ")
+			avg10, err = strconv.ParseFloat(field, 64)
+			if err != nil {
+				return PSI{}, fmt.Errorf("could not parse avg10: %w", err)
+			}
+		}
+
 		if strings.HasPrefix(line, "some") {
 			psi.Some = total
+			psi.SomeAvg10 = avg10
 		}
 
 		if strings.HasPrefix(line, "full") {
 			psi.Full = total
+			psi.FullAvg10 = avg10
 		}
 	}
 
@@ -133,6 +145,9 @@ func ReadPSIValue(path string) (PSI, error) {
 type PSI struct {
 	Some uint64
 	Full uint64
+	// SomeAvg10 and FullAvg10 are the percentages of time stalled during the last ten seconds
+	SomeAvg10 float64
+	FullAvg10 float64
 }
 
 var (
@@ -145,24 +145,28 @@ func TestReadPSI(t *testing.T) {
 			name:    "psi some",
 			content: "some avg10=61.00 avg60=64.28 avg300=29.94 total=149969752",
 			expected: PSI{
-				Some: 149969752,
-				Full: 0,
+				Some:      149969752,
+				Full:      0,
+				SomeAvg10: 61,
 			},
 		},
 		{
 			name:    "psi full",
 			content: "full avg10=36.27 avg60=37.15 avg300=17.59 total=93027571",
 			expected: PSI{
-				Some: 0,
-				Full: 93027571,
+				Some:      0,
+				Full:      93027571,
+				FullAvg10: 36.27,
 			},
 		},
 		{
 			name:    "psi some and full",
 			content: "some avg10=61.00 avg60=64.28 avg300=29.94 total=149969752\nfull avg10=36.27 avg60=37.15 avg300=17.59 total=93027571",
 			expected: PSI{
-				Some: 149969752,
-				Full: 93027571,
+				Some:      149969752,
+				Full:      93027571,
+				SomeAvg10: 61,
+				FullAvg10: 36.27,
 			},
 		},
 	}
@@ -39,6 +39,9 @@ const (
 	// TypeLabel marks the workspace type
 	TypeLabel = "workspaceType"
 
+	// WorkspaceClassLabel is the label for the workspace's class
+	WorkspaceClassLabel = "gitpod.io/workspaceClass"
+
 	// ServiceTypeLabel help differentiate between port service and IDE service
 	ServiceTypeLabel = "serviceType"
 
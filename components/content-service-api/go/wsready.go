@@ -38,6 +38,9 @@ type InitializerMetric struct {
 
 	// Size of the data that was initialized in bytes
 	Size uint64 `json:"size"`
+
+	// BytesFetched is the amount of data fetched from a remote, e.g. a Git repository
+	BytesFetched uint64 `json:"bytesFetched,omitempty"`
 }
 
 type InitializerMetrics []InitializerMetric
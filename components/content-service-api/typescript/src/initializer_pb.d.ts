@@ -195,6 +195,22 @@ export class GitInitializer extends jspb.Message {
     setConfig(value?: GitConfig): GitInitializer;
     getFullClone(): boolean;
     setFullClone(value: boolean): GitInitializer;
+    clearSparseCheckoutPatternsList(): void;
+    getSparseCheckoutPatternsList(): Array<string>;
+    setSparseCheckoutPatternsList(value: Array<string>): GitInitializer;
+    addSparseCheckoutPatterns(value: string, index?: number): string;
+    getFilter(): string;
+    setFilter(value: string): GitInitializer;
+    clearLfsIncludeList(): void;
+    getLfsIncludeList(): Array<string>;
+    setLfsIncludeList(value: Array<string>): GitInitializer;
+    addLfsInclude(value: string, index?: number): string;
+    clearLfsExcludeList(): void;
+    getLfsExcludeList(): Array<string>;
+    setLfsExcludeList(value: Array<string>): GitInitializer;
+    addLfsExclude(value: string, index?: number): string;
+    getLfsSkipFetch(): boolean;
+    setLfsSkipFetch(value: boolean): GitInitializer;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitInitializer.AsObject;
@@ -215,6 +231,11 @@ export namespace GitInitializer {
         checkoutLocation: string,
         config?: GitConfig.AsObject,
         fullClone: boolean,
+        sparseCheckoutPatternsList: Array<string>,
+        filter: string,
+        lfsIncludeList: Array<string>,
+        lfsExcludeList: Array<string>,
+        lfsSkipFetch: boolean,
     }
 }
 
@@ -151,7 +151,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.contentservice.GitInitializer = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitInitializer.repeatedFields_, null);
 };
 goog.inherits(proto.contentservice.GitInitializer, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -1398,6 +1398,15 @@ proto.contentservice.EmptyInitializer.serializeBinaryToWriter = function(message
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitInitializer.repeatedFields_ = [8,10,11];
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1433,7 +1442,12 @@ proto.contentservice.GitInitializer.toObject = function(includeInstance, msg) {
     cloneTaget: jspb.Message.getFieldWithDefault(msg, 4, ""),
     checkoutLocation: jspb.Message.getFieldWithDefault(msg, 5, ""),
     config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f),
-    fullClone: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    fullClone: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    sparseCheckoutPatternsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    filter: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    lfsIncludeList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
+    lfsExcludeList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+    lfsSkipFetch: jspb.Message.getBooleanFieldWithDefault(msg, 12, false)
   };
 
   if (includeInstance) {
@@ -1499,6 +1513,26 @@ proto.contentservice.GitInitializer.deserializeBinaryFromReader = function(msg,
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setFullClone(value);
       break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSparseCheckoutPatterns(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilter(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsInclude(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsExclude(value);
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLfsSkipFetch(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1578,6 +1612,41 @@ proto.contentservice.GitInitializer.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getSparseCheckoutPatternsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getFilter();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getLfsIncludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+  f = message.getLfsExcludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      11,
+      f
+    );
+  }
+  f = message.getLfsSkipFetch();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
 };
 
 
@@ -1726,6 +1795,153 @@ proto.contentservice.GitInitializer.prototype.setFullClone = function(value) {
 };
 
 
+/**
+ * repeated string sparse_checkout_patterns = 8;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getSparseCheckoutPatternsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setSparseCheckoutPatternsList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addSparseCheckoutPatterns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearSparseCheckoutPatternsList = function() {
+  return this.setSparseCheckoutPatternsList([]);
+};
+
+
+/**
+ * optional string filter = 9;
+ * @return {string}
+ */
+proto.contentservice.GitInitializer.prototype.getFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setFilter = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * repeated string lfs_include = 10;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsIncludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsIncludeList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addLfsInclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearLfsIncludeList = function() {
+  return this.setLfsIncludeList([]);
+};
+
+
+/**
+ * repeated string lfs_exclude = 11;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsExcludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsExcludeList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addLfsExclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearLfsExcludeList = function() {
+  return this.setLfsExcludeList([]);
+};
+
+
+/**
+ * optional bool lfs_skip_fetch = 12;
+ * @return {boolean}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsSkipFetch = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsSkipFetch = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
+};
+
+
 
 
 
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunks
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// MinChunkSize is the size below which a stream is never cut, except at its end
+	MinChunkSize = 256 * 1024
+	// MaxChunkSize is the size at which a stream is always cut
+	MaxChunkSize = 4 * 1024 * 1024
+
+	// chunkBits determines the average chunk size: a cut happens with a probability of 2^-chunkBits
+	// after MinChunkSize, i.e. chunks are about 1.25 MiB on average.
+	chunkBits = 20
+	// chunkMask selects the upper bits of the hash which depend on the last 64 bytes
+	chunkMask = (uint64(1)<<chunkBits - 1) << (64 - chunkBits)
+)
+
+// gear maps every byte to a pseudo-random value. The table must never change as this would change all chunk boundaries.
+var gear [256]uint64
+
+func init() {
+	for i := range gear {
+		sum := sha256.Sum256([]byte{byte(i)})
+		gear[i] = binary.BigEndian.Uint64(sum[:8])
+	}
+}
+
+// Chunker splits a stream into content-defined chunks using a gear-based rolling hash.
+// Because chunk boundaries depend on the content rather than offsets, changing parts of a
+// stream only affects the chunks around the change and unchanged content produces the same chunks.
+type Chunker struct {
+	r   io.Reader
+	buf []byte
+	n   int
+	cut int
+	eof bool
+}
+
+// NewChunker creates a chunker reading from r
+func NewChunker(r io.Reader) *Chunker {
+	return &Chunker{
+		r:   r,
+		buf: make([]byte, MaxChunkSize),
+	}
+}
+
+// Next returns the next chunk of the stream or io.EOF once the stream is exhausted.
+// The chunk is only valid until the next call to Next.
+func (c *Chunker) Next() ([]byte, error) {
+	c.n = copy(c.buf, c.buf[c.cut:c.n])
+	c.cut = 0
+
+	if !c.eof {
+		n, err := io.ReadFull(c.r, c.buf[c.n:])
+		c.n += n
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			c.eof = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if c.n == 0 {
+		return nil, io.EOF
+	}
+
+	c.cut = cutPoint(c.buf[:c.n])
+	return c.buf[:c.cut], nil
+}
+
+// cutPoint returns the length of the chunk at the beginning of data
+func cutPoint(data []byte) int {
+	if len(data) <= MinChunkSize {
+		return len(data)
+	}
+
+	var h uint64
+	for i := MinChunkSize; i < len(data); i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&chunkMask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,314 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunks
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+func TestChunker(t *testing.T) {
+	data := randomBytes(1, 20*1024*1024)
+
+	// inserting data must only change the chunks around the insertion
+	modified := make([]byte, 0, len(data)+100)
+	modified = append(modified, data[:10*1024*1024]...)
+	modified = append(modified, []byte(strings.Repeat("gitpod", 16))...)
+	modified = append(modified, data[10*1024*1024:]...)
+
+	tests := []struct {
+		Name string
+		Data []byte
+	}{
+		{Name: "empty", Data: nil},
+		{Name: "smaller than min chunk size", Data: data[:1024]},
+		{Name: "random", Data: data},
+		{Name: "modified", Data: modified},
+		{Name: "zeros", Data: make([]byte, 10*1024*1024)},
+	}
+
+	chunkSets := make(map[string]map[digest.Digest]struct{})
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				chunker = NewChunker(bytes.NewReader(test.Data))
+				res     []byte
+				set     = make(map[digest.Digest]struct{})
+			)
+			for {
+				chunk, err := chunker.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(chunk) == 0 || len(chunk) > MaxChunkSize {
+					t.Errorf("chunk size %d out of bounds", len(chunk))
+				}
+				res = append(res, chunk...)
+				set[digest.FromBytes(chunk)] = struct{}{}
+			}
+			if !bytes.Equal(test.Data, res) {
+				t.Errorf("chunks do not add up to the original data")
+			}
+			chunkSets[test.Name] = set
+		})
+	}
+
+	var changed int
+	for dgst := range chunkSets["modified"] {
+		if _, ok := chunkSets["random"][dgst]; !ok {
+			changed++
+		}
+	}
+	if changed == 0 || changed > 2 {
+		t.Errorf("expected the insertion to change one or two chunks, got %d of %d", changed, len(chunkSets["modified"]))
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	dgst := digest.FromString("foo")
+	tests := []struct {
+		Name  string
+		Input string
+		Error string
+	}{
+		{
+			Name:  "valid",
+			Input: `{"version":1,"size":3,"chunks":[{"digest":"` + dgst.String() + `","size":3}]}`,
+		},
+		{
+			Name:  "unknown version",
+			Input: `{"version":2,"size":0,"chunks":[]}`,
+			Error: "unsupported chunk manifest version 2",
+		},
+		{
+			Name:  "invalid digest",
+			Input: `{"version":1,"size":3,"chunks":[{"digest":"sha256:foo","size":3}]}`,
+			Error: "invalid chunk digest sha256:foo",
+		},
+		{
+			Name:  "size mismatch",
+			Input: `{"version":1,"size":4,"chunks":[{"digest":"` + dgst.String() + `","size":3}]}`,
+			Error: "chunks add up to 3 bytes, but the manifest expects 4 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := ReadManifest(strings.NewReader(test.Input))
+			var act string
+			if err != nil {
+				act = err.Error()
+			}
+			if test.Error == "" && act != "" || !strings.HasPrefix(act, test.Error) {
+				t.Errorf("unexpected error: want %q, got %q", test.Error, act)
+			}
+		})
+	}
+}
+
+func TestUploadRestore(t *testing.T) {
+	ctx := context.Background()
+	rs := &memoryStorage{objects: map[string][]byte{
+		"workspace/" + storage.DefaultBackup: []byte("legacy backup"),
+	}}
+
+	files := map[string][]byte{
+		"node_modules/big.js": randomBytes(2, 6*1024*1024),
+		"src/main.go":         []byte("package main"),
+	}
+	stats := uploadTarball(t, rs, files)
+	if stats.UploadedChunks != stats.Chunks || stats.UploadedSize != stats.Size {
+		t.Errorf("expected all chunks to be uploaded on the first backup: %+v", stats)
+	}
+	if _, ok := rs.objects["workspace/"+storage.DefaultBackup]; ok {
+		t.Errorf("expected the previous backup to be removed")
+	}
+
+	files["src/main.go"] = []byte("package main\n\nfunc main() {}")
+	stats = uploadTarball(t, rs, files)
+	if stats.UploadedChunks == 0 || stats.UploadedSize >= stats.Size/2 {
+		t.Errorf("expected only the changed chunks to be uploaded: %+v", stats)
+	}
+
+	var chunks []string
+	for name := range rs.objects {
+		if strings.HasPrefix(name, "workspace/"+ObjectPrefix) {
+			chunks = append(chunks, name)
+		}
+	}
+	if len(chunks) != stats.Chunks {
+		t.Errorf("expected unreferenced chunks to be removed: have %d chunks, manifest references %d", len(chunks), stats.Chunks)
+	}
+
+	dst := t.TempDir()
+	found, err := Restore(ctx, dst, rs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("backup not found")
+	}
+	for name, content := range files {
+		act, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(content, act) {
+			t.Errorf("unexpected content of %s", name)
+		}
+	}
+
+	manifest, tarball, err := Open(ctx, rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := io.Copy(io.Discard, tarball)
+	tarball.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != manifest.Size {
+		t.Errorf("expected a tarball of %d bytes, got %d", manifest.Size, n)
+	}
+
+	err = Remove(ctx, rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{}, rs.names()); diff != "" {
+		t.Errorf("unexpected objects after removal (-want +got):\n%s", diff)
+	}
+	found, err = Restore(ctx, t.TempDir(), rs, nil)
+	if err != nil || found {
+		t.Errorf("expected no backup, got found=%v, err=%v", found, err)
+	}
+	_, _, err = Open(ctx, rs)
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func uploadTarball(t *testing.T, rs storage.DirectAccess, files map[string][]byte) *UploadStats {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range names {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tw.Write(files[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := tw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tarball := filepath.Join(t.TempDir(), "backup.tar")
+	err = os.WriteFile(tarball, buf.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := Upload(context.Background(), rs, tarball, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stats
+}
+
+func randomBytes(seed int64, size int) []byte {
+	res := make([]byte, size)
+	_, _ = rand.New(rand.NewSource(seed)).Read(res)
+	return res
+}
+
+// memoryStorage keeps the objects of a single workspace in memory
+type memoryStorage struct {
+	storage.DirectNoopStorage
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (rs *memoryStorage) BackupObject(name string) string {
+	return "workspace/" + name
+}
+
+func (rs *memoryStorage) Upload(ctx context.Context, source string, name string, opts ...storage.UploadOption) (string, string, error) {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return "", "", err
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.objects[rs.BackupObject(name)] = data
+	return "", rs.BackupObject(name), nil
+}
+
+func (rs *memoryStorage) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	var res []string
+	for _, name := range rs.names() {
+		if strings.HasPrefix(name, prefix) {
+			res = append(res, name)
+		}
+	}
+	return res, nil
+}
+
+func (rs *memoryStorage) DeleteObjects(ctx context.Context, names ...string) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for _, name := range names {
+		delete(rs.objects, name)
+	}
+	return nil
+}
+
+func (rs *memoryStorage) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	data, ok := rs.objects[rs.BackupObject(name)]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (rs *memoryStorage) names() []string {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	res := make([]string, 0, len(rs.objects))
+	for name := range rs.objects {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package chunks implements workspace backups which are stored as content-addressed chunks.
+//
+// The backup tarball is split into content-defined chunks which are stored under their digest.
+// A manifest lists the chunks in order, concatenating them yields the tarball again. Chunks which
+// exist in the remote storage already, e.g. because they belong to files which did not change
+// since the last backup, are not uploaded again.
+package chunks
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// ManifestVersion is the version of the manifest format we produce
+	ManifestVersion = 1
+
+	// ObjectPrefix is the prefix of the chunk object names
+	ObjectPrefix = "chunks/"
+)
+
+// Manifest describes a backup which is stored as chunks
+type Manifest struct {
+	Version int `json:"version"`
+	// Size is the size of the backup tarball in bytes
+	Size   int64   `json:"size"`
+	Chunks []Chunk `json:"chunks"`
+}
+
+// Chunk is a part of the backup tarball
+type Chunk struct {
+	Digest digest.Digest `json:"digest"`
+	Size   int64         `json:"size"`
+}
+
+// ObjectName returns the name of the remote storage object holding the chunk with the given digest
+func ObjectName(dgst digest.Digest) string {
+	return ObjectPrefix + dgst.Encoded()
+}
+
+// Supersedes returns true if the chunked backup with the given manifest is at least as recent as the regular
+// backup. Switching between chunked and regular backups removes the other kind only after the upload, which
+// can fail and leave a stale backup behind. If the storage does not report modification times, the chunked
+// backup wins.
+func Supersedes(manifest, backup storage.ObjectMeta) bool {
+	if manifest.LastModified.IsZero() || backup.LastModified.IsZero() {
+		return true
+	}
+	return !backup.LastModified.After(manifest.LastModified)
+}
+
+// ReadManifest reads and validates a manifest
+func ReadManifest(r io.Reader) (*Manifest, error) {
+	var m Manifest
+	err := json.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal chunk manifest: %w", err)
+	}
+	if m.Version != ManifestVersion {
+		return nil, xerrors.Errorf("unsupported chunk manifest version %d", m.Version)
+	}
+
+	var size int64
+	for _, c := range m.Chunks {
+		err = c.Digest.Validate()
+		if err != nil {
+			return nil, xerrors.Errorf("invalid chunk digest %s: %w", c.Digest, err)
+		}
+		if c.Size <= 0 || c.Size > MaxChunkSize {
+			return nil, xerrors.Errorf("invalid size %d of chunk %s", c.Size, c.Digest)
+		}
+		size += c.Size
+	}
+	if size != m.Size {
+		return nil, xerrors.Errorf("chunks add up to %d bytes, but the manifest expects %d bytes", size, m.Size)
+	}
+
+	return &m, nil
+}
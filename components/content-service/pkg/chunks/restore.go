@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunks
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// restoreConcurrency is the number of chunks downloaded in parallel. As chunks are held in memory
+	// until they are extracted, this also bounds the memory used during restore.
+	restoreConcurrency = 8
+
+	// restoreAttempts is the number of times we try to download a chunk
+	restoreAttempts = 3
+)
+
+// Restore downloads the chunks of a chunked backup in parallel and extracts the tarball they form to destination.
+// Returns false if there is no chunked backup.
+func Restore(ctx context.Context, destination string, rs storage.ObjectReader, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chunks.Restore")
+	defer tracing.FinishSpan(span, &err)
+
+	manifest, tarball, err := Open(ctx, rs)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return true, err
+	}
+	defer tarball.Close()
+	span.SetTag("chunks", len(manifest.Chunks))
+	span.SetTag("size", manifest.Size)
+
+	err = archive.ExtractTarbal(ctx, tarball, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	if err != nil {
+		return true, xerrors.Errorf("tar %s: %w", destination, err)
+	}
+
+	return true, nil
+}
+
+// Open returns the manifest of a chunked backup and the tarball its chunks form. The chunks are downloaded
+// in parallel while the tarball is read. Returns storage.ErrNotFound if there is no chunked backup.
+func Open(ctx context.Context, rs storage.ObjectReader) (manifest *Manifest, tarball io.ReadCloser, err error) {
+	rc, err := rs.ReadObject(ctx, storage.DefaultBackupChunkManifest)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot download chunk manifest: %w", err)
+	}
+	manifest, err = ReadManifest(rc)
+	rc.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeChunks(ctx, pw, rs, manifest.Chunks))
+	}()
+
+	return manifest, &tarballReader{PipeReader: pr, cancel: cancel}, nil
+}
+
+// tarballReader stops downloading chunks once it is closed
+type tarballReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *tarballReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// writeChunks downloads the chunks in parallel and writes them to w in order.
+func writeChunks(ctx context.Context, w io.Writer, rs storage.ObjectReader, chunks []Chunk) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	// pending holds the downloads in order, its capacity limits the downloads in flight
+	pending := make(chan chan result, restoreConcurrency)
+	go func() {
+		defer close(pending)
+		for _, c := range chunks {
+			res := make(chan result, 1)
+			select {
+			case pending <- res:
+			case <-ctx.Done():
+				return
+			}
+			go func(c Chunk) {
+				data, err := downloadChunk(ctx, rs, c)
+				res <- result{data, err}
+			}(c)
+		}
+	}()
+
+	for res := range pending {
+		r := <-res
+		if r.err != nil {
+			return r.err
+		}
+		_, err := w.Write(r.data)
+		if err != nil {
+			return err
+		}
+	}
+	// the producer stops early if the context is cancelled
+	return ctx.Err()
+}
+
+func downloadChunk(ctx context.Context, rs storage.ObjectReader, c Chunk) (data []byte, err error) {
+	for i := 0; i < restoreAttempts; i++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		data, err = readChunk(ctx, rs, c)
+		if err == nil || errors.Is(err, storage.ErrNotFound) {
+			return data, err
+		}
+		log.WithError(err).WithField("chunk", c.Digest).WithField("attempt", i+1).Warn("cannot download chunk")
+	}
+	return nil, err
+}
+
+func readChunk(ctx context.Context, rs storage.ObjectReader, c Chunk) ([]byte, error) {
+	rc, err := rs.ReadObject(ctx, ObjectName(c.Digest))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download chunk %s: %w", c.Digest, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, c.Size+1))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download chunk %s: %w", c.Digest, err)
+	}
+	if int64(len(data)) != c.Size {
+		return nil, xerrors.Errorf("chunk %s has %d bytes, expected %d", c.Digest, len(data), c.Size)
+	}
+	if dgst := digest.FromBytes(data); dgst != c.Digest {
+		return nil, xerrors.Errorf("chunk %s has unexpected digest %s", c.Digest, dgst)
+	}
+	return data, nil
+}
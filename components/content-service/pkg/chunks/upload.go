@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package chunks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+// uploadConcurrency is the number of chunks uploaded in parallel
+const uploadConcurrency = 8
+
+// UploadStats describes a chunked backup upload
+type UploadStats struct {
+	// Chunks is the number of chunks the backup consists of
+	Chunks int
+	// UploadedChunks is the number of chunks which had to be uploaded
+	UploadedChunks int
+	// Size is the size of the backup in bytes
+	Size int64
+	// UploadedSize is the number of bytes which had to be uploaded
+	UploadedSize int64
+}
+
+// Upload splits the tarball into chunks and uploads all chunks which are not present in the remote storage yet,
+// followed by the manifest referencing them. Once the manifest is uploaded, chunks which are no longer referenced
+// and a previous backup which was not chunked are removed, as is the tarball assembled from the previous chunks. Temporary files are created in tmpdir.
+func Upload(ctx context.Context, rs storage.DirectAccess, tarball string, tmpdir string) (stats *UploadStats, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chunks.Upload")
+	defer tracing.FinishSpan(span, &err)
+
+	existing, err := listChunks(ctx, rs)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(tarball)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		manifest   = Manifest{Version: ManifestVersion}
+		referenced = make(map[string]struct{})
+		chunker    = NewChunker(f)
+	)
+	stats = &UploadStats{}
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(uploadConcurrency)
+	for egctx.Err() == nil {
+		data, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = eg.Wait()
+			return nil, xerrors.Errorf("cannot read %s: %w", tarball, err)
+		}
+
+		chunk := Chunk{Digest: digest.FromBytes(data), Size: int64(len(data))}
+		manifest.Chunks = append(manifest.Chunks, chunk)
+		manifest.Size += chunk.Size
+
+		obj := rs.BackupObject(ObjectName(chunk.Digest))
+		if _, seen := referenced[obj]; seen {
+			continue
+		}
+		referenced[obj] = struct{}{}
+		if _, exists := existing[obj]; exists {
+			continue
+		}
+		stats.UploadedChunks++
+		stats.UploadedSize += chunk.Size
+
+		// the chunker reuses its buffer, hence we write the chunk out before uploading it in the background
+		tmpf, err := writeTempFile(tmpdir, "chunk-*", data)
+		if err != nil {
+			_ = eg.Wait()
+			return nil, err
+		}
+		eg.Go(func() error {
+			defer os.Remove(tmpf)
+			_, _, err := rs.Upload(egctx, tmpf, ObjectName(chunk.Digest))
+			if err != nil {
+				return xerrors.Errorf("cannot upload chunk %s: %w", chunk.Digest, err)
+			}
+			return nil
+		})
+	}
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	stats.Chunks = len(manifest.Chunks)
+	stats.Size = manifest.Size
+	span.SetTag("chunks", stats.Chunks)
+	span.SetTag("uploadedChunks", stats.UploadedChunks)
+
+	mf, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	tmpf, err := writeTempFile(tmpdir, "chunk-manifest-*.json", mf)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpf)
+	_, _, err = rs.Upload(ctx, tmpf, storage.DefaultBackupChunkManifest, storage.WithContentType("application/json"))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot upload chunk manifest: %w", err)
+	}
+
+	// The new backup is complete - failing to clean up the previous one costs storage, but is no reason to fail.
+	var stale []string
+	for obj := range existing {
+		if _, ok := referenced[obj]; !ok {
+			stale = append(stale, obj)
+		}
+	}
+	stale = append(stale, rs.BackupObject(storage.DefaultBackup), rs.BackupObject(storage.DefaultBackupAssembled))
+	err = rs.DeleteObjects(ctx, stale...)
+	if err != nil {
+		log.WithError(err).WithField("objects", len(stale)).Warn("cannot remove stale backup objects")
+	}
+
+	return stats, nil
+}
+
+// Remove deletes a chunked backup, i.e. its manifest, all chunks and the tarball assembled from them.
+func Remove(ctx context.Context, rs storage.DirectAccess) error {
+	existing, err := listChunks(ctx, rs)
+	if err != nil {
+		return err
+	}
+
+	objs := []string{rs.BackupObject(storage.DefaultBackupChunkManifest), rs.BackupObject(storage.DefaultBackupAssembled)}
+	for obj := range existing {
+		objs = append(objs, obj)
+	}
+	return rs.DeleteObjects(ctx, objs...)
+}
+
+func listChunks(ctx context.Context, rs storage.DirectAccess) (map[string]struct{}, error) {
+	objs, err := rs.ListObjects(ctx, rs.BackupObject(ObjectPrefix))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list chunks: %w", err)
+	}
+
+	res := make(map[string]struct{}, len(objs))
+	for _, obj := range objs {
+		res[obj] = struct{}{}
+	}
+	return res, nil
+}
+
+func writeTempFile(dir, pattern string, data []byte) (string, error) {
+	f, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	err = f.Close()
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
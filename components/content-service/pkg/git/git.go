@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -99,6 +100,15 @@ type Client struct {
 
 	// FullClone indicates whether we should do a full checkout or a shallow clone
 	FullClone bool
+
+	// SparseCheckoutPatterns restricts the working copy to the given paths. The working copy is complete if empty.
+	SparseCheckoutPatterns []string
+
+	// Filter is the partial clone filter, e.g. blob:none or tree:0
+	Filter string
+
+	// SkipLFSSmudge leaves Git LFS pointer files in place during checkout. Use FetchLFS to fetch the objects afterwards.
+	SkipLFSSmudge bool
 }
 
 // Status describes the status of a Git repo/working copy akin to "git status"
@@ -155,6 +165,11 @@ func (e OpFailedError) Error() string {
 // GitWithOutput starts git and returns the stdout of the process. This function returns once git is started,
 // not after it finishd. Once the returned reader returned io.EOF, the command is finished.
 func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcommand string, args ...string) (out []byte, err error) {
+	return c.gitWithOutput(ctx, ignoreErr, nil, subcommand, args...)
+}
+
+// gitWithOutput runs git with the configuration values (name=value) passed as -c before the subcommand
+func (c *Client) gitWithOutput(ctx context.Context, ignoreErr *string, config []string, subcommand string, args ...string) (out []byte, err error) {
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("git.%s", subcommand))
 	defer func() {
@@ -183,7 +198,13 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 	}
 
 	env = append(env, "HOME=/home/gitpod")
+	if c.SkipLFSSmudge {
+		env = append(env, "GIT_LFS_SKIP_SMUDGE=1")
+	}
 
+	for _, cfg := range config {
+		fullArgs = append(fullArgs, "-c", cfg)
+	}
 	fullArgs = append(fullArgs, subcommand)
 	fullArgs = append(fullArgs, args...)
 
@@ -239,6 +260,13 @@ func (c *Client) Git(ctx context.Context, subcommand string, args ...string) (er
 	return nil
 }
 
+// GitWithConfig executes git using the client configuration and the configuration values (name=value)
+// which only apply to this invocation, e.g. lfs.concurrenttransfers=8
+func (c *Client) GitWithConfig(ctx context.Context, config []string, subcommand string, args ...string) (err error) {
+	_, err = c.gitWithOutput(ctx, nil, config, subcommand, args...)
+	return err
+}
+
 // GitStatusFromFiles same as Status but reads git output from preexisting files that were generated by prestop hook
 func GitStatusFromFiles(ctx context.Context, loc string) (res *Status, err error) {
 	gitout, err := os.ReadFile(filepath.Join(loc, "git_status.txt"))
@@ -368,7 +396,7 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 	now := time.Now()
 
 	defer func() {
-		log.WithField("duration", time.Since(now).String()).WithField("FullClone", c.FullClone).Info("clone repository took")
+		log.WithField("duration", time.Since(now).String()).WithField("FullClone", c.FullClone).WithField("Filter", c.Filter).WithField("sparse", len(c.SparseCheckoutPatterns) > 0).Info("clone repository took")
 	}()
 
 	args := []string{"--depth=1", "--shallow-submodules", c.RemoteURI}
@@ -377,6 +405,16 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 		args = []string{c.RemoteURI}
 	}
 
+	if c.Filter != "" {
+		args = append(args, "--filter="+c.Filter)
+	}
+
+	// the clone target is checked out once the sparse checkout is configured,
+	// so that files outside of the sparse checkout are never fetched or written
+	if len(c.SparseCheckoutPatterns) > 0 {
+		args = append(args, "--no-checkout")
+	}
+
 	for key, value := range c.Config {
 		args = append(args, "--config")
 		args = append(args, strings.TrimSpace(key)+"="+strings.TrimSpace(value))
@@ -390,7 +428,71 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 
 	args = append(args, ".")
 
-	return c.Git(ctx, "clone", args...)
+	err = c.Git(ctx, "clone", args...)
+	if err != nil {
+		return err
+	}
+
+	if len(c.SparseCheckoutPatterns) > 0 {
+		err = c.Git(ctx, "sparse-checkout", sparseCheckoutArgs(c.SparseCheckoutPatterns)...)
+		if err != nil {
+			return xerrors.Errorf("cannot configure sparse checkout: %w", err)
+		}
+	}
+	return nil
+}
+
+// sparseCheckoutArgs produces the arguments of `git sparse-checkout` for the given patterns.
+// Patterns without wildcards are directories and use the faster cone mode.
+// As soon as one pattern uses wildcards or negation all patterns are interpreted like .gitignore entries.
+func sparseCheckoutArgs(patterns []string) []string {
+	cone := true
+	for _, p := range patterns {
+		if strings.ContainsAny(p, "*?[!\\") {
+			cone = false
+			break
+		}
+	}
+
+	args := []string{"set", "--no-cone"}
+	if cone {
+		args = []string{"set", "--cone"}
+	}
+	args = append(args, "--")
+	for _, p := range patterns {
+		if cone {
+			p = strings.Trim(p, "/")
+		}
+		args = append(args, p)
+	}
+	return args
+}
+
+// ObjectDatabaseSize returns the size of the objects in the repository in bytes.
+// For a fresh clone this is the amount of data fetched from the remote.
+func (c *Client) ObjectDatabaseSize(ctx context.Context) (uint64, error) {
+	out, err := c.GitWithOutput(ctx, nil, "count-objects", "-v")
+	if err != nil {
+		return 0, err
+	}
+
+	var size uint64
+	for _, l := range strings.Split(string(out), "\n") {
+		key, value, ok := strings.Cut(l, ":")
+		if !ok {
+			continue
+		}
+		// sizes are reported in KiB
+		if key != "size" && key != "size-pack" {
+			continue
+		}
+		kib, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse git count-objects output %q: %w", l, err)
+		}
+		size += kib * 1024
+	}
+	return size, nil
 }
 
 // UpdateRemote performs a git fetch on the upstream remote URI
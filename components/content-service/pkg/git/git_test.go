@@ -479,6 +479,123 @@ func TestGitStatusFromFiles(t *testing.T) {
 	}
 }
 
+func TestSparseCheckoutArgs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Patterns []string
+		Expected []string
+	}{
+		{
+			Name:     "directories",
+			Patterns: []string{"components/server", "/components/dashboard/"},
+			Expected: []string{"set", "--cone", "--", "components/server", "components/dashboard"},
+		},
+		{
+			Name:     "wildcards",
+			Patterns: []string{"components/server", "*.md"},
+			Expected: []string{"set", "--no-cone", "--", "components/server", "*.md"},
+		},
+		{
+			Name:     "negation",
+			Patterns: []string{"/*", "!/dev/"},
+			Expected: []string{"set", "--no-cone", "--", "/*", "!/dev/"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := sparseCheckoutArgs(test.Patterns)
+			if diff := cmp.Diff(test.Expected, act); diff != "" {
+				t.Errorf("unexpected args (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloneSparseCheckout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	remote, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(remote.Location)
+	files := []string{"README.md", "backend/main.go", "frontend/index.ts", "frontend/app/app.ts"}
+	for _, f := range files {
+		fn := filepath.Join(remote.Location, f)
+		if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fn, []byte(f), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{
+		{"init", "--initial-branch=main"},
+		{"config", "--local", "uploadpack.allowFilter", "true"},
+		{"add", "."},
+		{"-c", "user.email=foo@bar.com", "-c", "user.name=foo bar", "commit", "-m", "foo"},
+	} {
+		if err := remote.Git(ctx, args[0], args[1:]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(client.Location)
+	// partial clones require the file protocol, local clones ignore the filter
+	client.RemoteURI = "file://" + remote.Location
+	client.Filter = "blob:none"
+	client.SparseCheckoutPatterns = []string{"frontend"}
+	if err := client.Clone(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Git(ctx, "reset", "--hard", "origin/HEAD"); err != nil {
+		t.Fatal(err)
+	}
+
+	var act []string
+	err = filepath.WalkDir(client.Location, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() {
+			rel, _ := filepath.Rel(client.Location, path)
+			act = append(act, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"README.md", "frontend/app/app.ts", "frontend/index.ts"}, act); diff != "" {
+		t.Errorf("unexpected working copy (-want +got):\n%s", diff)
+	}
+
+	out, err := client.GitWithOutput(ctx, nil, "config", "remote.origin.partialclonefilter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter := strings.TrimSpace(string(out)); filter != "blob:none" {
+		t.Errorf("unexpected partial clone filter: %q", filter)
+	}
+
+	size, err := client.ObjectDatabaseSize(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size == 0 {
+		t.Errorf("expected fetched objects to be accounted for")
+	}
+}
+
 func newGitClient(ctx context.Context) (*Client, error) {
 	loc, err := os.MkdirTemp("", "gittest")
 	if err != nil {
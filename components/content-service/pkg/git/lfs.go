@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+)
+
+const (
+	// lfsConcurrentTransfers is the number of LFS objects fetched in parallel
+	lfsConcurrentTransfers = 16
+
+	// lfsProgressInterval is the interval in which the LFS fetch progress is logged
+	lfsProgressInterval = 5 * time.Second
+)
+
+// ErrLFSNotInstalled is returned when a repository uses Git LFS but git-lfs is not available
+var ErrLFSNotInstalled = xerrors.New("repository uses Git LFS but git-lfs is not installed")
+
+// LFSFiles returns the paths of all files in the index which are tracked by Git LFS.
+// This does not require git-lfs to be installed as it only looks at the filter attribute.
+func (c *Client) LFSFiles(ctx context.Context) ([]string, error) {
+	out, err := c.GitWithOutput(ctx, nil, "ls-files", "-z", "--", ":(attr:filter=lfs)")
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, f := range bytes.Split(out, []byte{0}) {
+		if len(f) == 0 {
+			continue
+		}
+		res = append(res, string(f))
+	}
+	return res, nil
+}
+
+// FetchLFS downloads the Git LFS objects of the checked out revision and replaces the pointer files in the working copy.
+// Credentials are passed to git-lfs through the credential helper configured by the AuthProvider.
+// FetchLFS returns the number of bytes fetched.
+func (c *Client) FetchLFS(ctx context.Context, include, exclude []string) (fetched uint64, err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "fetchLFS")
+	defer tracing.FinishSpan(span, &err)
+
+	files, err := c.LFSFiles(ctx)
+	if err != nil {
+		return 0, err
+	}
+	span.SetTag("lfsFiles", len(files))
+	if len(files) == 0 {
+		return 0, nil
+	}
+
+	if err := c.Git(ctx, "lfs", "version"); err != nil {
+		log.WithError(err).WithField("location", c.Location).Debug("git-lfs is not available")
+		return 0, ErrLFSNotInstalled
+	}
+
+	objects := filepath.Join(c.Location, ".git", "lfs", "objects")
+	initialSize := dirSize(objects)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		t := time.NewTicker(lfsProgressInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				log.WithField("location", c.Location).WithField("bytesFetched", dirSize(objects)-initialSize).Info("fetching Git LFS objects")
+			}
+		}
+	}()
+
+	err = c.GitWithConfig(ctx, []string{"lfs.concurrenttransfers=" + strconv.Itoa(lfsConcurrentTransfers)}, "lfs", lfsPullArgs(include, exclude)...)
+	if err != nil {
+		return 0, err
+	}
+
+	fetched = dirSize(objects) - initialSize
+	span.SetTag("bytesFetched", fetched)
+	return fetched, nil
+}
+
+// lfsPullArgs produces the arguments for git lfs pull.
+func lfsPullArgs(include, exclude []string) []string {
+	args := []string{"pull"}
+	if len(include) > 0 {
+		args = append(args, "--include="+strings.Join(include, ","))
+	}
+	if len(exclude) > 0 {
+		args = append(args, "--exclude="+strings.Join(exclude, ","))
+	}
+	return args
+}
+
+// dirSize returns the size of all regular files below dir, or zero if dir does not exist.
+func dirSize(dir string) uint64 {
+	var size uint64
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	return size
+}
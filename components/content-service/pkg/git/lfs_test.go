@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package git
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLFSPullArgs(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Include     []string
+		Exclude     []string
+		Expectation []string
+	}{
+		{
+			Name:        "everything",
+			Expectation: []string{"pull"},
+		},
+		{
+			Name:        "include",
+			Include:     []string{"assets/**", "*.psd"},
+			Expectation: []string{"pull", "--include=assets/**,*.psd"},
+		},
+		{
+			Name:        "include and exclude",
+			Include:     []string{"assets/**"},
+			Exclude:     []string{"assets/videos/**"},
+			Expectation: []string{"pull", "--include=assets/**", "--exclude=assets/videos/**"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := lfsPullArgs(test.Include, test.Exclude)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected args (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLFSFiles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		Name        string
+		Files       map[string]string
+		Expectation []string
+	}{
+		{
+			Name: "no lfs",
+			Files: map[string]string{
+				"README.md": "hello",
+			},
+		},
+		{
+			Name: "lfs tracked files",
+			Files: map[string]string{
+				".gitattributes":  "*.bin filter=lfs diff=lfs merge=lfs -text\nassets/** filter=lfs diff=lfs merge=lfs -text\n",
+				"README.md":       "hello",
+				"model.bin":       "version https://git-lfs.github.com/spec/v1\n",
+				"assets/logo.png": "version https://git-lfs.github.com/spec/v1\n",
+			},
+			Expectation: []string{"assets/logo.png", "model.bin"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			client, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(client.Location)
+
+			for fn, content := range test.Files {
+				fn = filepath.Join(client.Location, fn)
+				if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(fn, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, args := range [][]string{
+				{"init", "--initial-branch=main"},
+				{"add", "."},
+			} {
+				if err := client.Git(ctx, args[0], args[1:]...); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			act, err := client.LFSFiles(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected LFS files (-want +got):\n%s", diff)
+			}
+
+			fetched, err := client.FetchLFS(ctx, nil, nil)
+			if len(test.Expectation) == 0 {
+				if err != nil || fetched != 0 {
+					t.Errorf("expected no LFS fetch, got %d bytes and error %v", fetched, err)
+				}
+				return
+			}
+			if _, lerr := exec.LookPath("git-lfs"); lerr == nil {
+				return
+			}
+			if !errors.Is(err, ErrLFSNotInstalled) {
+				t.Errorf("expected ErrLFSNotInstalled, got %v", err)
+			}
+		})
+	}
+}
@@ -55,6 +55,15 @@ type GitInitializer struct {
 
 	// If true, the Git initializer will chown(gitpod) after the clone
 	Chown bool
+
+	// LFSInclude restricts the Git LFS objects which are fetched to the given paths
+	LFSInclude []string
+
+	// LFSExclude excludes the given paths from the Git LFS objects which are fetched
+	LFSExclude []string
+
+	// If true, Git LFS pointer files are left in place
+	LFSSkipFetch bool
 }
 
 // Run initializes the workspace using Git
@@ -63,6 +72,8 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "GitInitializer.Run")
 	span.SetTag("isGitWS", isGitWS)
+	span.SetTag("filter", ws.Filter)
+	span.SetTag("sparseCheckout", len(ws.SparseCheckoutPatterns) > 0)
 	defer tracing.FinishSpan(span, &err)
 	start := time.Now()
 	initialSize, fsErr := getFsUsage()
@@ -182,6 +193,7 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 	if err := ws.UpdateSubmodules(ctx); err != nil {
 		log.WithError(err).Warn("error while updating submodules - continuing")
 	}
+	lfsMetric := ws.fetchLFS(ctx)
 
 	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
 
@@ -192,14 +204,57 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 		}
 
 		stats = csapi.InitializerMetrics{csapi.InitializerMetric{
-			Type:     "git",
-			Duration: time.Since(start),
-			Size:     currentSize - initialSize,
+			Type:         "git",
+			Duration:     time.Since(start),
+			Size:         currentSize - initialSize,
+			BytesFetched: ws.bytesFetched(ctx),
 		}}
 	}
+	if lfsMetric != nil {
+		stats = append(stats, *lfsMetric)
+	}
 	return
 }
 
+// fetchLFS fetches the Git LFS objects of the working copy unless disabled. Failing to do so is not fatal
+// and leaves the pointer files in place. fetchLFS returns a metric if any objects were fetched.
+func (ws *GitInitializer) fetchLFS(ctx context.Context) *csapi.InitializerMetric {
+	if ws.LFSSkipFetch {
+		return nil
+	}
+
+	start := time.Now()
+	fetched, err := ws.FetchLFS(ctx, ws.LFSInclude, ws.LFSExclude)
+	if errors.Is(err, git.ErrLFSNotInstalled) {
+		log.WithField("location", ws.Location).Warn("repository uses Git LFS but git-lfs is not installed - leaving pointer files in place")
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).WithField("location", ws.Location).Warn("error while fetching Git LFS objects - continuing")
+		return nil
+	}
+	if fetched == 0 {
+		return nil
+	}
+
+	return &csapi.InitializerMetric{
+		Type:         "gitLfs",
+		Duration:     time.Since(start),
+		Size:         fetched,
+		BytesFetched: fetched,
+	}
+}
+
+// bytesFetched returns the amount of data fetched by the clone, or zero if it cannot be determined.
+func (ws *GitInitializer) bytesFetched(ctx context.Context) uint64 {
+	size, err := ws.ObjectDatabaseSize(ctx)
+	if err != nil {
+		log.WithError(err).WithField("location", ws.Location).Warn("cannot determine the amount of data fetched")
+		return 0
+	}
+	return size
+}
+
 func (ws *GitInitializer) isShallowRepository(ctx context.Context) bool {
 	out, err := ws.GitWithOutput(ctx, nil, "rev-parse", "--is-shallow-repository")
 	if err != nil {
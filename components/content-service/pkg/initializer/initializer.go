@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"syscall"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunks"
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
@@ -220,7 +222,7 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 		log.WithError(fsErr).Error("could not get disk usage")
 	}
 
-	hasBackup, err := bi.RemoteStorage.Download(ctx, bi.Location, storage.DefaultBackup, mappings)
+	hasBackup, err := downloadBackup(ctx, bi.Location, bi.RemoteStorage, mappings)
 	if !hasBackup {
 		if err != nil {
 			return src, nil, xerrors.Errorf("no backup found, error: %w", err)
@@ -247,6 +249,22 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 	return csapi.WorkspaceInitFromBackup, stats, nil
 }
 
+// downloadBackup restores the regular backup of a workspace. Chunked backups take precedence if the remote storage can read them.
+func downloadBackup(ctx context.Context, location string, rs storage.DirectDownloader, mappings []archive.IDMapping) (found bool, err error) {
+	if or, ok := rs.(storage.ObjectReader); ok {
+		found, err = chunks.Restore(ctx, location, or, mappings)
+		if found || err != nil {
+			return found, err
+		}
+	}
+
+	return rs.Download(ctx, location, storage.DefaultBackup, mappings)
+}
+
+// cloneFilterRegex matches the partial clone filters we support, see `git rev-list --filter`.
+// Keep in sync with the cloneFilter enum of gitpod-schema.json.
+var cloneFilterRegex = regexp.MustCompile(`^(blob:none|tree:0)$`)
+
 // newGitInitializer creates a Git initializer based on the request.
 // Returns gRPC errors.
 func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, forceGitpodUser bool) (*GitInitializer, error) {
@@ -268,6 +286,10 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target mode: %v", req.TargetMode))
 	}
 
+	if req.Filter != "" && !cloneFilterRegex.MatchString(req.Filter) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid clone filter: %s", req.Filter))
+	}
+
 	var authMethod = git.BasicAuth
 	if req.Config.Authentication == csapi.GitAuthMethod_NO_AUTH {
 		authMethod = git.NoAuth
@@ -297,18 +319,24 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 	log.WithField("location", loc).Debug("using Git initializer")
 	return &GitInitializer{
 		Client: git.Client{
-			Location:          filepath.Join(loc, req.CheckoutLocation),
-			RemoteURI:         req.RemoteUri,
-			UpstreamRemoteURI: req.Upstream_RemoteUri,
-			Config:            req.Config.CustomConfig,
-			AuthMethod:        authMethod,
-			AuthProvider:      authProvider,
-			RunAsGitpodUser:   forceGitpodUser,
-			FullClone:         req.FullClone,
+			Location:               filepath.Join(loc, req.CheckoutLocation),
+			RemoteURI:              req.RemoteUri,
+			UpstreamRemoteURI:      req.Upstream_RemoteUri,
+			Config:                 req.Config.CustomConfig,
+			AuthMethod:             authMethod,
+			AuthProvider:           authProvider,
+			RunAsGitpodUser:        forceGitpodUser,
+			FullClone:              req.FullClone,
+			Filter:                 req.Filter,
+			SparseCheckoutPatterns: req.SparseCheckoutPatterns,
+			SkipLFSSmudge:          true,
 		},
-		TargetMode:  targetMode,
-		CloneTarget: req.CloneTaget,
-		Chown:       false,
+		TargetMode:   targetMode,
+		CloneTarget:  req.CloneTaget,
+		Chown:        false,
+		LFSInclude:   req.LfsInclude,
+		LFSExclude:   req.LfsExclude,
+		LFSSkipFetch: req.LfsSkipFetch,
 	}, nil
 }
 
@@ -474,7 +502,7 @@ func InitializeWorkspace(ctx context.Context, location string, remoteStorage sto
 		log.WithError(fsErr).Error("could not get disk usage")
 	}
 	downloadStart := time.Now()
-	hasBackup, err := remoteStorage.Download(ctx, location, storage.DefaultBackup, cfg.mappings)
+	hasBackup, err := downloadBackup(ctx, location, remoteStorage, cfg.mappings)
 	if err != nil {
 		return src, nil, xerrors.Errorf("cannot restore backup: %w", err)
 	}
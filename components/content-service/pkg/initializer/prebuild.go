@@ -179,6 +179,7 @@ func runGitInit(ctx context.Context, gInit *GitInitializer) (commitChanged bool,
 		if err != nil {
 			log.WithError(err).Warn("error while updating submodules from prebuild initializer - continuing")
 		}
+		gInit.fetchLFS(ctx)
 
 		// If any of these cleanup operations fail that's no reason to fail ws initialization.
 		// It just results in a slightly degraded state.
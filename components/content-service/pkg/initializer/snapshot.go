@@ -15,6 +15,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/snapshot"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
@@ -26,7 +27,7 @@ type SnapshotInitializer struct {
 	FromVolumeSnapshot bool
 }
 
-// Run downloads a snapshot from a remote storage
+// Run downloads a snapshot from a remote storage. Incremental snapshots are restored by downloading their whole chain.
 func (s *SnapshotInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, stats csapi.InitializerMetrics, err error) {
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "SnapshotInitializer")
@@ -45,7 +46,7 @@ func (s *SnapshotInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 		return src, nil, nil
 	}
 
-	ok, err := s.Storage.DownloadSnapshot(ctx, s.Location, s.Snapshot, mappings)
+	ok, err := snapshot.Restore(ctx, s.Location, s.Storage, s.Snapshot, mappings)
 	if err != nil {
 		return src, nil, xerrors.Errorf("snapshot initializer: %w", err)
 	}
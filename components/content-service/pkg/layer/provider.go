@@ -24,6 +24,7 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/api/config"
 	"github.com/gitpod-io/gitpod/content-service/pkg/executor"
 	"github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/snapshot"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
@@ -244,9 +245,11 @@ func (s *Provider) getSnapshotContentLayer(ctx context.Context, sp *csapi.Snapsh
 
 	if manifest == nil {
 		// we've found a legacy snapshot
-		cdesc, err := executor.Prepare(&csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Snapshot{Snapshot: sp}}, map[string]string{
-			sp.Snapshot: info.URL,
-		})
+		urls, err := s.snapshotURLs(ctx, sp.Snapshot, info)
+		if err != nil {
+			return nil, nil, err
+		}
+		cdesc, err := executor.Prepare(&csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Snapshot{Snapshot: sp}}, urls)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -288,9 +291,12 @@ func (s *Provider) getPrebuildContentLayer(ctx context.Context, pb *csapi.Prebui
 	var cdesc []byte
 	if manifest == nil {
 		// legacy prebuild - resort to in-workspace content init
-		cdesc, err = executor.Prepare(&csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Prebuild{Prebuild: pb}}, map[string]string{
-			pb.Prebuild.Snapshot: info.URL,
-		})
+		var urls map[string]string
+		urls, err = s.snapshotURLs(ctx, pb.Prebuild.Snapshot, info)
+		if err != nil {
+			return nil, nil, err
+		}
+		cdesc, err = executor.Prepare(&csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Prebuild{Prebuild: pb}}, urls)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -325,6 +331,23 @@ func (s *Provider) getPrebuildContentLayer(ctx context.Context, pb *csapi.Prebui
 	return l, manifest, nil
 }
 
+// snapshotURLs produces the URLs the in-workspace content init needs to restore a snapshot.
+// If the snapshot is incremental, that's all snapshots in its chain.
+func (s *Provider) snapshotURLs(ctx context.Context, name string, info *storage.DownloadInfo) (map[string]string, error) {
+	urls := map[string]string{
+		name: info.URL,
+	}
+
+	chain, err := snapshot.SignChain(ctx, s.Storage, s.Client, name)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot resolve snapshot chain of %s: %w", name, err)
+	}
+	for n, i := range chain {
+		urls[n] = i.URL
+	}
+	return urls, nil
+}
+
 func (s *Provider) layerFromContentManifest(ctx context.Context, mf *csapi.WorkspaceContentManifest, initsrc csapi.WorkspaceInitSource, ready bool) (l []Layer, err error) {
 	// we have a valid full workspace backup
 	l = make([]Layer, len(mf.Layers))
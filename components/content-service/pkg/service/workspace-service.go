@@ -7,9 +7,14 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,14 +22,22 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	"github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunks"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
+// assembleTimeout is the time we give the assembly of a chunked backup for download
+const assembleTimeout = 1 * time.Hour
+
 // WorkspaceService implements WorkspaceServiceServer
 type WorkspaceService struct {
 	cfg config.StorageConfig
 	s   storage.PresignedAccess
 
+	// assembling holds the workspaces whose chunked backup is being assembled for download
+	assembling   map[string]struct{}
+	assemblingMu sync.Mutex
+
 	api.UnimplementedWorkspaceServiceServer
 }
 
@@ -34,34 +47,90 @@ func NewWorkspaceService(cfg config.StorageConfig) (res *WorkspaceService, err e
 	if err != nil {
 		return nil, err
 	}
-	return &WorkspaceService{cfg: cfg, s: s}, nil
+	return &WorkspaceService{cfg: cfg, s: s, assembling: make(map[string]struct{})}, nil
 }
 
-// WorkspaceDownloadURL provides a URL from where the content of a workspace can be downloaded from
+// WorkspaceDownloadURL provides a URL from where the content of a workspace can be downloaded from.
+// Chunked backups are assembled into a single tarball in the background once, until then Unavailable is returned.
 func (cs *WorkspaceService) WorkspaceDownloadURL(ctx context.Context, req *api.WorkspaceDownloadURLRequest) (resp *api.WorkspaceDownloadURLResponse, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "WorkspaceDownloadURL")
 	span.SetTag("user", req.OwnerId)
 	span.SetTag("workspaceId", req.WorkspaceId)
 	defer tracing.FinishSpan(span, &err)
 
+	bucket := cs.s.Bucket(req.OwnerId)
 	blobName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackup)
-
-	info, err := cs.s.SignDownload(ctx, cs.s.Bucket(req.OwnerId), blobName, &storage.SignedURLOptions{})
-	if err != nil {
+	logFailure := func(err error) {
 		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).
-			WithField("bucket", cs.s.Bucket(req.OwnerId)).
+			WithField("bucket", bucket).
 			WithField("blobName", blobName).
 			WithError(err).
 			Error("error getting SignDownload URL")
-		if errors.Is(err, storage.ErrNotFound) {
+	}
+
+	info, err := cs.s.SignDownload(ctx, bucket, blobName, &storage.SignedURLOptions{})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		logFailure(err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	manifest, merr := cs.s.SignDownload(ctx, bucket, cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackupChunkManifest), &storage.SignedURLOptions{})
+	if merr != nil && !errors.Is(merr, storage.ErrNotFound) {
+		logFailure(merr)
+		return nil, status.Error(codes.Unknown, merr.Error())
+	}
+	if manifest == nil || (info != nil && !chunks.Supersedes(manifest.Meta, info.Meta)) {
+		if info == nil {
+			logFailure(err)
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		return &api.WorkspaceDownloadURLResponse{
+			Url: info.URL,
+		}, nil
+	}
+
+	// the chunked backup is the most recent one
+	assembled, err := cs.s.SignDownload(ctx, bucket, cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackupAssembled), &storage.SignedURLOptions{})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		logFailure(err)
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	// every backup removes the assembled tarball, its modification time only guards against failed removals
+	if assembled != nil && (assembled.Meta.LastModified.IsZero() || !assembled.Meta.LastModified.Before(manifest.Meta.LastModified)) {
+		return &api.WorkspaceDownloadURLResponse{
+			Url: assembled.URL,
+		}, nil
+	}
 
-	return &api.WorkspaceDownloadURLResponse{
-		Url: info.URL,
-	}, nil
+	cs.startAssembly(req.OwnerId, req.WorkspaceId)
+	return nil, status.Error(codes.Unavailable, "the workspace content is being prepared for download, please try again later")
+}
+
+// startAssembly assembles the chunked backup of a workspace in the background unless that's happening already
+func (cs *WorkspaceService) startAssembly(ownerID, workspaceID string) {
+	key := ownerID + "/" + workspaceID
+	cs.assemblingMu.Lock()
+	if _, running := cs.assembling[key]; running {
+		cs.assemblingMu.Unlock()
+		return
+	}
+	cs.assembling[key] = struct{}{}
+	cs.assemblingMu.Unlock()
+
+	go func() {
+		defer func() {
+			cs.assemblingMu.Lock()
+			delete(cs.assembling, key)
+			cs.assemblingMu.Unlock()
+		}()
+
+		// the assembly outlives the request which started it
+		ctx, cancel := context.WithTimeout(context.Background(), assembleTimeout)
+		defer cancel()
+		err := cs.assembleChunkedBackup(ctx, ownerID, workspaceID)
+		if err != nil {
+			log.WithFields(log.OWI(ownerID, workspaceID, "")).WithError(err).Error("cannot assemble chunked backup for download")
+		}
+	}()
 }
 
 // DeleteWorkspace deletes the content of a single workspace
@@ -89,6 +158,25 @@ func (cs *WorkspaceService) DeleteWorkspace(ctx context.Context, req *api.Delete
 		return &api.DeleteWorkspaceResponse{}, nil
 	}
 
+	chunkManifest := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackupChunkManifest)
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: chunkManifest})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.WithError(err).Error("error deleting workspace backup: ", chunkManifest)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	assembled := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackupAssembled)
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: assembled})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.WithError(err).Error("error deleting workspace backup: ", assembled)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	chunkPrefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, chunks.ObjectPrefix)
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Prefix: chunkPrefix})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.WithError(err).Error("error deleting workspace backup: ", chunkPrefix)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
 	blobName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.DefaultBackup)
 	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: blobName})
 	if err != nil {
@@ -129,3 +217,79 @@ func (cs *WorkspaceService) WorkspaceSnapshotExists(ctx context.Context, req *ap
 		Exists: exists,
 	}, nil
 }
+
+// assembleChunkedBackup uploads the tarball formed by the chunks of a workspace's chunked backup for download.
+// The next backup of the workspace removes the assembled tarball again.
+func (cs *WorkspaceService) assembleChunkedBackup(ctx context.Context, ownerID, workspaceID string) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "assembleChunkedBackup")
+	defer tracing.FinishSpan(span, &err)
+
+	bucket := cs.s.Bucket(ownerID)
+	manifest, tarball, err := chunks.Open(ctx, &presignedObjectReader{
+		s:      cs.s,
+		bucket: bucket,
+		object: func(name string) string { return cs.s.BackupObject(ownerID, workspaceID, name) },
+	})
+	if err != nil {
+		return err
+	}
+	defer tarball.Close()
+	span.SetTag("size", manifest.Size)
+
+	info, err := cs.s.SignUpload(ctx, bucket, cs.s.BackupObject(ownerID, workspaceID, storage.DefaultBackupAssembled), &storage.SignedURLOptions{
+		ContentType: "application/tar",
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot sign upload of the assembled backup: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, info.URL, tarball)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = manifest.Size
+	req.Header.Set("Content-Type", "application/tar")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return xerrors.Errorf("cannot upload the assembled backup: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return xerrors.Errorf("cannot upload the assembled backup: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// presignedObjectReader reads objects through download URLs signed by a PresignedAccess
+type presignedObjectReader struct {
+	s      storage.PresignedAccess
+	bucket string
+	object func(name string) string
+}
+
+// ReadObject returns the content of an object - if the object is not found, ErrNotFound is returned
+func (r *presignedObjectReader) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	info, err := r.s.SignDownload(ctx, r.bucket, r.object(name), &storage.SignedURLOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, info.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, storage.ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("cannot download %s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package snapshot implements incremental snapshots.
+//
+// An incremental snapshot only contains the files which changed since its parent snapshot. Next to
+// the snapshot tarball we store a chain which lists all snapshots that have to be restored in order,
+// starting with a full snapshot, together with the paths which were removed in each step. Snapshots
+// without a chain are full snapshots. To detect changes every snapshot is accompanied by an index
+// of the workspace content at the time the snapshot was taken.
+//
+// The snapshots an incremental snapshot builds on are copied next to it, so that a chain only references
+// objects of the workspace it belongs to. Deleting the workspaces which took the earlier snapshots thus
+// doesn't break the chain.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// ChainVersion is the version of the chain format we produce
+	ChainVersion = 1
+
+	chainSuffix = ".chain.json"
+	indexSuffix = ".index.json.gz"
+)
+
+// Chain lists the snapshots an incremental snapshot consists of
+type Chain struct {
+	Version int `json:"version"`
+	// Layers are the snapshots to restore in order. The first layer is a full snapshot,
+	// the last layer is the snapshot the chain belongs to.
+	Layers []ChainLayer `json:"layers"`
+}
+
+// ChainLayer is a single snapshot in a chain
+type ChainLayer struct {
+	// Snapshot is the fully qualified name of the snapshot
+	Snapshot string `json:"snapshot"`
+	// Deleted lists the paths which were removed since the previous snapshot in the chain
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+// Len returns the number of snapshots in the chain
+func (c *Chain) Len() int {
+	if c == nil {
+		return 1
+	}
+	return len(c.Layers)
+}
+
+// Extend produces the chain of a snapshot which builds on the snapshot this chain belongs to.
+// parent is the fully qualified name of that snapshot - the chain of a full snapshot is nil.
+func (c *Chain) Extend(parent, snapshot string, deleted []string) *Chain {
+	res := &Chain{Version: ChainVersion}
+	if c == nil {
+		res.Layers = append(res.Layers, ChainLayer{Snapshot: parent})
+	} else {
+		res.Layers = append(res.Layers, c.Layers...)
+	}
+	res.Layers = append(res.Layers, ChainLayer{Snapshot: snapshot, Deleted: deleted})
+	return res
+}
+
+// LayerName returns the name of the object holding the copy of the i-th layer in the chain of a snapshot.
+// name can either be a fully qualified snapshot name or the name of a workspace object.
+func LayerName(name string, i int) string {
+	return sidecarName(name, fmt.Sprintf(".layer-%d", i))
+}
+
+// ChainName returns the name of the object holding the chain of a snapshot.
+// name can either be a fully qualified snapshot name or the name of a workspace object.
+func ChainName(name string) string {
+	return sidecarName(name, chainSuffix)
+}
+
+// IndexName returns the name of the object holding the content index of a snapshot.
+// name can either be a fully qualified snapshot name or the name of a workspace object.
+func IndexName(name string) string {
+	return sidecarName(name, indexSuffix)
+}
+
+func sidecarName(name, suffix string) string {
+	if i := strings.LastIndex(name, "@"); i >= 0 {
+		return name[:i] + suffix + name[i:]
+	}
+	return name + suffix
+}
+
+// ReadChain reads and validates the chain of the snapshot with the given fully qualified name
+func ReadChain(r io.Reader, snapshot string) (*Chain, error) {
+	var c Chain
+	err := json.NewDecoder(r).Decode(&c)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal snapshot chain: %w", err)
+	}
+	if c.Version != ChainVersion {
+		return nil, xerrors.Errorf("unsupported snapshot chain version %d", c.Version)
+	}
+	if len(c.Layers) < 2 {
+		return nil, xerrors.Errorf("snapshot chain has %d layers, expected at least two", len(c.Layers))
+	}
+	if len(c.Layers[0].Deleted) > 0 {
+		return nil, xerrors.Errorf("snapshot chain does not start with a full snapshot")
+	}
+	if tip := c.Layers[len(c.Layers)-1].Snapshot; tip != snapshot {
+		return nil, xerrors.Errorf("snapshot chain belongs to %s, not %s", tip, snapshot)
+	}
+	for _, l := range c.Layers {
+		if _, _, err := storage.ParseSnapshotName(l.Snapshot); err != nil {
+			return nil, err
+		}
+	}
+	return &c, nil
+}
+
+// SignChain produces download URLs for the chain of the given snapshot and all snapshots in it.
+// The result is keyed by the names the SnapshotInitializer uses, and nil if the snapshot is a full snapshot.
+func SignChain(ctx context.Context, ps storage.PresignedAccess, client *http.Client, snapshot string) (map[string]storage.DownloadInfo, error) {
+	chainName := ChainName(snapshot)
+	bkt, obj, err := storage.ParseSnapshotName(chainName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("cannot sign snapshot chain: %w", err)
+	}
+
+	chain, err := fetchChain(ctx, client, info.URL, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]storage.DownloadInfo{chainName: *info}
+	for _, l := range chain.Layers {
+		bkt, obj, err := storage.ParseSnapshotName(l.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+		if err != nil {
+			return nil, xerrors.Errorf("cannot sign snapshot %s: %w", l.Snapshot, err)
+		}
+		res[l.Snapshot] = *info
+	}
+	return res, nil
+}
+
+func fetchChain(ctx context.Context, client *http.Client, url, snapshot string) (*Chain, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download snapshot chain: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("cannot download snapshot chain: status %d", resp.StatusCode)
+	}
+	return ReadChain(resp.Body, snapshot)
+}
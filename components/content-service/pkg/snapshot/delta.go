@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package snapshot
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// WriteDelta writes a tarball containing the given paths below root to w. Directories are added without their children,
+// i.e. paths is expected to list every file which is part of the delta, as produced by Diff.
+// The mappings of the options translate the owners on disk back to the IDs within the workspace.
+func WriteDelta(w io.Writer, root string, paths []string, opts ...archive.TarOption) error {
+	var cfg archive.TarConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	tw := tar.NewWriter(w)
+	for _, p := range paths {
+		fn := filepath.Join(root, filepath.FromSlash(p))
+		stat, err := os.Lstat(fn)
+		if errors.Is(err, fs.ErrNotExist) {
+			// the file was removed after we indexed the workspace
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if stat.Mode()&fs.ModeSocket != 0 {
+			// tar cannot represent sockets and the backup would not restore them either
+			continue
+		}
+
+		var link string
+		if stat.Mode()&fs.ModeSymlink != 0 {
+			link, err = os.Readlink(fn)
+			if err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(stat, link)
+		if err != nil {
+			return xerrors.Errorf("cannot add %s to snapshot: %w", p, err)
+		}
+		hdr.Name = p
+		if stat.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.Uname, hdr.Gname = "", ""
+		hdr.Format = tar.FormatPAX
+		if sys, ok := stat.Sys().(*syscall.Stat_t); ok {
+			hdr.Uid = toContainerID(int(sys.Uid), cfg.UIDMaps)
+			hdr.Gid = toContainerID(int(sys.Gid), cfg.GIDMaps)
+		}
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return xerrors.Errorf("cannot add %s to snapshot: %w", p, err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		err = copyFile(tw, fn, hdr.Size)
+		if err != nil {
+			return xerrors.Errorf("cannot add %s to snapshot: %w", p, err)
+		}
+	}
+	return tw.Close()
+}
+
+func copyFile(w io.Writer, fn string, size int64) error {
+	f, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(w, io.LimitReader(f, size))
+	if err != nil {
+		return err
+	}
+	if n < size {
+		// the file shrunk after we read its size - pad it to keep the tarball valid
+		log.WithField("path", fn).Warn("file changed while adding it to the snapshot")
+		_, err = w.Write(make([]byte, size-n))
+	}
+	return err
+}
+
+func toContainerID(hostID int, idMap []archive.IDMapping) int {
+	for _, m := range idMap {
+		if hostID >= m.HostID && hostID <= m.HostID+m.Size-1 {
+			return m.ContainerID + (hostID - m.HostID)
+		}
+	}
+	return hostID
+}
+
+// RemovePaths removes the given paths below root. Paths which do not exist are ignored.
+func RemovePaths(root string, paths []string) error {
+	for _, p := range paths {
+		// cleaning the path as absolute path ensures we never leave root
+		fn := filepath.Join(root, filepath.Clean("/"+filepath.FromSlash(p)))
+		if fn == filepath.Clean(root) {
+			continue
+		}
+		err := os.RemoveAll(fn)
+		if err != nil {
+			return xerrors.Errorf("cannot remove %s: %w", p, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package snapshot
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"golang.org/x/xerrors"
+)
+
+// IndexVersion is the version of the index format we produce
+const IndexVersion = 2
+
+// Index describes the content of a workspace at the time a snapshot was taken
+type Index struct {
+	Version int `json:"version"`
+	// Entries maps slash-separated paths relative to the workspace location to their metadata
+	Entries map[string]Entry `json:"entries"`
+}
+
+// Entry describes a single file in the index. Changes are detected by comparing type, permissions, owner, size,
+// modification and status change time. Unlike the modification time, the status change time cannot be set from
+// userspace and changes with the owner and permissions, too. Both are recorded with nanosecond precision, so that
+// a file which is rewritten within the same second at the same size is not taken for unchanged.
+type Entry struct {
+	Mode fs.FileMode `json:"m"`
+	UID  uint32      `json:"u,omitempty"`
+	GID  uint32      `json:"g,omitempty"`
+	Size int64       `json:"s,omitempty"`
+	// ModTime and ChangeTime are the modification and status change time in nanoseconds since the epoch.
+	// They're not recorded for directories, as both change whenever one of their children is added or removed.
+	ModTime    int64  `json:"t,omitempty"`
+	ChangeTime int64  `json:"c,omitempty"`
+	Link       string `json:"l,omitempty"`
+}
+
+// BuildIndex produces the index of all files below root
+func BuildIndex(root string) (*Index, error) {
+	idx := &Index{Version: IndexVersion, Entries: make(map[string]Entry)}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		entry := Entry{Mode: stat.Mode()}
+		if sys, ok := stat.Sys().(*syscall.Stat_t); ok {
+			entry.UID, entry.GID = sys.Uid, sys.Gid
+			if !stat.IsDir() {
+				entry.ChangeTime = sys.Ctim.Nano()
+			}
+		}
+		switch {
+		case stat.Mode().IsRegular():
+			entry.Size = stat.Size()
+			entry.ModTime = stat.ModTime().UnixNano()
+		case stat.Mode()&fs.ModeSymlink != 0:
+			entry.Link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		case !stat.IsDir():
+			entry.ModTime = stat.ModTime().UnixNano()
+		}
+		idx.Entries[filepath.ToSlash(rel)] = entry
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot index %s: %w", root, err)
+	}
+	return idx, nil
+}
+
+// Diff compares the index of a parent snapshot with the current one. It returns the paths which have to be part
+// of the incremental snapshot and the paths which were removed since the parent snapshot, both sorted.
+// Paths whose type changed are reported as both, as they have to be removed before the new content is restored.
+func Diff(parent, current *Index) (changed, deleted []string) {
+	for p, e := range current.Entries {
+		pe, exists := parent.Entries[p]
+		if !exists {
+			changed = append(changed, p)
+			continue
+		}
+		if pe.Mode.Type() != e.Mode.Type() {
+			deleted = append(deleted, p)
+			changed = append(changed, p)
+			continue
+		}
+		if pe != e {
+			changed = append(changed, p)
+		}
+	}
+	for p := range parent.Entries {
+		if _, exists := current.Entries[p]; !exists {
+			deleted = append(deleted, p)
+		}
+	}
+	sort.Strings(changed)
+	sort.Strings(deleted)
+
+	// removing a directory removes its children, too
+	var (
+		res    = deleted[:0]
+		prefix string
+	)
+	for _, p := range deleted {
+		if prefix != "" && strings.HasPrefix(p, prefix) {
+			continue
+		}
+		res = append(res, p)
+		prefix = ""
+		if parent.Entries[p].Mode.IsDir() {
+			prefix = p + "/"
+		}
+	}
+	deleted = res
+
+	return changed, deleted
+}
+
+// WriteIndex writes a compressed index
+func WriteIndex(w io.Writer, idx *Index) error {
+	gz := gzip.NewWriter(w)
+	err := json.NewEncoder(gz).Encode(idx)
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadIndex reads a compressed index
+func ReadIndex(r io.Reader) (*Index, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read snapshot index: %w", err)
+	}
+	defer gz.Close()
+
+	var idx Index
+	err = json.NewDecoder(gz).Decode(&idx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal snapshot index: %w", err)
+	}
+	if idx.Version != IndexVersion {
+		return nil, xerrors.Errorf("unsupported snapshot index version %d", idx.Version)
+	}
+	return &idx, nil
+}
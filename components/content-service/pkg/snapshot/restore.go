@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+// Restore downloads a snapshot to destination. If the snapshot is incremental, all snapshots in its chain are restored in order.
+// Returns false if the snapshot or one of its parents does not exist.
+func Restore(ctx context.Context, destination string, rs storage.DirectDownloader, snapshot string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "snapshot.Restore")
+	span.SetTag("snapshot", snapshot)
+	defer tracing.FinishSpan(span, &err)
+
+	chain, err := readChain(ctx, rs, snapshot)
+	if err != nil {
+		return false, err
+	}
+	if chain == nil {
+		return rs.DownloadSnapshot(ctx, destination, snapshot, mappings)
+	}
+	span.SetTag("chainLength", chain.Len())
+
+	for _, l := range chain.Layers {
+		err = RemovePaths(destination, l.Deleted)
+		if err != nil {
+			return true, err
+		}
+		found, err = rs.DownloadSnapshot(ctx, destination, l.Snapshot, mappings)
+		if err != nil {
+			return true, xerrors.Errorf("cannot restore snapshot %s: %w", l.Snapshot, err)
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// readChain returns the chain of a snapshot, or nil if it's a full snapshot
+func readChain(ctx context.Context, rs storage.DirectDownloader, snapshot string) (*Chain, error) {
+	or, ok := rs.(storage.ObjectReader)
+	if !ok {
+		return nil, nil
+	}
+
+	rc, err := or.ReadObject(ctx, ChainName(snapshot))
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download snapshot chain: %w", err)
+	}
+	defer rc.Close()
+
+	return ReadChain(rc, snapshot)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+func TestDiff(t *testing.T) {
+	var (
+		dir  = Entry{Mode: fs.ModeDir | 0755}
+		file = func(size, mtime int64) Entry { return Entry{Mode: 0644, Size: size, ModTime: mtime} }
+	)
+
+	tests := []struct {
+		Name    string
+		Parent  map[string]Entry
+		Current map[string]Entry
+		Changed []string
+		Deleted []string
+	}{
+		{
+			Name:    "unchanged",
+			Parent:  map[string]Entry{"a": dir, "a/b": file(1, 1)},
+			Current: map[string]Entry{"a": dir, "a/b": file(1, 1)},
+		},
+		{
+			Name:    "added and modified",
+			Parent:  map[string]Entry{"a": dir, "a/b": file(1, 1), "c": file(1, 1)},
+			Current: map[string]Entry{"a": dir, "a/b": file(1, 2), "a/d": file(1, 1), "c": file(2, 1), "e": dir, "e/f": file(1, 1)},
+			Changed: []string{"a/b", "a/d", "c", "e", "e/f"},
+		},
+		{
+			Name:    "permissions changed",
+			Parent:  map[string]Entry{"a": dir},
+			Current: map[string]Entry{"a": {Mode: fs.ModeDir | 0700}},
+			Changed: []string{"a"},
+		},
+		{
+			Name:    "owner changed",
+			Parent:  map[string]Entry{"a": dir, "a/b": file(1, 1)},
+			Current: map[string]Entry{"a": {Mode: fs.ModeDir | 0755, UID: 33333}, "a/b": {Mode: 0644, GID: 33333, Size: 1, ModTime: 1}},
+			Changed: []string{"a", "a/b"},
+		},
+		{
+			Name:    "status changed",
+			Parent:  map[string]Entry{"a": dir, "a/b": file(1, 1)},
+			Current: map[string]Entry{"a": dir, "a/b": {Mode: 0644, Size: 1, ModTime: 1, ChangeTime: 2}},
+			Changed: []string{"a/b"},
+		},
+		{
+			Name:    "removed directory",
+			Parent:  map[string]Entry{"a": dir, "a/b": dir, "a/b/c": file(1, 1), "ab": file(1, 1), "d": file(1, 1)},
+			Current: map[string]Entry{"d": file(1, 1)},
+			Deleted: []string{"a", "ab"},
+		},
+		{
+			Name:    "type changed",
+			Parent:  map[string]Entry{"a": dir, "a/b": file(1, 1), "c": {Mode: fs.ModeSymlink | 0777, Link: "a"}},
+			Current: map[string]Entry{"a": file(1, 1), "c": {Mode: fs.ModeSymlink | 0777, Link: "d"}},
+			Changed: []string{"a", "c"},
+			Deleted: []string{"a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			changed, deleted := Diff(&Index{Entries: test.Parent}, &Index{Entries: test.Current})
+			if diff := cmp.Diff(test.Changed, changed); diff != "" {
+				t.Errorf("unexpected changed paths (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.Deleted, deleted); diff != "" {
+				t.Errorf("unexpected deleted paths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildIndex(t *testing.T) {
+	root := t.TempDir()
+	fn := filepath.Join(root, "a")
+	err := os.WriteFile(fn, []byte("hello"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat, err := os.Stat(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name   string
+		Modify func() error
+	}{
+		{
+			Name: "rewritten with the same size and modification time",
+			Modify: func() error {
+				err := os.WriteFile(fn, []byte("world"), 0644)
+				if err != nil {
+					return err
+				}
+				return os.Chtimes(fn, stat.ModTime(), stat.ModTime())
+			},
+		},
+		{
+			Name:   "permissions changed",
+			Modify: func() error { return os.Chmod(fn, 0600) },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			parent, err := BuildIndex(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = test.Modify()
+			if err != nil {
+				t.Fatal(err)
+			}
+			current, err := BuildIndex(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changed, _ := Diff(parent, current)
+			if diff := cmp.Diff([]string{"a"}, changed); diff != "" {
+				t.Errorf("unexpected changed paths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadChain(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Input string
+		Error string
+	}{
+		{
+			Name:  "valid",
+			Input: `{"version":1,"layers":[{"snapshot":"base@bkt"},{"snapshot":"tip@bkt","deleted":["a"]}]}`,
+		},
+		{
+			Name:  "unknown version",
+			Input: `{"version":2,"layers":[]}`,
+			Error: "unsupported snapshot chain version 2",
+		},
+		{
+			Name:  "single layer",
+			Input: `{"version":1,"layers":[{"snapshot":"tip@bkt"}]}`,
+			Error: "snapshot chain has 1 layers, expected at least two",
+		},
+		{
+			Name:  "incremental base",
+			Input: `{"version":1,"layers":[{"snapshot":"base@bkt","deleted":["a"]},{"snapshot":"tip@bkt"}]}`,
+			Error: "snapshot chain does not start with a full snapshot",
+		},
+		{
+			Name:  "foreign chain",
+			Input: `{"version":1,"layers":[{"snapshot":"base@bkt"},{"snapshot":"other@bkt"}]}`,
+			Error: "snapshot chain belongs to other@bkt, not tip@bkt",
+		},
+		{
+			Name:  "invalid snapshot name",
+			Input: `{"version":1,"layers":[{"snapshot":"base"},{"snapshot":"tip@bkt"}]}`,
+			Error: "base is not a valid GCloud remote storage FQN",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := ReadChain(strings.NewReader(test.Input), "tip@bkt")
+			var act string
+			if err != nil {
+				act = err.Error()
+			}
+			if test.Error == "" && act != "" || !strings.HasPrefix(act, test.Error) {
+				t.Errorf("unexpected error: want %q, got %q", test.Error, act)
+			}
+		})
+	}
+}
+
+func TestSidecarNames(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Chain string
+		Index string
+		Layer string
+	}{
+		{Name: "workspaces/ws/snapshot-1.tar@bucket", Chain: "workspaces/ws/snapshot-1.tar.chain.json@bucket", Index: "workspaces/ws/snapshot-1.tar.index.json.gz@bucket", Layer: "workspaces/ws/snapshot-1.tar.layer-0@bucket"},
+		{Name: "snapshot-1.tar", Chain: "snapshot-1.tar.chain.json", Index: "snapshot-1.tar.index.json.gz", Layer: "snapshot-1.tar.layer-0"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := ChainName(test.Name); act != test.Chain {
+				t.Errorf("unexpected chain name: want %s, got %s", test.Chain, act)
+			}
+			if act := IndexName(test.Name); act != test.Index {
+				t.Errorf("unexpected index name: want %s, got %s", test.Index, act)
+			}
+			if act := LayerName(test.Name, 0); act != test.Layer {
+				t.Errorf("unexpected layer name: want %s, got %s", test.Layer, act)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	var (
+		ctx = context.Background()
+		rs  = &memoryStorage{objects: make(map[string][]byte)}
+		src = t.TempDir()
+	)
+	writeFiles(t, src, time.Unix(1000, 0), map[string]string{
+		"README.md":               "hello",
+		"src/main.go":             "package main",
+		"node_modules/a/index.js": "a",
+		"node_modules/b/index.js": "b",
+	})
+
+	// the first snapshot is a full snapshot
+	base := takeSnapshot(t, rs, src, "base@bkt", nil, nil)
+	chain := (*Chain)(nil).Extend("base@bkt", "delta-1@bkt", nil)
+	if chain.Len() != 2 {
+		t.Errorf("unexpected chain length %d", chain.Len())
+	}
+
+	writeFiles(t, src, time.Unix(2000, 0), map[string]string{
+		"src/main.go":  "package main\n\nfunc main() {}",
+		"src/util.go":  "package main",
+		"docs/test.md": "test",
+	})
+	err := os.RemoveAll(filepath.Join(src, "node_modules/b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	delta1 := takeSnapshot(t, rs, src, "delta-1@bkt", base, chain)
+
+	err = os.Remove(filepath.Join(src, "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain = chain.Extend("delta-1@bkt", "delta-2@bkt", nil)
+	takeSnapshot(t, rs, src, "delta-2@bkt", delta1, chain)
+
+	if size := len(rs.objects["delta-1@bkt"]); size >= len(rs.objects["base@bkt"]) {
+		t.Errorf("expected the incremental snapshot to be smaller than the full one: %d bytes", size)
+	}
+
+	dst := t.TempDir()
+	found, err := Restore(ctx, dst, rs, "delta-2@bkt", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("snapshot not found")
+	}
+
+	want, err := BuildIndex(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	act, err := BuildIndex(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the status change time is set by the kernel and cannot be restored
+	for _, idx := range []*Index{want, act} {
+		for p, e := range idx.Entries {
+			e.ChangeTime = 0
+			idx.Entries[p] = e
+		}
+	}
+	if diff := cmp.Diff(want, act); diff != "" {
+		t.Errorf("unexpected restored content (-want +got):\n%s", diff)
+	}
+	for p, e := range want.Entries {
+		if !e.Mode.IsRegular() {
+			continue
+		}
+		wantContent, _ := os.ReadFile(filepath.Join(src, p))
+		actContent, _ := os.ReadFile(filepath.Join(dst, p))
+		if !bytes.Equal(wantContent, actContent) {
+			t.Errorf("unexpected content of %s: want %q, got %q", p, wantContent, actContent)
+		}
+	}
+
+	delete(rs.objects, "delta-1@bkt")
+	found, err = Restore(ctx, t.TempDir(), rs, "delta-2@bkt", nil)
+	if err != nil || found {
+		t.Errorf("expected a missing parent to fail the restore, got found=%v, err=%v", found, err)
+	}
+}
+
+// takeSnapshot uploads a snapshot of src and returns its index. If parent is not nil, an incremental snapshot is taken.
+func takeSnapshot(t *testing.T, rs *memoryStorage, src, name string, parent *Index, chain *Chain) *Index {
+	idx, err := BuildIndex(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		paths   []string
+		deleted []string
+	)
+	if parent == nil {
+		for p := range idx.Entries {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+	} else {
+		paths, deleted = Diff(parent, idx)
+		chain.Layers[len(chain.Layers)-1].Deleted = deleted
+
+		var buf bytes.Buffer
+		err = json.NewEncoder(&buf).Encode(chain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs.objects[ChainName(name)] = buf.Bytes()
+	}
+
+	var buf bytes.Buffer
+	err = WriteDelta(&buf, src, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs.objects[name] = buf.Bytes()
+
+	var ibuf bytes.Buffer
+	err = WriteIndex(&ibuf, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx, err = ReadIndex(&ibuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+// writeFiles writes files with the given modification time, as the index has a resolution of one second
+func writeFiles(t *testing.T, root string, mtime time.Time, files map[string]string) {
+	for name, content := range files {
+		fn := filepath.Join(root, name)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.Chtimes(fn, mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// memoryStorage keeps snapshots in memory
+type memoryStorage struct {
+	storage.DirectNoopStorage
+
+	objects map[string][]byte
+}
+
+func (rs *memoryStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	data, ok := rs.objects[name]
+	if !ok {
+		return false, nil
+	}
+	return true, archive.ExtractTarbal(ctx, bytes.NewReader(data), destination)
+}
+
+func (rs *memoryStorage) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, ok := rs.objects[name]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
@@ -338,6 +338,53 @@ func (rs *DirectGCPStorage) ListObjects(ctx context.Context, prefix string) (obj
 	return objects, nil
 }
 
+// DeleteObjects deletes the objects with the given names. Objects which do not exist are ignored.
+func (rs *DirectGCPStorage) DeleteObjects(ctx context.Context, names ...string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectGCPStorage.DeleteObjects")
+	span.SetTag("objects", len(names))
+	defer tracing.FinishSpan(span, &err)
+
+	bkt := rs.client.Bucket(rs.bucketName())
+	for _, name := range names {
+		err = bkt.Object(name).Delete(ctx)
+		if errors.Is(err, gcpstorage.ErrBucketNotExist) || errors.Is(err, gcpstorage.ErrObjectNotExist) {
+			continue
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot delete %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// CopySnapshot copies a snapshot to the remote storage without downloading it
+func (rs *DirectGCPStorage) CopySnapshot(ctx context.Context, snapshot string, name string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectGCPStorage.CopySnapshot")
+	span.SetTag("snapshot", snapshot)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return xerrors.Errorf("no gcloud client available - did you call Init()?")
+	}
+
+	bkt, obj, err := ParseSnapshotName(snapshot)
+	if err != nil {
+		return err
+	}
+	src := rs.client.Bucket(bkt).Object(obj)
+	dst := rs.client.Bucket(rs.bucketName()).Object(rs.objectName(name))
+	_, err = dst.CopierFrom(src).Run(ctx)
+	if errors.Is(err, gcpstorage.ErrBucketNotExist) || errors.Is(err, gcpstorage.ErrObjectNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot copy %s: %w", snapshot, err)
+	}
+	return nil
+}
+
 // Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
 func (rs *DirectGCPStorage) Qualify(name string) string {
 	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
@@ -618,6 +665,7 @@ func (p *PresignedGCPStorage) downloadInfo(ctx context.Context, client *gcpstora
 		OCIMediaType:       obj.Metadata[ObjectAnnotationOCIContentType],
 		Digest:             obj.Metadata[ObjectAnnotationDigest],
 		UncompressedDigest: obj.Metadata[ObjectAnnotationUncompressedDigest],
+		LastModified:       obj.Updated,
 	}
 	url, err := gcpstorage.SignedURL(obj.Bucket, obj.Name, &gcpstorage.SignedURLOptions{
 		Method:         "GET",
@@ -262,6 +262,56 @@ func (rs *DirectMinIOStorage) ListObjects(ctx context.Context, prefix string) (o
 	return objects, nil
 }
 
+// DeleteObjects deletes the objects with the given names. Objects which do not exist are ignored.
+func (rs *DirectMinIOStorage) DeleteObjects(ctx context.Context, names ...string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectMinIOStorage.DeleteObjects")
+	span.SetTag("objects", len(names))
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return xerrors.Errorf("no MinIO client available - did you call Init()?")
+	}
+
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for _, name := range names {
+			objectsCh <- minio.ObjectInfo{Key: name}
+		}
+	}()
+	for removeErr := range rs.client.RemoveObjects(ctx, rs.bucketName(), objectsCh, minio.RemoveObjectsOptions{}) {
+		if translateMinioError(removeErr.Err) == ErrNotFound {
+			continue
+		}
+		err = removeErr.Err
+		log.WithField("bucket", rs.bucketName()).WithField("object", removeErr.ObjectName).Error(err)
+	}
+	return translateMinioError(err)
+}
+
+// CopySnapshot copies a snapshot to the remote storage without downloading it
+func (rs *DirectMinIOStorage) CopySnapshot(ctx context.Context, snapshot string, name string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectMinIOStorage.CopySnapshot")
+	span.SetTag("snapshot", snapshot)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.client == nil {
+		return xerrors.Errorf("no MinIO client available - did you call Init()?")
+	}
+
+	bkt, obj, err := ParseSnapshotName(snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = rs.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: rs.bucketName(), Object: rs.objectName(name)},
+		minio.CopySrcOptions{Bucket: bkt, Object: obj},
+	)
+	return translateMinioError(err)
+}
+
 // Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
 func (rs *DirectMinIOStorage) Qualify(name string) string {
 	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
@@ -418,6 +468,7 @@ func (s *presignedMinIOStorage) SignDownload(ctx context.Context, bucket, object
 			OCIMediaType:       stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationOCIContentType)),
 			Digest:             stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationDigest)),
 			UncompressedDigest: stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationUncompressedDigest)),
+			LastModified:       stat.LastModified,
 		},
 		Size: stat.Size,
 		URL:  url.String(),
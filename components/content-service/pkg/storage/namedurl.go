@@ -6,6 +6,7 @@ package storage
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"golang.org/x/xerrors"
@@ -13,6 +14,8 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
 )
 
+var _ ObjectReader = &NamedURLDownloader{}
+
 // NamedURLDownloader offers downloads from fixed URLs
 type NamedURLDownloader struct {
 	URLs map[string]string
@@ -50,6 +53,34 @@ func (d *NamedURLDownloader) Download(ctx context.Context, destination string, n
 	return true, nil
 }
 
+// ReadObject returns the content of an object - if the object is not found, ErrNotFound is returned
+func (d *NamedURLDownloader) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	url, found := d.URLs[name]
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("non-OK status code: %v", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
 // DownloadSnapshot downloads a snapshot.
 func (d *NamedURLDownloader) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
 	return d.Download(ctx, destination, name, mappings)
@@ -55,6 +55,16 @@ func (rs *DirectNoopStorage) Upload(ctx context.Context, source string, name str
 	return "", "", nil
 }
 
+// DeleteObjects does nothing
+func (rs *DirectNoopStorage) DeleteObjects(ctx context.Context, names ...string) error {
+	return nil
+}
+
+// CopySnapshot does nothing
+func (rs *DirectNoopStorage) CopySnapshot(ctx context.Context, snapshot string, name string) error {
+	return nil
+}
+
 // Bucket returns an empty string
 func (rs *DirectNoopStorage) Bucket(string) string {
 	return ""
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -41,6 +42,7 @@ type S3Client interface {
 	DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
 	GetObjectAttributes(ctx context.Context, params *s3.GetObjectAttributesInput, optFns ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error)
 	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	CopyObject(ctx context.Context, params *s3.CopyObjectInput, optFns ...func(*s3.Options)) (*s3.CopyObjectOutput, error)
 }
 
 type PresignedS3Client interface {
@@ -240,6 +242,7 @@ func (rs *PresignedS3Storage) SignDownload(ctx context.Context, bucket string, o
 	return &DownloadInfo{
 		Meta: ObjectMeta{
 			// TODO(cw): implement this if we need to support FWB with S3
+			LastModified: aws.ToTime(resp.LastModified),
 		},
 		Size: *resp.ObjectSize,
 		URL:  req.URL,
@@ -373,6 +376,64 @@ func (s3st *s3Storage) ListObjects(ctx context.Context, prefix string) ([]string
 	return res, nil
 }
 
+// s3MaxDeleteObjects is the maximum number of objects which can be deleted with a single request
+const s3MaxDeleteObjects = 1000
+
+// DeleteObjects implements DirectAccess
+func (s3st *s3Storage) DeleteObjects(ctx context.Context, names ...string) error {
+	for len(names) > 0 {
+		n := len(names)
+		if n > s3MaxDeleteObjects {
+			n = s3MaxDeleteObjects
+		}
+		objects := make([]types.ObjectIdentifier, 0, n)
+		for _, name := range names[:n] {
+			if !strings.HasPrefix(name, s3st.OwnerID+"/") {
+				return xerrors.Errorf("object %s does not belong to the owner", name)
+			}
+			objects = append(objects, types.ObjectIdentifier{Key: aws.String(name)})
+		}
+		names = names[n:]
+
+		resp, err := s3st.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s3st.Config.Bucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Errors) > 0 {
+			var errs []string
+			for _, e := range resp.Errors {
+				errs = append(errs, fmt.Sprintf("%s: %s", aws.ToString(e.Key), aws.ToString(e.Message)))
+			}
+			return xerrors.Errorf("cannot delete objects: %s", strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// CopySnapshot implements DirectAccess
+func (s3st *s3Storage) CopySnapshot(ctx context.Context, snapshot string, name string) error {
+	bkt, obj, err := ParseSnapshotName(snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = s3st.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(s3st.Config.Bucket),
+		Key:        aws.String(s3st.objectName(name)),
+		CopySource: aws.String(url.PathEscape(bkt + "/" + obj)),
+	})
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nsk) {
+		return ErrNotFound
+	}
+	return err
+}
+
 // Qualify implements DirectAccess
 func (s3st *s3Storage) Qualify(name string) string {
 	return fmt.Sprintf("%s@%s", s3st.objectName(name), s3st.Config.Bucket)
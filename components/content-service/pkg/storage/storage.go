@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"golang.org/x/xerrors"
 
@@ -28,6 +29,12 @@ const (
 
 	// DefaultBackupManifest is the name of the manifest of the regular default backup we upload
 	DefaultBackupManifest = "wsfull.json"
+
+	// DefaultBackupChunkManifest is the name of the manifest of the regular backup if it is uploaded as content-addressed chunks
+	DefaultBackupChunkManifest = "full.chunks.json"
+
+	// DefaultBackupAssembled is the name of the tarball assembled from the chunks of a chunked backup for download
+	DefaultBackupAssembled = "full.chunks.tar"
 )
 
 var (
@@ -101,6 +108,8 @@ type ObjectMeta struct {
 	OCIMediaType       string
 	Digest             string
 	UncompressedDigest string
+	// LastModified is the time the object was last written. It is zero if the storage does not report it.
+	LastModified time.Time
 }
 
 // DownloadInfo describes an object for download
@@ -138,6 +147,12 @@ type DirectDownloader interface {
 	DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error)
 }
 
+// ObjectReader reads individual objects as they are, i.e. without extracting them
+type ObjectReader interface {
+	// ReadObject returns the content of an object - if the object is not found, ErrNotFound is returned
+	ReadObject(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
 // DirectAccess represents a remote location where we can store data
 type DirectAccess interface {
 	BucketNamer
@@ -161,6 +176,13 @@ type DirectAccess interface {
 
 	// UploadInstance takes all files from a local location and uploads it to the remote storage
 	UploadInstance(ctx context.Context, source string, name string, options ...UploadOption) (bucket, obj string, err error)
+
+	// DeleteObjects deletes the objects with the given names, as returned by ListObjects. Objects which do not exist are ignored.
+	DeleteObjects(ctx context.Context, names ...string) error
+
+	// CopySnapshot copies a snapshot, given by its fully qualified name, to the remote storage without downloading it.
+	// If the snapshot is not found, ErrNotFound is returned.
+	CopySnapshot(ctx context.Context, snapshot string, name string) error
 }
 
 // UploadOptions configure remote storage upload
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/policy"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/spf13/cobra"
+)
+
+var policyRecordOpts struct {
+	Output   string
+	Duration time.Duration
+}
+
+// policyRecordCmd represents the policy record command
+var policyRecordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Records the processes of all workspaces on this node for replaying them with \"policy test\"",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		out := os.Stdout
+		if policyRecordOpts.Output != "" {
+			f, err := os.Create(policyRecordOpts.Output)
+			if err != nil {
+				log.WithError(err).Fatal("cannot create recording")
+			}
+			defer f.Close()
+			out = f
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		if policyRecordOpts.Duration > 0 {
+			ctx, cancel = context.WithTimeout(ctx, policyRecordOpts.Duration)
+			defer cancel()
+		}
+
+		det, err := detector.NewProcfsDetector()
+		if err != nil {
+			log.WithError(err).Fatal("cannot create process detector")
+		}
+		ps, err := det.DiscoverProcesses(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot start process detector")
+		}
+
+		enc := json.NewEncoder(out)
+		var n int
+		for {
+			select {
+			case <-ctx.Done():
+				log.WithField("processes", n).Info("recording finished")
+				return
+			case p, ok := <-ps:
+				if !ok {
+					return
+				}
+				err := enc.Encode(policy.RecordedProcess{Time: time.Now(), Process: p})
+				if err != nil {
+					log.WithError(err).Fatal("cannot write recording")
+				}
+				n++
+			}
+		}
+	},
+}
+
+func init() {
+	policyCmd.AddCommand(policyRecordCmd)
+
+	policyRecordCmd.Flags().StringVarP(&policyRecordOpts.Output, "output", "o", "", "file to write the recording to - defaults to stdout")
+	policyRecordCmd.Flags().DurationVar(&policyRecordOpts.Duration, "duration", 0, "stop recording after this time - records until interrupted if zero")
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/policy"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/spf13/cobra"
+)
+
+var policyTestOpts struct {
+	JSON bool
+}
+
+// policyTestCmd represents the policy test command
+var policyTestCmd = &cobra.Command{
+	Use:   "test <recording>",
+	Short: "Replays a recording against the configured policy and prints the actions it would take",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.GetConfig(cfgFile)
+		if err != nil {
+			log.WithError(err).Fatal("cannot get config")
+		}
+		if cfg.Enforcement.Policy == nil {
+			log.Fatal("no policy configured")
+		}
+
+		engine, err := policy.NewEngine(*cfg.Enforcement.Policy)
+		if err != nil {
+			log.WithError(err).Fatal("invalid policy")
+		}
+		class, err := cfg.Blocklists.Classifier()
+		if err != nil {
+			log.WithError(err).Fatal("cannot create classifier")
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			log.WithError(err).Fatal("cannot open recording")
+		}
+		defer f.Close()
+		procs, err := policy.ReadRecording(f)
+		if err != nil {
+			log.WithError(err).Fatal("cannot read recording")
+		}
+
+		decisions, err := policy.Replay(engine, class, procs)
+		if err != nil {
+			log.WithError(err).Fatal("cannot replay recording")
+		}
+
+		if policyTestOpts.JSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(decisions)
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		for _, d := range decisions {
+			fmt.Printf("%s\t%s\t%s\t%v\t%s: %s\n", d.Time.Format("15:04:05"), d.Event.Workspace.InstanceID, d.Rule, d.Actions, d.Event.Classification.Classifier, d.Event.Classification.Message)
+		}
+		fmt.Printf("%d processes, %d decisions\n", len(procs), len(decisions))
+	},
+}
+
+func init() {
+	policyCmd.AddCommand(policyTestCmd)
+
+	policyTestCmd.Flags().BoolVar(&policyTestOpts.JSON, "json", false, "print the decisions as JSON")
+}
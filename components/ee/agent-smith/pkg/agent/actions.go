@@ -5,8 +5,19 @@
 package agent
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/policy"
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	protocol "github.com/gitpod-io/gitpod/gitpod-protocol"
@@ -15,9 +26,76 @@ import (
 	"golang.org/x/sys/unix"
 	"golang.org/x/xerrors"
 	corev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/util/retry"
 )
 
+const (
+	// supervisorAPIPort is the port supervisor serves its API on in every workspace
+	supervisorAPIPort = 22999
+	// defaultWebhookTimeout is used for audit webhooks which don't configure a timeout
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+// Action is something agent smith does to a workspace when a policy rule fires
+type Action interface {
+	Execute(ctx context.Context, agent *Smith, decision policy.Decision) error
+}
+
+// ActionFunc adapts a function to an Action
+type ActionFunc func(ctx context.Context, agent *Smith, decision policy.Decision) error
+
+// Execute calls f
+func (f ActionFunc) Execute(ctx context.Context, agent *Smith, decision policy.Decision) error {
+	return f(ctx, agent, decision)
+}
+
+var (
+	actionsMu sync.RWMutex
+	actions   = map[config.ActionKind]Action{
+		config.ActionStopWorkspace: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			return agent.stopWorkspace(d.Event.Workspace.PID, d.Event.Workspace.InstanceID)
+		}),
+		config.ActionBlockUser: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			return agent.blockUser(d.Event.Workspace.OwnerID, d.Event.Workspace.WorkspaceID)
+		}),
+		config.ActionLimitCPU: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			podName, _, err := agent.workspacePod(ctx, d.Event.Workspace.InstanceID)
+			if err != nil {
+				return err
+			}
+			return agent.limitCPUUse(podName)
+		}),
+		config.ActionKillProcess: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			return killProcess(d.Event.PID, d.Event.StartTime)
+		}),
+		config.ActionNotifyUser: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			return agent.notifyUser(ctx, d.Event.Workspace.InstanceID, d.Message)
+		}),
+		config.ActionAudit: ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+			return agent.audit(ctx, d)
+		}),
+	}
+)
+
+// RegisterAction makes an action available to policy rules. Actions need to be registered
+// before agent smith is created, which validates that all actions of the policy exist.
+// Registering an action of an existing kind replaces it.
+func RegisterAction(kind config.ActionKind, action Action) {
+	actionsMu.Lock()
+	defer actionsMu.Unlock()
+
+	actions[kind] = action
+}
+
+func getAction(kind config.ActionKind) (Action, bool) {
+	actionsMu.RLock()
+	defer actionsMu.RUnlock()
+
+	a, ok := actions[kind]
+	return a, ok
+}
+
 // all functions in this file deal directly with Kubernetes and make several assumptions
 // how workspace pods look like. This code should eventually be moved to ws-manager or
 // call one of ws-manager's libraries.
@@ -94,3 +172,133 @@ func (agent *Smith) limitCPUUse(podname string) error {
 
 	return nil
 }
+
+// workspacePod finds the name and IP of the pod of a workspace instance
+func (agent *Smith) workspacePod(ctx context.Context, instanceID string) (name, ip string, err error) {
+	if agent.Kubernetes == nil {
+		return "", "", xerrors.Errorf("not connected to Kubernetes - cannot find workspace pod")
+	}
+	if instanceID == "" {
+		return "", "", xerrors.Errorf("cannot find workspace pod as instance id is empty")
+	}
+
+	pods, err := agent.Kubernetes.CoreV1().Pods(agent.Config.KubernetesNamespace).List(ctx, corev1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{wsk8s.WorkspaceIDLabel: instanceID}).String(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if len(pods.Items) == 0 {
+		return "", "", xerrors.Errorf("no pod found for workspace %s", instanceID)
+	}
+	pod := pods.Items[0]
+	return pod.Name, pod.Status.PodIP, nil
+}
+
+// killProcess kills a single process of a workspace. The start time of the process is checked before, so that
+// we never kill another process which reused the PID after the classified process exited.
+func killProcess(pid int, startTime uint64) error {
+	if pid <= 1 {
+		return xerrors.Errorf("cannot kill process with PID %d", pid)
+	}
+	if startTime == 0 {
+		return xerrors.Errorf("cannot kill process %d without knowing when it started", pid)
+	}
+
+	// the pidfd keeps referring to the same process, even if its PID is reused after we checked the start time
+	pidfd, err := unix.PidfdOpen(pid, 0)
+	if errors.Is(err, unix.ESRCH) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot open process %d: %w", pid, err)
+	}
+	defer unix.Close(pidfd)
+
+	actual, err := detector.ProcessStartTime(pid)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot get start time of process %d: %w", pid, err)
+	}
+	if actual != startTime {
+		log.WithField("pid", pid).Debug("process exited before it could be killed and its PID was reused")
+		return nil
+	}
+
+	err = unix.PidfdSendSignal(pidfd, unix.SIGKILL, nil, 0)
+	if errors.Is(err, unix.ESRCH) {
+		return nil
+	}
+	return err
+}
+
+// notifyUser shows a warning to the user through supervisor's notification service
+func (agent *Smith) notifyUser(ctx context.Context, instanceID, message string) error {
+	if message == "" {
+		return xerrors.Errorf("rule has no message - cannot notify user")
+	}
+
+	podName, podIP, err := agent.workspacePod(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if podIP == "" {
+		return xerrors.Errorf("workspace pod %s has no IP", podName)
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"level":   "WARNING",
+		"message": message,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("http://%s:%d/_supervisor/v1/notification/notify", podIP, supervisorAPIPort)
+	return postJSON(ctx, url, body, defaultWebhookTimeout)
+}
+
+// audit sends the decision to the policy webhook, or logs it if there is no webhook
+func (agent *Smith) audit(ctx context.Context, decision policy.Decision) error {
+	var webhook *config.PolicyWebhook
+	if agent.Config.Enforcement.Policy != nil {
+		webhook = agent.Config.Enforcement.Policy.Webhook
+	}
+	if webhook == nil {
+		log.WithField("decision", log.TrustedValueWrap{Value: decision}).Info("policy audit event")
+		return nil
+	}
+
+	body, err := json.Marshal(decision)
+	if err != nil {
+		return err
+	}
+	timeout := webhook.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return postJSON(ctx, webhook.URL, body, timeout)
+}
+
+func postJSON(ctx context.Context, url string, body []byte, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return xerrors.Errorf("%s returned %s", url, resp.Status)
+	}
+	return nil
+}
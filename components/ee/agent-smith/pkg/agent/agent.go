@@ -27,6 +27,7 @@ import (
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/policy"
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
@@ -43,6 +44,7 @@ type Smith struct {
 	Config           config.Config
 	GitpodAPI        gitpod.APIInterface
 	EnforcementRules map[string]config.EnforcementRules
+	Policy           *policy.Engine
 	Kubernetes       kubernetes.Interface
 	metrics          *metrics
 
@@ -199,6 +201,19 @@ func NewAgentSmith(cfg config.Config) (*Smith, error) {
 		}
 		res.EnforcementRules[repo] = rules
 	}
+	if cfg.Enforcement.Policy != nil {
+		for _, r := range cfg.Enforcement.Policy.Rules {
+			for _, a := range r.Actions {
+				if _, ok := getAction(a); !ok {
+					return nil, xerrors.Errorf("%s: unknown action %q", r.Name, a)
+				}
+			}
+		}
+		res.Policy, err = policy.NewEngine(*cfg.Enforcement.Policy)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid policy: %w", err)
+		}
+	}
 
 	return res, nil
 }
@@ -381,6 +396,18 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 				continue
 			}
 
+			if agent.Policy != nil {
+				agent.Enforce(ctx, policy.Event{
+					Workspace:      *proc.Workspace,
+					PID:            proc.PID,
+					StartTime:      proc.StartTime,
+					Path:           proc.Path,
+					CommandLine:    proc.CommandLine,
+					Classification: *cl,
+				})
+				continue
+			}
+
 			_, _ = agent.Penalize(InfringingWorkspace{
 				SupervisorPID: proc.Workspace.PID,
 				Owner:         proc.Workspace.OwnerID,
@@ -406,6 +433,15 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 				WithFields(log.OWI(file.Workspace.OwnerID, file.Workspace.WorkspaceID, file.Workspace.InstanceID)).
 				Info("filesystem signature detected")
 
+			if agent.Policy != nil {
+				agent.Enforce(ctx, policy.Event{
+					Workspace:      *file.Workspace,
+					Path:           file.Path,
+					Classification: *cl,
+				})
+				continue
+			}
+
 			_, _ = agent.Penalize(InfringingWorkspace{
 				SupervisorPID: file.Workspace.PID,
 				Owner:         file.Workspace.OwnerID,
@@ -469,6 +505,31 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 	return penalty, nil
 }
 
+// Enforce evaluates a classifier match against the policy and executes the actions of all rules which fire
+func (agent *Smith) Enforce(ctx context.Context, ev policy.Event) []policy.Decision {
+	owi := log.OWI(ev.Workspace.OwnerID, ev.Workspace.WorkspaceID, ev.Workspace.InstanceID)
+
+	decisions := agent.Policy.Evaluate(ev, time.Now())
+	for _, d := range decisions {
+		for _, kind := range d.Actions {
+			action, ok := getAction(kind)
+			if !ok {
+				log.WithFields(owi).WithField("rule", d.Rule).WithField("action", kind).Error("unknown policy action")
+				continue
+			}
+
+			log.WithField("classification", log.TrustedValueWrap{Value: ev.Classification}).WithField("rule", d.Rule).WithField("action", kind).WithFields(owi).Info("executing policy action")
+			agent.metrics.penaltyAttempts.WithLabelValues(string(kind)).Inc()
+			err := action.Execute(ctx, agent, d)
+			if err != nil {
+				log.WithError(err).WithField("rule", d.Rule).WithField("action", kind).WithFields(owi).Warn("failed to execute policy action")
+				agent.metrics.penaltyFailures.WithLabelValues(string(kind), err.Error()).Inc()
+			}
+		}
+	}
+	return decisions
+}
+
 func findEnforcementRules(rules map[string]config.EnforcementRules, remoteURL string) config.EnforcementRules {
 	res, ok := rules[remoteURL]
 	if ok {
@@ -5,11 +5,18 @@
 package agent
 
 import (
+	"context"
+	"os/exec"
 	"sort"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/classifier"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/policy"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -94,3 +101,80 @@ func BenchmarkFindEnforcementRules(b *testing.B) {
 		findEnforcementRules(rules, "foobar")
 	}
 }
+
+func TestEnforce(t *testing.T) {
+	const actionRecord config.ActionKind = "record"
+	var executed []string
+	RegisterAction(actionRecord, ActionFunc(func(ctx context.Context, agent *Smith, d policy.Decision) error {
+		executed = append(executed, d.Rule+"/"+d.Event.Workspace.InstanceID)
+		return nil
+	}))
+	t.Cleanup(func() {
+		actionsMu.Lock()
+		defer actionsMu.Unlock()
+
+		delete(actions, actionRecord)
+	})
+
+	engine, err := policy.NewEngine(config.Policy{Rules: []config.PolicyRule{
+		{Name: "very", Match: config.PolicyMatch{Severity: []string{"very"}}, Actions: []config.ActionKind{actionRecord}},
+		{Name: "twice", Threshold: 2, Actions: []config.ActionKind{actionRecord}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	agent := &Smith{Policy: engine, metrics: newAgentMetrics()}
+
+	for _, lvl := range []classifier.Level{classifier.LevelAudit, classifier.LevelVery} {
+		agent.Enforce(context.Background(), policy.Event{
+			Workspace:      common.Workspace{InstanceID: "foo"},
+			Classification: classifier.Classification{Level: lvl},
+		})
+	}
+
+	if diff := cmp.Diff([]string{"very/foo", "twice/foo"}, executed); diff != "" {
+		t.Errorf("unexpected actions (-want +got):\n%s", diff)
+	}
+}
+
+func TestKillProcess(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	err := cmd.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = cmd.Process.Kill() }()
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	startTime, err := detector.ProcessStartTime(cmd.Process.Pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a process which reused the PID of the classified one must survive
+	err = killProcess(cmd.Process.Pid, startTime+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-exited:
+		t.Fatal("killed a process whose start time does not match")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	err = killProcess(cmd.Process.Pid, startTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = <-exited
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); !ok || status.Signal() != syscall.SIGKILL {
+		t.Errorf("expected the process to be killed, got %v", err)
+	}
+
+	// the process is gone already
+	err = killProcess(cmd.Process.Pid, startTime)
+	if err != nil {
+		t.Errorf("unexpected error for a process which exited: %v", err)
+	}
+}
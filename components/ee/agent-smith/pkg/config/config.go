@@ -61,6 +61,9 @@ type Enforcement struct {
 	Default         *EnforcementRules           `json:"default,omitempty"`
 	PerRepo         map[string]EnforcementRules `json:"perRepo,omitempty"`
 	CPULimitPenalty string                      `json:"cpuLimitPenalty,omitempty"`
+
+	// Policy replaces the default and per-repo rules with declarative rules and actions
+	Policy *Policy `json:"policy,omitempty"`
 }
 
 // EnforcementRules matches a infringement with a particular penalty
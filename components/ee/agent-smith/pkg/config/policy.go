@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package config
+
+import (
+	"regexp"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"golang.org/x/xerrors"
+)
+
+// ActionKind names an action agent smith takes when a policy rule fires
+type ActionKind string
+
+const (
+	// ActionStopWorkspace stops the workspace without grace period
+	ActionStopWorkspace ActionKind = "stop workspace"
+	// ActionBlockUser blocks the owner of the workspace
+	ActionBlockUser ActionKind = "block user"
+	// ActionLimitCPU limits the CPU the workspace can use to Enforcement.CPULimitPenalty
+	ActionLimitCPU ActionKind = "limit CPU"
+	// ActionKillProcess kills the matching process
+	ActionKillProcess ActionKind = "kill process"
+	// ActionNotifyUser shows the rule's message to the user in their IDE
+	ActionNotifyUser ActionKind = "notify user"
+	// ActionAudit emits an audit event to the policy webhook, or logs it if there's none
+	ActionAudit ActionKind = "audit"
+)
+
+// Policy maps classifier matches to actions. If a policy is configured it replaces
+// the default and per-repo enforcement rules.
+type Policy struct {
+	Rules   []PolicyRule   `json:"rules"`
+	Webhook *PolicyWebhook `json:"webhook,omitempty"`
+}
+
+// PolicyRule fires its actions once a workspace matched Threshold times within Window.
+// After a rule fired for a workspace it won't fire again for that workspace until Cooldown has passed.
+type PolicyRule struct {
+	Name  string      `json:"name"`
+	Match PolicyMatch `json:"match"`
+
+	// Threshold is the number of matches needed to fire the rule. Defaults to 1.
+	Threshold int `json:"threshold,omitempty"`
+	// Window is the time within which Threshold matches need to occur. Zero means matches never expire.
+	Window   Duration `json:"window,omitempty"`
+	Cooldown Duration `json:"cooldown,omitempty"`
+
+	Actions []ActionKind `json:"actions"`
+	// Message is shown to the user by the notify user action
+	Message string `json:"message,omitempty"`
+}
+
+// PolicyMatch selects classifications a rule applies to. Empty fields match everything.
+type PolicyMatch struct {
+	// Severity lists the severities to match. Use "audit" for the audit severity.
+	Severity []string `json:"severity,omitempty"`
+	// Classifier is the name of the classifier which produced the match, e.g. "signature"
+	Classifier string `json:"classifier,omitempty"`
+	// Message is a regular expression matched against the classification message
+	Message string `json:"message,omitempty"`
+	// Repo matches the remote origin URL of the workspace case-insensitively and supports the same wildcards as perRepo enforcement rules
+	Repo string `json:"repo,omitempty"`
+}
+
+// PolicyWebhook receives audit events as JSON POST requests
+type PolicyWebhook struct {
+	URL     string   `json:"url"`
+	Timeout Duration `json:"timeout,omitempty"`
+}
+
+// Severities returns the severities this match applies to
+func (m PolicyMatch) Severities() []common.Severity {
+	res := make([]common.Severity, 0, len(m.Severity))
+	for _, s := range m.Severity {
+		if s == "audit" {
+			s = string(common.SeverityAudit)
+		}
+		res = append(res, common.Severity(s))
+	}
+	return res
+}
+
+// Validate returns an error if the policy is invalid for some reason
+func (p *Policy) Validate() error {
+	validSeverities := map[common.Severity]struct{}{
+		common.SeverityBarely: {},
+		common.SeverityAudit:  {},
+		common.SeverityVery:   {},
+	}
+
+	names := make(map[string]struct{}, len(p.Rules))
+	for i, r := range p.Rules {
+		if r.Name == "" {
+			return xerrors.Errorf("rule %d: name is required", i)
+		}
+		if _, exists := names[r.Name]; exists {
+			return xerrors.Errorf("%s: duplicate rule name", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		for _, s := range r.Match.Severities() {
+			if _, ok := validSeverities[s]; !ok {
+				return xerrors.Errorf("%s: unknown severity %q", r.Name, s)
+			}
+		}
+		if r.Match.Message != "" {
+			if _, err := regexp.Compile(r.Match.Message); err != nil {
+				return xerrors.Errorf("%s: invalid message expression: %w", r.Name, err)
+			}
+		}
+		if r.Threshold < 0 {
+			return xerrors.Errorf("%s: threshold must not be negative", r.Name)
+		}
+		if r.Window.Duration < 0 || r.Cooldown.Duration < 0 {
+			return xerrors.Errorf("%s: window and cooldown must not be negative", r.Name)
+		}
+		if len(r.Actions) == 0 {
+			return xerrors.Errorf("%s: at least one action is required", r.Name)
+		}
+	}
+
+	if p.Webhook != nil && p.Webhook.URL == "" {
+		return xerrors.Errorf("webhook: url is required")
+	}
+
+	return nil
+}
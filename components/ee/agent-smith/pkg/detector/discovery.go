@@ -13,10 +13,14 @@ import (
 
 // Process describes a process ont the node that might warant closer inspection
 type Process struct {
+	PID         int
 	Path        string
 	CommandLine []string
 	Kind        ProcessKind
 	Workspace   *common.Workspace
+	// StartTime is the time the process started after system boot in clock ticks. Together with the PID
+	// it identifies the process, as PIDs are reused.
+	StartTime uint64
 }
 
 // ProcessDetector discovers processes on the node
@@ -78,6 +78,7 @@ func (fs realProcfs) Discover() map[int]*process {
 		proc.Parent = parent
 		proc.Kind = ProcessUnknown
 		proc.Path = path
+		proc.StartTime = stat.Starttime
 		parent.Children = append(parent.Children, proc)
 
 		binary.LittleEndian.PutUint64(digest[0:8], uint64(p.PID))
@@ -95,6 +96,16 @@ type stat struct {
 	Starttime uint64
 }
 
+// ProcessStartTime returns the time the process started after system boot in clock ticks.
+// As PIDs are reused, only the PID and start time together identify a process.
+func ProcessStartTime(pid int) (uint64, error) {
+	stat, err := statProc(pid)
+	if err != nil {
+		return 0, err
+	}
+	return stat.Starttime, nil
+}
+
 // statProc returns a limited set of /proc/<pid>/stat content.
 func statProc(pid int) (*stat, error) {
 	f, err := os.Open(fmt.Sprintf("/proc/%d/stat", pid))
@@ -336,6 +347,7 @@ type process struct {
 	Cmdline   []string
 	Workspace *common.Workspace
 	Hash      uint64
+	StartTime uint64
 }
 
 func (det *ProcfsDetector) run(processes chan<- Process) {
@@ -375,10 +387,12 @@ func (det *ProcfsDetector) run(processes chan<- Process) {
 		det.cache.Add(p.Hash, struct{}{})
 
 		proc := Process{
+			PID:         p.PID,
 			Path:        p.Path,
 			CommandLine: p.Cmdline,
 			Kind:        p.Kind,
 			Workspace:   p.Workspace,
+			StartTime:   p.StartTime,
 		}
 		log.WithField("proc", proc).Debug("found process")
 		processes <- proc
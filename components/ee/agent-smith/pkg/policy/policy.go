@@ -0,0 +1,218 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package policy
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/classifier"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// defaultRetention is how long we keep the state of rules without window and cooldown
+	defaultRetention = 24 * time.Hour
+	// pruneInterval is how often we drop the state of idle workspaces
+	pruneInterval = time.Minute
+)
+
+// Event is a classifier match in a workspace
+type Event struct {
+	Workspace      common.Workspace          `json:"workspace"`
+	PID            int                       `json:"pid,omitempty"`
+	StartTime      uint64                    `json:"startTime,omitempty"`
+	Path           string                    `json:"path,omitempty"`
+	CommandLine    []string                  `json:"commandLine,omitempty"`
+	Classification classifier.Classification `json:"classification"`
+}
+
+// Decision is a rule firing for an event
+type Decision struct {
+	Rule    string              `json:"rule"`
+	Actions []config.ActionKind `json:"actions"`
+	Message string              `json:"message,omitempty"`
+	Time    time.Time           `json:"time"`
+	Event   Event               `json:"event"`
+}
+
+// Engine evaluates events against the rules of a policy. It keeps track of
+// matches per rule and workspace to apply thresholds and cooldowns.
+type Engine struct {
+	rules []rule
+
+	mu        sync.Mutex
+	state     map[stateKey]*ruleState
+	lastPrune time.Time
+}
+
+type rule struct {
+	config.PolicyRule
+	severities map[common.Severity]struct{}
+	message    *regexp.Regexp
+	retention  time.Duration
+}
+
+type stateKey struct {
+	Rule       int
+	InstanceID string
+}
+
+type ruleState struct {
+	hits      []time.Time
+	lastFired time.Time
+	lastSeen  time.Time
+}
+
+// NewEngine produces a new policy engine. The policy is validated first.
+func NewEngine(p config.Policy) (*Engine, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules := make([]rule, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		res := rule{PolicyRule: r}
+		if res.Threshold == 0 {
+			res.Threshold = 1
+		}
+		if len(r.Match.Severity) > 0 {
+			res.severities = make(map[common.Severity]struct{}, len(r.Match.Severity))
+			for _, s := range r.Match.Severities() {
+				res.severities[s] = struct{}{}
+			}
+		}
+		if r.Match.Message != "" {
+			expr, err := regexp.Compile(r.Match.Message)
+			if err != nil {
+				return nil, xerrors.Errorf("%s: invalid message expression: %w", r.Name, err)
+			}
+			res.message = expr
+		}
+		res.retention = r.Window.Duration
+		if r.Cooldown.Duration > res.retention {
+			res.retention = r.Cooldown.Duration
+		}
+		if res.retention == 0 {
+			res.retention = defaultRetention
+		}
+		rules = append(rules, res)
+	}
+
+	return &Engine{
+		rules: rules,
+		state: make(map[stateKey]*ruleState),
+	}, nil
+}
+
+// Evaluate records the event and returns the decisions of all rules which fire because of it.
+// Rules are evaluated independently and in the order they were configured.
+func (e *Engine) Evaluate(ev Event, now time.Time) []Decision {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if now.Sub(e.lastPrune) > pruneInterval {
+		e.prune(now)
+		e.lastPrune = now
+	}
+
+	var res []Decision
+	for i, r := range e.rules {
+		if !r.matches(ev) {
+			continue
+		}
+
+		key := stateKey{Rule: i, InstanceID: ev.Workspace.InstanceID}
+		st, ok := e.state[key]
+		if !ok {
+			st = &ruleState{}
+			e.state[key] = st
+		}
+		st.lastSeen = now
+
+		if !st.lastFired.IsZero() && now.Sub(st.lastFired) < r.Cooldown.Duration {
+			continue
+		}
+
+		st.hits = append(st.hits, now)
+		if r.Window.Duration > 0 {
+			var idx int
+			for idx < len(st.hits) && now.Sub(st.hits[idx]) > r.Window.Duration {
+				idx++
+			}
+			st.hits = st.hits[idx:]
+		}
+		if len(st.hits) < r.Threshold {
+			continue
+		}
+
+		st.hits = nil
+		st.lastFired = now
+		res = append(res, Decision{
+			Rule:    r.Name,
+			Actions: r.Actions,
+			Message: r.Message,
+			Time:    now,
+			Event:   ev,
+		})
+	}
+	return res
+}
+
+// prune drops the state of workspaces we haven't seen for longer than the rule cares about.
+// Callers must hold the lock.
+func (e *Engine) prune(now time.Time) {
+	for k, st := range e.state {
+		if now.Sub(st.lastSeen) > e.rules[k.Rule].retention {
+			delete(e.state, k)
+		}
+	}
+}
+
+func (r *rule) matches(ev Event) bool {
+	if ev.Classification.Level == classifier.LevelNoMatch {
+		return false
+	}
+	if r.severities != nil {
+		if _, ok := r.severities[common.Severity(ev.Classification.Level)]; !ok {
+			return false
+		}
+	}
+	if r.Match.Classifier != "" && r.Match.Classifier != ev.Classification.Classifier {
+		return false
+	}
+	if r.message != nil && !r.message.MatchString(ev.Classification.Message) {
+		return false
+	}
+	if r.Match.Repo != "" && !matchesRepo(r.Match.Repo, ev.Workspace.GitURL) {
+		return false
+	}
+	return true
+}
+
+// matchesRepo matches a remote origin URL against a pattern which may start and/or end with a wildcard.
+// Matching is case-insensitive, as hosts and the repository names of the common Git hosts are.
+func matchesRepo(pattern, remoteURL string) bool {
+	pattern, url := strings.ToLower(pattern), strings.ToLower(remoteURL)
+	if pattern == url {
+		return true
+	}
+
+	hp, hs := strings.HasPrefix(pattern, "*"), strings.HasSuffix(pattern, "*")
+	p := strings.Trim(pattern, "*")
+	switch {
+	case hp && hs:
+		return strings.Contains(url, p)
+	case hp:
+		return strings.HasSuffix(url, p)
+	case hs:
+		return strings.HasPrefix(url, p)
+	}
+	return false
+}
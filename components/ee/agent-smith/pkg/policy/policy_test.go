@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package policy
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/classifier"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(instanceID string, level classifier.Level, message string) Event {
+		return Event{
+			Workspace: common.Workspace{InstanceID: instanceID, GitURL: "https://github.com/gitpod-io/gitpod"},
+			Classification: classifier.Classification{
+				Level:      level,
+				Classifier: classifier.ClassifierCommandline,
+				Message:    message,
+			},
+		}
+	}
+	type step struct {
+		Offset time.Duration
+		Event  Event
+	}
+
+	tests := []struct {
+		Desc        string
+		Rules       []config.PolicyRule
+		Steps       []step
+		Expectation []string
+	}{
+		{
+			Desc:  "first match fires",
+			Rules: []config.PolicyRule{{Name: "stop", Actions: []config.ActionKind{config.ActionStopWorkspace}}},
+			Steps: []step{
+				{Event: event("a", classifier.LevelVery, `matched "miner"`)},
+			},
+			Expectation: []string{"0s stop a"},
+		},
+		{
+			Desc:  "no-match never fires",
+			Rules: []config.PolicyRule{{Name: "stop", Actions: []config.ActionKind{config.ActionStopWorkspace}}},
+			Steps: []step{
+				{Event: event("a", classifier.LevelNoMatch, "")},
+			},
+		},
+		{
+			Desc: "severity",
+			Rules: []config.PolicyRule{
+				{Name: "audit", Match: config.PolicyMatch{Severity: []string{"audit"}}, Actions: []config.ActionKind{config.ActionAudit}},
+				{Name: "very", Match: config.PolicyMatch{Severity: []string{"very"}}, Actions: []config.ActionKind{config.ActionStopWorkspace}},
+			},
+			Steps: []step{
+				{Event: event("a", classifier.LevelAudit, "")},
+				{Event: event("b", classifier.LevelVery, "")},
+				{Event: event("c", classifier.LevelBarely, "")},
+			},
+			Expectation: []string{"0s audit a", "0s very b"},
+		},
+		{
+			Desc: "classifier message and repo",
+			Rules: []config.PolicyRule{
+				{Name: "miner", Match: config.PolicyMatch{Classifier: "commandline", Message: "miner"}, Actions: []config.ActionKind{config.ActionKillProcess}},
+				{Name: "other-repo", Match: config.PolicyMatch{Repo: "*gitlab.com*"}, Actions: []config.ActionKind{config.ActionKillProcess}},
+				{Name: "this-repo", Match: config.PolicyMatch{Repo: "*/gitpod-io/gitpod"}, Actions: []config.ActionKind{config.ActionNotifyUser}},
+			},
+			Steps: []step{
+				{Event: event("a", classifier.LevelAudit, `matched "xmrig"`)},
+				{Event: event("b", classifier.LevelAudit, `matched "miner"`)},
+			},
+			Expectation: []string{"0s this-repo a", "0s miner b", "0s this-repo b"},
+		},
+		{
+			Desc: "threshold within window",
+			Rules: []config.PolicyRule{
+				{Name: "stop", Threshold: 3, Window: config.Duration{Duration: time.Minute}, Actions: []config.ActionKind{config.ActionStopWorkspace}},
+			},
+			Steps: []step{
+				{Offset: 0, Event: event("a", classifier.LevelAudit, "")},
+				{Offset: 40 * time.Second, Event: event("a", classifier.LevelAudit, "")},
+				// the first match has expired by now
+				{Offset: 70 * time.Second, Event: event("a", classifier.LevelAudit, "")},
+				{Offset: 80 * time.Second, Event: event("b", classifier.LevelAudit, "")},
+				{Offset: 95 * time.Second, Event: event("a", classifier.LevelAudit, "")},
+			},
+			Expectation: []string{"1m35s stop a"},
+		},
+		{
+			Desc: "cooldown",
+			Rules: []config.PolicyRule{
+				{Name: "notify", Cooldown: config.Duration{Duration: 10 * time.Minute}, Actions: []config.ActionKind{config.ActionNotifyUser}},
+			},
+			Steps: []step{
+				{Offset: 0, Event: event("a", classifier.LevelBarely, "")},
+				{Offset: time.Minute, Event: event("a", classifier.LevelBarely, "")},
+				{Offset: time.Minute, Event: event("b", classifier.LevelBarely, "")},
+				{Offset: 11 * time.Minute, Event: event("a", classifier.LevelBarely, "")},
+			},
+			Expectation: []string{"0s notify a", "1m0s notify b", "11m0s notify a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			engine, err := NewEngine(config.Policy{Rules: test.Rules})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act []string
+			for _, s := range test.Steps {
+				for _, d := range engine.Evaluate(s.Event, start.Add(s.Offset)) {
+					act = append(act, strings.Join([]string{d.Time.Sub(start).String(), d.Rule, d.Event.Workspace.InstanceID}, " "))
+				}
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected decisions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatchesRepo(t *testing.T) {
+	tests := []struct {
+		Pattern     string
+		URL         string
+		Expectation bool
+	}{
+		{Pattern: "https://github.com/gitpod-io/gitpod", URL: "https://github.com/gitpod-io/gitpod", Expectation: true},
+		{Pattern: "https://github.com/gitpod-io/gitpod", URL: "https://github.com/gitpod-io/gitpod-test", Expectation: false},
+		{Pattern: "*/gitpod-io/gitpod", URL: "https://github.com/gitpod-io/gitpod", Expectation: true},
+		{Pattern: "https://github.com/*", URL: "https://github.com/gitpod-io/gitpod", Expectation: true},
+		{Pattern: "*gitlab.com*", URL: "https://github.com/gitpod-io/gitpod", Expectation: false},
+		{Pattern: "*/Gitpod-IO/*", URL: "https://github.com/gitpod-io/gitpod", Expectation: true},
+		{Pattern: "*/gitpod-io/gitpod", URL: "https://GitHub.com/Gitpod-IO/Gitpod", Expectation: true},
+		{Pattern: "https://github.com/Gitpod-IO/Gitpod", URL: "https://github.com/gitpod-io/gitpod", Expectation: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Pattern+" "+test.URL, func(t *testing.T) {
+			if act := matchesRepo(test.Pattern, test.URL); act != test.Expectation {
+				t.Errorf("unexpected match: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestNewEngineInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		Desc   string
+		Policy config.Policy
+	}{
+		{Desc: "missing name", Policy: config.Policy{Rules: []config.PolicyRule{{Actions: []config.ActionKind{config.ActionAudit}}}}},
+		{Desc: "missing actions", Policy: config.Policy{Rules: []config.PolicyRule{{Name: "foo"}}}},
+		{Desc: "unknown severity", Policy: config.Policy{Rules: []config.PolicyRule{{Name: "foo", Match: config.PolicyMatch{Severity: []string{"extremely"}}, Actions: []config.ActionKind{config.ActionAudit}}}}},
+		{Desc: "invalid message", Policy: config.Policy{Rules: []config.PolicyRule{{Name: "foo", Match: config.PolicyMatch{Message: "("}, Actions: []config.ActionKind{config.ActionAudit}}}}},
+		{Desc: "duplicate name", Policy: config.Policy{Rules: []config.PolicyRule{
+			{Name: "foo", Actions: []config.ActionKind{config.ActionAudit}},
+			{Name: "foo", Actions: []config.ActionKind{config.ActionAudit}},
+		}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			_, err := NewEngine(test.Policy)
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	recording := `{"time":"2026-01-01T00:00:00Z","process":{"PID":10,"Path":"/usr/bin/bash","CommandLine":["bash"],"Kind":3,"Workspace":{"InstanceID":"a"}}}
+{"time":"2026-01-01T00:00:10Z","process":{"PID":11,"Path":"/tmp/xmrig","CommandLine":["xmrig","--donate-level=1"],"Kind":3,"Workspace":{"InstanceID":"a"}}}
+{"time":"2026-01-01T00:00:20Z","process":{"PID":12,"Path":"/tmp/xmrig","CommandLine":["xmrig","--donate-level=1"],"Kind":3,"Workspace":{"InstanceID":"a"}}}
+`
+	procs, err := ReadRecording(strings.NewReader(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 3 {
+		t.Fatalf("expected three recorded processes, got %d", len(procs))
+	}
+
+	class, err := classifier.NewCommandlineClassifier("test", classifier.LevelAudit, nil, []string{"xmrig"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewEngine(config.Policy{Rules: []config.PolicyRule{
+		{Name: "kill", Actions: []config.ActionKind{config.ActionKillProcess}},
+		{Name: "stop", Threshold: 2, Window: config.Duration{Duration: time.Minute}, Actions: []config.ActionKind{config.ActionStopWorkspace}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decisions, err := Replay(engine, class, procs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Rule string
+		PID  int
+	}
+	var act []result
+	for _, d := range decisions {
+		act = append(act, result{Rule: d.Rule, PID: d.Event.PID})
+	}
+	exp := []result{{"kill", 11}, {"kill", 12}, {"stop", 12}}
+	if diff := cmp.Diff(exp, act); diff != "" {
+		t.Errorf("unexpected decisions (-want +got):\n%s", diff)
+	}
+
+	// the detector kind survives the round trip through the recording
+	if procs[1].Process.Kind != detector.ProcessUserWorkload {
+		t.Errorf("unexpected process kind %v", procs[1].Process.Kind)
+	}
+}
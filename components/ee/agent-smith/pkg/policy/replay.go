@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package policy
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/classifier"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"golang.org/x/xerrors"
+)
+
+// RecordedProcess is a process the detector discovered at some point in time.
+// Recordings let us test a policy offline against real process trees.
+type RecordedProcess struct {
+	Time    time.Time        `json:"time"`
+	Process detector.Process `json:"process"`
+}
+
+// ReadRecording reads a recording of newline-delimited JSON encoded processes
+func ReadRecording(r io.Reader) ([]RecordedProcess, error) {
+	var res []RecordedProcess
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var p RecordedProcess
+		err := dec.Decode(&p)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read recording: %w", err)
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// Replay classifies the recorded processes and evaluates them against the engine in order.
+// It returns the decisions the policy would have produced, without acting on any of them.
+func Replay(engine *Engine, class classifier.ProcessClassifier, procs []RecordedProcess) ([]Decision, error) {
+	var res []Decision
+	for _, p := range procs {
+		cl, err := class.Matches(p.Process.Path, p.Process.CommandLine)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot classify %s: %w", p.Process.Path, err)
+		}
+		if cl == nil || cl.Level == classifier.LevelNoMatch {
+			continue
+		}
+
+		var ws common.Workspace
+		if p.Process.Workspace != nil {
+			ws = *p.Process.Workspace
+		}
+		res = append(res, engine.Evaluate(Event{
+			Workspace:      ws,
+			PID:            p.Process.PID,
+			Path:           p.Process.Path,
+			CommandLine:    p.Process.CommandLine,
+			Classification: *cl,
+		}, p.Time)...)
+	}
+	return res, nil
+}
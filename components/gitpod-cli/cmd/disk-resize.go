@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/gitpod"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	serverapi "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/sourcegraph/jsonrpc2"
+	"github.com/spf13/cobra"
+)
+
+// resizeDiskCmd grows the disk of the current workspace
+var resizeDiskCmd = &cobra.Command{
+	Use:   "resize <size>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Grow the disk of the current workspace",
+	Long: `Grow the disk of the current workspace without restarting it.
+
+Size is a Kubernetes quantity, e.g. 50Gi. The disk can only grow, up to the maximum of the workspace class.`,
+	Example: fmt.Sprintf("%s %s resize 50Gi", rootCmd.Use, diskCmd.Use),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+		defer cancel()
+		wsInfo, err := gitpod.GetWSInfo(ctx)
+		if err != nil {
+			return err
+		}
+		client, err := gitpod.ConnectToServer(ctx, wsInfo, []string{
+			"function:resizeWorkspaceDisk",
+			"resource:workspace::" + wsInfo.WorkspaceId + "::get/update",
+		})
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		err = client.ResizeWorkspaceDisk(ctx, wsInfo.WorkspaceId, args[0])
+		if err != nil {
+			if err, ok := err.(*jsonrpc2.Error); ok && (err.Code == serverapi.BAD_REQUEST || err.Code == serverapi.PRECONDITION_FAILED) {
+				return GpError{Err: err, OutCome: utils.Outcome_UserErr, Message: "Cannot resize workspace disk: " + err.Message, ErrorCode: utils.UserErrorCode_InvalidArguments}
+			}
+			return err
+		}
+		fmt.Printf("Workspace disk is being resized to %s. Run `%s top` to see the new size.\n", args[0], rootCmd.Use)
+		return nil
+	},
+}
+
+func init() {
+	diskCmd.AddCommand(resizeDiskCmd)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// diskCmd commands collection
+var diskCmd = &cobra.Command{
+	Use:   "disk",
+	Short: "Interact with the disk of the current workspace",
+}
+
+func init() {
+	rootCmd.AddCommand(diskCmd)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var portsInspectOpts struct {
+	Enable  bool
+	Disable bool
+	Show    string
+	Replay  string
+}
+
+// portsInspectCmd captures, shows and replays the HTTP requests of a port
+var portsInspectCmd = &cobra.Command{
+	Use:   "inspect <port>",
+	Short: "Capture, show and replay the HTTP requests sent to a port",
+	Long: `Capture, show and replay the HTTP requests sent to a port through its URL.
+
+Use --enable to start capturing the requests of a port. Gitpod keeps the most recent requests and responses
+with credentials and other sensitive values scrubbed. Use --disable to stop capturing and drop the captured requests.
+
+Use --replay to send a captured request again. It is sent as it was captured, i.e. with the scrubbed values, so that
+authenticated or signed requests like webhooks are likely rejected. Requests whose body was not captured entirely
+cannot be replayed.`,
+	Example: `  gp ports inspect 3000 --enable
+  gp ports inspect 3000
+  gp ports inspect 3000 --show 4
+  gp ports inspect 3000 --replay 4`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		port, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if portsInspectOpts.Enable && portsInspectOpts.Disable {
+			return GpError{Err: xerrors.Errorf("--enable and --disable cannot be used together"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 40*time.Second)
+		defer cancel()
+
+		client, err := supervisor.New(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if portsInspectOpts.Enable || portsInspectOpts.Disable {
+			_, err = client.Port.SetInspection(ctx, &api.SetPortInspectionRequest{Port: uint32(port), Enabled: portsInspectOpts.Enable})
+			if err != nil {
+				return xerrors.Errorf("cannot change inspection of port %d: %w", port, err)
+			}
+			if portsInspectOpts.Enable {
+				fmt.Printf("requests to port %d are now captured\n", port)
+			} else {
+				fmt.Printf("requests to port %d are no longer captured\n", port)
+			}
+			return nil
+		}
+
+		if portsInspectOpts.Replay != "" {
+			resp, err := client.Port.ReplayRequest(ctx, &api.ReplayPortRequestRequest{Port: uint32(port), Id: portsInspectOpts.Replay})
+			if err != nil {
+				return inspectError(port, err)
+			}
+			printExchange(resp.Exchange)
+			return nil
+		}
+
+		resp, err := client.Port.ListRequests(ctx, &api.ListPortRequestsRequest{Port: uint32(port)})
+		if err != nil {
+			return inspectError(port, err)
+		}
+		if portsInspectOpts.Show != "" {
+			for _, e := range resp.Exchanges {
+				if e.Id == portsInspectOpts.Show {
+					printExchange(e)
+					return nil
+				}
+			}
+			return GpError{Err: xerrors.Errorf("request %s was not captured", portsInspectOpts.Show), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		if len(resp.Exchanges) == 0 {
+			fmt.Printf("No requests to port %d have been captured yet.\n", port)
+			return nil
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ID", "Time", "Method", "URI", "Status", "Duration"})
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetCenterSeparator("|")
+		for _, e := range resp.Exchanges {
+			id := e.Id
+			if e.Replayed {
+				id += " (replay)"
+			}
+			status := e.Error
+			if e.Response != nil {
+				status = strconv.Itoa(int(e.Response.StatusCode))
+			}
+			table.Append([]string{
+				id,
+				e.Time.AsTime().Local().Format(time.TimeOnly),
+				e.Request.GetMethod(),
+				e.Request.GetUri(),
+				status,
+				e.Duration.AsDuration().Round(time.Millisecond).String(),
+			})
+		}
+		table.Render()
+		return nil
+	},
+}
+
+func inspectError(port uint64, err error) error {
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.NotFound:
+		msg := status.Convert(err).Message()
+		if strings.Contains(msg, "not inspected") {
+			msg = fmt.Sprintf("requests to port %d are not captured, use `gp ports inspect %d --enable`", port, port)
+		}
+		return GpError{Err: xerrors.New(msg), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+	default:
+		return err
+	}
+}
+
+func printExchange(e *api.PortExchange) {
+	fmt.Printf("# %s at %s, took %s\n", e.Id, e.Time.AsTime().Local().Format(time.RFC1123), e.Duration.AsDuration().Round(time.Millisecond))
+	fmt.Printf("%s %s\n", e.Request.GetMethod(), e.Request.GetUri())
+	printCaptured(e.Request.GetHeaders(), e.Request.GetBody(), e.Request.GetBodyTruncated())
+	fmt.Println()
+
+	if e.Error != "" {
+		fmt.Printf("error: %s\n", e.Error)
+	}
+	if e.Response == nil {
+		return
+	}
+	fmt.Printf("%d\n", e.Response.StatusCode)
+	printCaptured(e.Response.Headers, e.Response.Body, e.Response.BodyTruncated)
+}
+
+func printCaptured(headers []*api.HTTPHeader, body []byte, truncated bool) {
+	for _, h := range headers {
+		for _, v := range h.Values {
+			fmt.Printf("%s: %s\n", h.Name, v)
+		}
+	}
+	if len(body) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println(string(body))
+	if truncated {
+		fmt.Println("[truncated]")
+	}
+}
+
+func init() {
+	portsCmd.AddCommand(portsInspectCmd)
+
+	portsInspectCmd.Flags().BoolVar(&portsInspectOpts.Enable, "enable", false, "start capturing the requests of the port")
+	portsInspectCmd.Flags().BoolVar(&portsInspectOpts.Disable, "disable", false, "stop capturing the requests of the port and drop the captured ones")
+	portsInspectCmd.Flags().StringVar(&portsInspectOpts.Show, "show", "", "show the captured request with the given ID")
+	portsInspectCmd.Flags().StringVar(&portsInspectOpts.Replay, "replay", "", "send the captured request with the given ID to the port again")
+}
@@ -42,7 +42,7 @@ var listPortsCmd = &cobra.Command{
 		}
 
 		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"Port", "Status", "Protocol", "URL", "Name & Description"})
+		table.SetHeader([]string{"Port", "Status", "Protocol", "URL", "Process", "Name & Description"})
 		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
 		table.SetCenterSeparator("|")
 
@@ -75,6 +75,10 @@ var listPortsCmd = &cobra.Command{
 					status = "open (private)"
 					statusColor = tablewriter.FgHiCyanColor
 				}
+				if port.Exposed.Visibility == api.PortVisibility_organization {
+					status = "open (organization)"
+					statusColor = tablewriter.FgHiCyanColor
+				}
 			} else if port.Tunneled != nil {
 				if port.Tunneled.Visibility == api.TunnelVisiblity(api.TunnelVisiblity_value["network"]) {
 					status = "open on all interfaces"
@@ -95,13 +99,18 @@ var listPortsCmd = &cobra.Command{
 				}
 			}
 
+			process := ""
+			if port.OwnerPid != 0 {
+				process = fmt.Sprintf("%d %s", port.OwnerPid, truncate(port.OwnerCommand, maxProcessCommandLength))
+			}
+
 			colors := []tablewriter.Colors{}
 			if !noColor && utils.ColorsEnabled() {
 				colors = []tablewriter.Colors{{}, {statusColor}, {}, {}}
 			}
 
 			table.Rich(
-				[]string{fmt.Sprint(port.LocalPort), status, port.Exposed.Protocol.String(), exposedUrl, nameAndDescription},
+				[]string{fmt.Sprint(port.LocalPort), status, port.Exposed.Protocol.String(), exposedUrl, process, nameAndDescription},
 				colors,
 			)
 		}
@@ -111,6 +120,17 @@ var listPortsCmd = &cobra.Command{
 	},
 }
 
+// maxProcessCommandLength is the number of characters of the command line of a process serving a port shown in the ports list.
+const maxProcessCommandLength = 40
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
+
 func init() {
 	listPortsCmd.Flags().BoolVarP(&noColor, "no-color", "", false, "Disable output colorization")
 	portsCmd.AddCommand(listPortsCmd)
@@ -22,9 +22,11 @@ import (
 
 // portsProtocolCmd change protocol of port
 var portsProtocolCmd = &cobra.Command{
-	Use:   "protocol <port:{http|https}>",
+	Use:   "protocol <port:{http|https|tcp|udp}>",
 	Short: "Set port protocol",
-	Args:  cobra.ExactArgs(1),
+	Long: `Sets the protocol of a port. Ports with the http or https protocol are proxied over HTTP.
+Ports with the tcp or udp protocol are forwarded as raw streams or datagrams through the port tunnel.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// TODO: we can add protocol for analysis later.
 		portProtocol := args[0]
@@ -37,8 +39,10 @@ var portsProtocolCmd = &cobra.Command{
 			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
 		}
 		protocol := s[1]
-		if protocol != serverapi.PortProtocolHTTP && protocol != serverapi.PortProtocolHTTPS {
-			return GpError{Err: xerrors.Errorf("protocol should be `%s` or `%s`", serverapi.PortProtocolHTTP, serverapi.PortProtocolHTTPS), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		switch protocol {
+		case serverapi.PortProtocolHTTP, serverapi.PortProtocolHTTPS, serverapi.PortProtocolTCP, serverapi.PortProtocolUDP:
+		default:
+			return GpError{Err: xerrors.Errorf("protocol should be one of `%s`, `%s`, `%s` or `%s`", serverapi.PortProtocolHTTP, serverapi.PortProtocolHTTPS, serverapi.PortProtocolTCP, serverapi.PortProtocolUDP), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
 		}
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
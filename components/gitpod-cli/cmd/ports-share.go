@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	connect "github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/components/public-api/go/client"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/gitpod"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var portsShareOpts struct {
+	TTL    time.Duration
+	Revoke bool
+}
+
+// portsShareCmd issues or revokes share links for a port
+var portsShareCmd = &cobra.Command{
+	Use:   "share <port>",
+	Short: "Create a link which grants access to a port until it expires",
+	Long: `Create a link which grants access to a port until it expires, regardless of the port's visibility.
+
+Anyone who has the link can access the port. Use --revoke to invalidate all links issued for the port.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		port, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+		if !portsShareOpts.Revoke && portsShareOpts.TTL <= 0 {
+			return GpError{Err: xerrors.Errorf("ttl should be positive"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 10*time.Second)
+		defer cancel()
+
+		wsInfo, err := gitpod.GetWSInfo(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot get workspace info, %w", err)
+		}
+		supervisorConn, err := grpc.Dial(util.GetSupervisorAddress(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return xerrors.Errorf("failed connecting to supervisor: %w", err)
+		}
+		defer supervisorConn.Close()
+		clientToken, err := supervisor.NewTokenServiceClient(supervisorConn).GetToken(ctx, &supervisor.GetTokenRequest{
+			Host: wsInfo.GitpodApi.Host,
+			Kind: "gitpod",
+			Scope: []string{
+				"function:getWorkspace",
+				"function:openPort",
+				"resource:workspace::" + wsInfo.WorkspaceId + "::get/update",
+			},
+		})
+		if err != nil {
+			return xerrors.Errorf("failed getting token from supervisor: %w", err)
+		}
+
+		c, err := client.New(client.WithCredentials(clientToken.Token), client.WithURL("https://api."+wsInfo.GitpodApi.Host))
+		if err != nil {
+			return err
+		}
+		share := &v1.PortShare{Revoke: portsShareOpts.Revoke}
+		if !portsShareOpts.Revoke {
+			share.Ttl = durationpb.New(portsShareOpts.TTL)
+		}
+		resp, err := c.Workspaces.UpdatePort(ctx, connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: wsInfo.WorkspaceId,
+			Port:        &v1.PortSpec{Port: port},
+			Share:       share,
+		}))
+		if err != nil {
+			return xerrors.Errorf("failed to share port: %w", err)
+		}
+
+		if portsShareOpts.Revoke {
+			fmt.Printf("all share links of port %d have been revoked\n", port)
+			return nil
+		}
+		fmt.Println(resp.Msg.ShareUrl)
+		fmt.Printf("the link expires at %s\n", resp.Msg.ShareExpiry.AsTime().Local().Format(time.RFC1123))
+		return nil
+	},
+}
+
+func init() {
+	portsCmd.AddCommand(portsShareCmd)
+
+	portsShareCmd.Flags().DurationVar(&portsShareOpts.TTL, "ttl", time.Hour, "how long the link is valid for")
+	portsShareCmd.Flags().BoolVar(&portsShareOpts.Revoke, "revoke", false, "revoke all links issued for the port")
+}
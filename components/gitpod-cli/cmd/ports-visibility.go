@@ -22,8 +22,8 @@ import (
 
 // portsVisibilityCmd change visibility of port
 var portsVisibilityCmd = &cobra.Command{
-	Use:   "visibility <port:{private|public}>",
-	Short: "Make a port public or private",
+	Use:   "visibility <port:{private|public|organization}>",
+	Short: "Make a port public, private or accessible to the organization",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// TODO: we can add visibility for analysis later.
@@ -37,8 +37,8 @@ var portsVisibilityCmd = &cobra.Command{
 			return GpError{Err: xerrors.Errorf("port should be integer"), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
 		}
 		visibility := s[1]
-		if visibility != serverapi.PortVisibilityPublic && visibility != serverapi.PortVisibilityPrivate {
-			return GpError{Err: xerrors.Errorf("visibility should be `%s` or `%s`", serverapi.PortVisibilityPublic, serverapi.PortVisibilityPrivate), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+		if visibility != serverapi.PortVisibilityPublic && visibility != serverapi.PortVisibilityPrivate && visibility != serverapi.PortVisibilityOrganization {
+			return GpError{Err: xerrors.Errorf("visibility should be `%s`, `%s` or `%s`", serverapi.PortVisibilityPublic, serverapi.PortVisibilityPrivate, serverapi.PortVisibilityOrganization), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
 		}
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
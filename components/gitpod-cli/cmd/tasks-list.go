@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
@@ -52,6 +53,8 @@ var listTasksCmd = &cobra.Command{
 			0: tablewriter.FgHiGreenColor,
 			1: tablewriter.FgHiGreenColor,
 			2: tablewriter.FgHiBlackColor,
+			3: tablewriter.FgHiYellowColor,
+			4: tablewriter.FgHiRedColor,
 		}
 
 		mapCurrentToColor := map[bool]int{
@@ -61,6 +64,11 @@ var listTasksCmd = &cobra.Command{
 
 		ppid := int64(os.Getppid())
 
+		taskNames := make(map[string]string, len(tasks))
+		for _, task := range tasks {
+			taskNames[task.Id] = task.Presentation.Name
+		}
+
 		for _, task := range tasks {
 			colors := []tablewriter.Colors{}
 
@@ -81,7 +89,26 @@ var listTasksCmd = &cobra.Command{
 				colors = []tablewriter.Colors{{mapCurrentToColor[isCurrent]}, {}, {mapStatusToColor[task.State]}}
 			}
 
-			table.Rich([]string{task.Terminal, task.Presentation.Name, task.State.String()}, colors)
+			state := task.State.String()
+			if len(task.WaitingFor) > 0 {
+				waitingFor := make([]string, 0, len(task.WaitingFor))
+				for _, id := range task.WaitingFor {
+					waitingFor = append(waitingFor, taskNames[id])
+				}
+				state += " for " + strings.Join(waitingFor, ", ")
+			}
+			var details []string
+			if task.Health != api.TaskHealth_unknown_health {
+				details = append(details, task.Health.String())
+			}
+			if task.RestartCount > 0 {
+				details = append(details, fmt.Sprintf("restarted %d times", task.RestartCount))
+			}
+			if len(details) > 0 {
+				state += " (" + strings.Join(details, ", ") + ")"
+			}
+
+			table.Rich([]string{task.Terminal, task.Presentation.Name, state}, colors)
 		}
 
 		table.Render()
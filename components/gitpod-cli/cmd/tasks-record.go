@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var recordTaskCmdOpts struct {
+	Output   string
+	Duration time.Duration
+}
+
+// recordTaskCmd represents the record task command
+var recordTaskCmd = &cobra.Command{
+	Use:   "record <id>",
+	Short: "Record a workspace task in the asciicast format",
+	Long: `Records the terminal of a workspace task in the asciicast v2 format until you press Ctrl+C,
+the --duration elapsed or the task ends. The recording can be downloaded from the workspace
+and played back with asciinema.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := supervisor.New(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		var terminalAlias string
+		if len(args) > 0 {
+			terminalAlias = args[0]
+		} else {
+			tasks, err := client.GetTasksListByState(cmd.Context(), api.TaskState_running)
+			if err != nil {
+				return xerrors.Errorf("cannot get task list: %w", err)
+			}
+
+			if len(tasks) == 0 {
+				fmt.Println("There are no running tasks")
+				return nil
+			}
+
+			var taskIndex int
+			if len(tasks) > 1 {
+				var taskNames []string
+				for _, task := range tasks {
+					taskNames = append(taskNames, task.Presentation.Name)
+				}
+
+				prompt := promptui.Select{
+					Label: "What task do you want to record?",
+					Items: taskNames,
+					Templates: &promptui.SelectTemplates{
+						Selected: "Recording task: {{ . }}",
+					},
+				}
+
+				selectedIndex, selectedValue, err := prompt.Run()
+				if selectedValue == "" {
+					return nil
+				}
+				if err != nil {
+					return xerrors.Errorf("error occurred with the input prompt: %w", err)
+				}
+				taskIndex = selectedIndex
+			}
+			terminalAlias = tasks[taskIndex].Terminal
+		}
+
+		started, err := client.Terminal.StartRecording(cmd.Context(), &api.StartTerminalRecordingRequest{Alias: terminalAlias})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				msg := fmt.Sprintf("The selected task was not found or already stopped: %s.\nMake sure to use the correct task ID.\nUse 'gp tasks list' to obtain the task id or run 'gp tasks record' to select the desired task\n", terminalAlias)
+				return GpError{Err: err, Message: msg, OutCome: utils.Outcome_UserErr}
+			}
+			return xerrors.Errorf("cannot start recording: %w", err)
+		}
+		fmt.Printf("Recording task terminal %s, press Ctrl+C to stop.\n", terminalAlias)
+
+		ctx := cmd.Context()
+		if recordTaskCmdOpts.Duration > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, recordTaskCmdOpts.Duration)
+			defer cancel()
+		}
+		terminalClosed := make(chan struct{})
+		go func() {
+			defer close(terminalClosed)
+			listen, err := client.Terminal.Listen(ctx, &api.ListenTerminalRequest{Alias: terminalAlias})
+			if err != nil {
+				return
+			}
+			for {
+				if _, err := listen.Recv(); err != nil {
+					return
+				}
+			}
+		}()
+		select {
+		case <-ctx.Done():
+		case <-terminalClosed:
+		}
+
+		// the command context is cancelled on Ctrl+C already
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		path := started.Path
+		stopped, err := client.Terminal.StopRecording(stopCtx, &api.StopTerminalRecordingRequest{Alias: terminalAlias})
+		switch status.Code(err) {
+		case codes.OK:
+			path = stopped.Path
+		case codes.NotFound, codes.FailedPrecondition:
+			// the task ended, which finished the recording
+		default:
+			return xerrors.Errorf("cannot stop recording: %w", err)
+		}
+
+		if recordTaskCmdOpts.Output == "" {
+			fmt.Println("Recording saved to", path)
+		} else {
+			err = copyFile(path, recordTaskCmdOpts.Output)
+			if err != nil {
+				return xerrors.Errorf("cannot export recording: %w", err)
+			}
+			fmt.Println("Recording saved to", recordTaskCmdOpts.Output)
+		}
+		if wsurl := GetWorkspaceURL(0); wsurl != "" {
+			fmt.Println("Download it from", recordingDownloadURL(wsurl, path))
+		}
+		return nil
+	},
+}
+
+// recordingsPath is where supervisor serves terminal recordings for download
+const recordingsPath = "/_supervisor/v1/terminal/recordings/"
+
+func recordingDownloadURL(wsurl, path string) string {
+	return strings.TrimSuffix(wsurl, "/") + recordingsPath + url.PathEscape(filepath.Base(path))
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func init() {
+	tasksCmd.AddCommand(recordTaskCmd)
+
+	recordTaskCmd.Flags().StringVarP(&recordTaskCmdOpts.Output, "output", "o", "", "file to export the recording to")
+	recordTaskCmd.Flags().DurationVarP(&recordTaskCmdOpts.Duration, "duration", "d", 0, "stop recording after the given duration, e.g. 5m")
+}
@@ -544,7 +544,7 @@ func checkAllTasksOpened(ctx context.Context, listener api.StatusService_TasksSt
 
 func areTasksOpened(tasks []*api.TaskStatus) bool {
 	for _, task := range tasks {
-		if task.State == api.TaskState_opening {
+		if task.State == api.TaskState_opening || task.State == api.TaskState_waiting {
 			return false
 		}
 	}
@@ -640,11 +640,17 @@ type TerminalReaderAdapter struct {
 }
 
 func (t *TerminalReaderAdapter) Recv() ([]byte, error) {
-	resp, err := t.client.Recv()
-	if err != nil {
-		return nil, err
+	for {
+		resp, err := t.client.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if resp.Historical {
+			// output of a previous session is not relevant for validating the current configuration
+			continue
+		}
+		return resp.GetData(), nil
 	}
-	return resp.GetData(), nil
 }
 
 var validateOpts struct {
@@ -27,6 +27,7 @@ type SupervisorClient struct {
 	Notification api.NotificationServiceClient
 	Control      api.ControlServiceClient
 	Token        api.TokenServiceClient
+	Port         api.PortServiceClient
 }
 
 type SupervisorClientOption struct {
@@ -53,6 +54,7 @@ func New(ctx context.Context, options ...*SupervisorClientOption) (*SupervisorCl
 		Notification: api.NewNotificationServiceClient(conn),
 		Control:      api.NewControlServiceClient(conn),
 		Token:        api.NewTokenServiceClient(conn),
+		Port:         api.NewPortServiceClient(conn),
 	}, nil
 }
 
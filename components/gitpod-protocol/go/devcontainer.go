@@ -0,0 +1,385 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DevcontainerLocations are the locations of a dev container config relative to the repository root, in order of precedence
+var DevcontainerLocations = []string{".devcontainer/devcontainer.json", ".devcontainer.json"}
+
+// Devcontainer is the part of a dev container config (https://containers.dev/implementors/json_reference/)
+// which can be mapped onto a Gitpod config.
+type Devcontainer struct {
+	Name  string             `json:"name,omitempty"`
+	Image string             `json:"image,omitempty"`
+	Build *DevcontainerBuild `json:"build,omitempty"`
+	// DockerFile and Context are the deprecated top-level equivalents of build.dockerfile and build.context
+	DockerFile string `json:"dockerFile,omitempty"`
+	Context    string `json:"context,omitempty"`
+
+	ForwardPorts    []interface{}                         `json:"forwardPorts,omitempty"`
+	PortsAttributes map[string]DevcontainerPortAttributes `json:"portsAttributes,omitempty"`
+
+	// Lifecycle commands are either a string, an array of strings or an object of commands which run in parallel
+	OnCreateCommand      interface{} `json:"onCreateCommand,omitempty"`
+	UpdateContentCommand interface{} `json:"updateContentCommand,omitempty"`
+	PostCreateCommand    interface{} `json:"postCreateCommand,omitempty"`
+	PostStartCommand     interface{} `json:"postStartCommand,omitempty"`
+
+	ContainerEnv map[string]string `json:"containerEnv,omitempty"`
+	RemoteEnv    map[string]string `json:"remoteEnv,omitempty"`
+
+	Features       map[string]interface{}      `json:"features,omitempty"`
+	Customizations *DevcontainerCustomizations `json:"customizations,omitempty"`
+
+	// unsupported lists the top-level properties we cannot map onto a Gitpod config
+	unsupported []string
+}
+
+// DevcontainerBuild configures how to build the dev container image
+type DevcontainerBuild struct {
+	Dockerfile string            `json:"dockerfile,omitempty"`
+	Context    string            `json:"context,omitempty"`
+	Args       map[string]string `json:"args,omitempty"`
+	Target     string            `json:"target,omitempty"`
+}
+
+// DevcontainerPortAttributes configures a forwarded port
+type DevcontainerPortAttributes struct {
+	Label         string `json:"label,omitempty"`
+	OnAutoForward string `json:"onAutoForward,omitempty"`
+	Protocol      string `json:"protocol,omitempty"`
+}
+
+// DevcontainerCustomizations holds tool specific configuration
+type DevcontainerCustomizations struct {
+	Vscode *struct {
+		Extensions []string `json:"extensions,omitempty"`
+	} `json:"vscode,omitempty"`
+}
+
+// devcontainerProperties are the top-level properties of a dev container config we understand
+var devcontainerProperties = map[string]struct{}{
+	"$schema":              {},
+	"name":                 {},
+	"image":                {},
+	"build":                {},
+	"dockerFile":           {},
+	"context":              {},
+	"forwardPorts":         {},
+	"portsAttributes":      {},
+	"onCreateCommand":      {},
+	"updateContentCommand": {},
+	"postCreateCommand":    {},
+	"postStartCommand":     {},
+	"containerEnv":         {},
+	"remoteEnv":            {},
+	"features":             {},
+	"customizations":       {},
+}
+
+// ParseDevcontainer parses a dev container config. Like VS Code, we accept comments and trailing commas.
+func ParseDevcontainer(data []byte) (*Devcontainer, error) {
+	data = stripJSONC(data)
+
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+	var res Devcontainer
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	for k := range raw {
+		if _, ok := devcontainerProperties[k]; !ok {
+			res.unsupported = append(res.unsupported, k)
+		}
+	}
+	sort.Strings(res.unsupported)
+
+	return &res, nil
+}
+
+// GitpodConfig maps the dev container config onto a Gitpod config. dir is the directory of the
+// dev container config relative to the repository root, which relative paths are resolved against.
+// The warnings explain which parts of the dev container config were not mapped.
+func (d *Devcontainer) GitpodConfig(dir string) (cfg *GitpodConfig, warnings []string) {
+	cfg = &GitpodConfig{}
+	for _, k := range d.unsupported {
+		warnings = append(warnings, fmt.Sprintf("devcontainer.json: %q is not supported and will be ignored", k))
+	}
+
+	dockerfile, context := d.DockerFile, d.Context
+	if d.Build != nil {
+		if d.Build.Dockerfile != "" {
+			dockerfile = d.Build.Dockerfile
+		}
+		if d.Build.Context != "" {
+			context = d.Build.Context
+		}
+		if len(d.Build.Args) > 0 {
+			warnings = append(warnings, "devcontainer.json: build args are not supported and will be ignored")
+		}
+		if d.Build.Target != "" {
+			warnings = append(warnings, "devcontainer.json: build target is not supported and will be ignored")
+		}
+	}
+	switch {
+	case dockerfile != "":
+		if context == "" {
+			context = "."
+		}
+		cfg.Image = map[string]interface{}{
+			"file":    path.Join(dir, dockerfile),
+			"context": path.Join(dir, context),
+		}
+	case d.Image != "":
+		cfg.Image = d.Image
+	}
+	for ref, opts := range d.Features {
+		// features can be configured with an object of options, or in the legacy format with a version string or a boolean
+		switch o := opts.(type) {
+		case map[string]interface{}:
+		case string:
+			opts = map[string]interface{}{"version": o}
+		case bool:
+			if !o {
+				continue
+			}
+			opts = map[string]interface{}{}
+		default:
+			warnings = append(warnings, fmt.Sprintf("devcontainer.json: invalid options of feature %s", ref))
+			continue
+		}
+		if cfg.Features == nil {
+			cfg.Features = make(map[string]interface{}, len(d.Features))
+		}
+		cfg.Features[ref] = opts
+	}
+
+	cfg.Ports, warnings = d.ports(warnings)
+
+	initCommand := joinCommands(d.OnCreateCommand, d.UpdateContentCommand, d.PostCreateCommand)
+	command := joinCommands(d.PostStartCommand)
+	if initCommand != "" || command != "" {
+		name := d.Name
+		if name == "" {
+			name = "devcontainer"
+		}
+		cfg.Tasks = []*TasksItems{{Name: name, Init: initCommand, Command: command}}
+	}
+
+	if len(d.ContainerEnv)+len(d.RemoteEnv) > 0 {
+		cfg.Env = make(map[string]string, len(d.ContainerEnv)+len(d.RemoteEnv))
+		for _, env := range []map[string]string{d.ContainerEnv, d.RemoteEnv} {
+			keys := make([]string, 0, len(env))
+			for k := range env {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				v := env[k]
+				if strings.Contains(v, "${") {
+					warnings = append(warnings, fmt.Sprintf("devcontainer.json: variable references in %s are not supported and will be passed as is", k))
+				}
+				cfg.Env[k] = v
+			}
+		}
+	}
+
+	if d.Customizations != nil && d.Customizations.Vscode != nil && len(d.Customizations.Vscode.Extensions) > 0 {
+		cfg.Vscode = &Vscode{Extensions: d.Customizations.Vscode.Extensions}
+	}
+
+	return cfg, warnings
+}
+
+func (d *Devcontainer) ports(warnings []string) ([]*PortsItems, []string) {
+	var (
+		res   []*PortsItems
+		index = make(map[string]*PortsItems)
+	)
+	add := func(port interface{}) *PortsItems {
+		key := fmt.Sprint(port)
+		if p, ok := index[key]; ok {
+			return p
+		}
+		p := &PortsItems{Port: port}
+		index[key] = p
+		res = append(res, p)
+		return p
+	}
+
+	for _, fp := range d.ForwardPorts {
+		switch p := fp.(type) {
+		case float64:
+			add(int(p))
+		case string:
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("devcontainer.json: forwarding port %q of another host is not supported", p))
+				continue
+			}
+			add(n)
+		default:
+			warnings = append(warnings, fmt.Sprintf("devcontainer.json: invalid forward port %v", fp))
+		}
+	}
+
+	keys := make([]string, 0, len(d.PortsAttributes))
+	for k := range d.PortsAttributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var port interface{} = k
+		if n, err := strconv.Atoi(k); err == nil {
+			port = n
+		} else if from, to, found := strings.Cut(k, "-"); !found || !isNumber(from) || !isNumber(to) {
+			warnings = append(warnings, fmt.Sprintf("devcontainer.json: port attributes for %q are not supported, only ports and port ranges are", k))
+			continue
+		}
+
+		attrs := d.PortsAttributes[k]
+		p := add(port)
+		p.Name = attrs.Label
+		switch attrs.OnAutoForward {
+		case "":
+		case "notify":
+			p.OnOpen = "notify"
+		case "openBrowser", "openBrowserOnce":
+			p.OnOpen = "open-browser"
+		case "openPreview":
+			p.OnOpen = "open-preview"
+		case "silent":
+			p.OnOpen = "ignore"
+		case "ignore":
+			p.OnOpen = "ignore-completely"
+		default:
+			warnings = append(warnings, fmt.Sprintf("devcontainer.json: onAutoForward %q of port %s is not supported", attrs.OnAutoForward, k))
+		}
+		switch attrs.Protocol {
+		case "", "http", "https":
+			p.Protocol = attrs.Protocol
+		default:
+			warnings = append(warnings, fmt.Sprintf("devcontainer.json: protocol %q of port %s is not supported", attrs.Protocol, k))
+		}
+	}
+
+	return res, warnings
+}
+
+// joinCommands produces a single shell command from dev container lifecycle commands, which are run in order
+func joinCommands(cmds ...interface{}) string {
+	var res []string
+	for _, c := range cmds {
+		if s := shellCommand(c); s != "" {
+			res = append(res, s)
+		}
+	}
+	return strings.Join(res, "\n")
+}
+
+func shellCommand(cmd interface{}) string {
+	switch c := cmd.(type) {
+	case string:
+		return c
+	case []interface{}:
+		args := make([]string, 0, len(c))
+		for _, a := range c {
+			args = append(args, shellQuote(fmt.Sprint(a)))
+		}
+		return strings.Join(args, " ")
+	case map[string]interface{}:
+		// commands of an object run in parallel
+		keys := make([]string, 0, len(c))
+		for k := range c {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var parallel []string
+		for _, k := range keys {
+			if s := shellCommand(c[k]); s != "" {
+				parallel = append(parallel, "("+s+") &")
+			}
+		}
+		if len(parallel) == 0 {
+			return ""
+		}
+		return strings.Join(parallel, " ") + " wait"
+	}
+	return ""
+}
+
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./=:,@+", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// stripJSONC removes comments and trailing commas from JSON with comments
+func stripJSONC(data []byte) []byte {
+	res := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '"':
+			// copy strings verbatim, including escaped quotes
+			start := i
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(data) {
+				i = len(data) - 1
+			}
+			res = append(res, data[start:i+1]...)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				res = append(res, '\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i+1 < len(data) && !(data[i] == '*' && data[i+1] == '/') {
+				i++
+			}
+			i++
+		case c == '}' || c == ']':
+			// drop a trailing comma before the closing bracket
+			j := len(res) - 1
+			for j >= 0 && (res[j] == ' ' || res[j] == '\t' || res[j] == '\n' || res[j] == '\r') {
+				j--
+			}
+			if j >= 0 && res[j] == ',' {
+				res = append(res[:j], res[j+1:]...)
+			}
+			res = append(res, c)
+		default:
+			res = append(res, c)
+		}
+	}
+	return res
+}
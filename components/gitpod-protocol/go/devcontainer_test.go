@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDevcontainerGitpodConfig(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Content     string
+		Expectation string
+		Warnings    []string
+	}{
+		{
+			Name: "image",
+			Content: `{
+				// comments and trailing commas are fine
+				"image": "mcr.microsoft.com/devcontainers/go:1", /* really */
+				"features": {
+					"ghcr.io/devcontainers/features/node:1": { "version": "20" },
+					"ghcr.io/devcontainers/features/docker-in-docker:2": "latest",
+					"ghcr.io/devcontainers/features/disabled:1": false,
+				},
+			}`,
+			Expectation: `{"features":{"ghcr.io/devcontainers/features/docker-in-docker:2":{"version":"latest"},"ghcr.io/devcontainers/features/node:1":{"version":"20"}},"image":"mcr.microsoft.com/devcontainers/go:1"}`,
+		},
+		{
+			Name:        "dockerfile",
+			Content:     `{"build": {"dockerfile": "Dockerfile", "context": "..", "args": {"FOO": "bar"}}}`,
+			Expectation: `{"image":{"context":".","file":".devcontainer/Dockerfile"}}`,
+			Warnings:    []string{"devcontainer.json: build args are not supported and will be ignored"},
+		},
+		{
+			Name:        "legacy dockerfile",
+			Content:     `{"dockerFile": "Dockerfile"}`,
+			Expectation: `{"image":{"context":".devcontainer","file":".devcontainer/Dockerfile"}}`,
+		},
+		{
+			Name: "ports",
+			Content: `{
+				"forwardPorts": [3000, "8080", "db:5432"],
+				"portsAttributes": {
+					"3000": {"label": "app", "onAutoForward": "openBrowser"},
+					"9000-9010": {"onAutoForward": "silent", "protocol": "https"},
+					".+\\/server.js": {"onAutoForward": "notify"}
+				}
+			}`,
+			Expectation: `{"ports":[{"name":"app","onOpen":"open-browser","port":3000},{"port":8080},{"onOpen":"ignore","port":"9000-9010","protocol":"https"}]}`,
+			Warnings: []string{
+				`devcontainer.json: forwarding port "db:5432" of another host is not supported`,
+				`devcontainer.json: port attributes for ".+\\/server.js" are not supported, only ports and port ranges are`,
+			},
+		},
+		{
+			Name: "lifecycle commands",
+			Content: `{
+				"name": "app",
+				"onCreateCommand": "make deps",
+				"postCreateCommand": ["npm", "run", "build it"],
+				"postStartCommand": {"server": "npm start", "watch": "npm run watch"}
+			}`,
+			Expectation: `{"tasks":[{"command":"(npm start) & (npm run watch) & wait","init":"make deps\nnpm run 'build it'","name":"app"}]}`,
+		},
+		{
+			Name: "env and unsupported properties",
+			Content: `{
+				"containerEnv": {"FOO": "container", "BAR": "bar"},
+				"remoteEnv": {"FOO": "remote", "PATH": "${containerEnv:PATH}:/opt/bin"},
+				"customizations": {"vscode": {"extensions": ["golang.go"]}},
+				"mounts": [],
+				"runArgs": ["--privileged"]
+			}`,
+			Expectation: `{"env":{"BAR":"bar","FOO":"remote","PATH":"${containerEnv:PATH}:/opt/bin"},"vscode":{"extensions":["golang.go"]}}`,
+			Warnings: []string{
+				`devcontainer.json: "mounts" is not supported and will be ignored`,
+				`devcontainer.json: "runArgs" is not supported and will be ignored`,
+				"devcontainer.json: variable references in PATH are not supported and will be passed as is",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dc, err := ParseDevcontainer([]byte(test.Content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg, warnings := dc.GitpodConfig(".devcontainer")
+
+			var act bytes.Buffer
+			enc := json.NewEncoder(&act)
+			enc.SetEscapeHTML(false)
+			err = enc.Encode(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(act.String()) != test.Expectation {
+				t.Errorf("unexpected config:\nwant %s\n got %s", test.Expectation, act.String())
+			}
+			if !reflect.DeepEqual(warnings, test.Warnings) {
+				t.Errorf("unexpected warnings:\nwant %q\n got %q", test.Warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestStripJSONC(t *testing.T) {
+	tests := []struct {
+		Content     string
+		Expectation string
+	}{
+		{`{"a": "// not a comment", "b": "/* nor this */"}`, `{"a": "// not a comment", "b": "/* nor this */"}`},
+		{`{"a": "escaped \" // quote"} // comment`, `{"a": "escaped \" // quote"} `},
+		{"{\"a\": [1, 2, ],\n}", "{\"a\": [1, 2 ]\n}"},
+	}
+	for _, test := range tests {
+		act := string(stripJSONC([]byte(test.Content)))
+		if act != test.Expectation {
+			t.Errorf("stripJSONC(%q) = %q, want %q", test.Content, act, test.Expectation)
+		}
+	}
+}
@@ -24,6 +24,9 @@ const (
 	// 411 No User
 	NEEDS_VERIFICATION = 411
 
+	// 412 Precondition Failed
+	PRECONDITION_FAILED = 412
+
 	// 429 Too Many Requests
 	TOO_MANY_REQUESTS = 429
 
@@ -12,6 +12,15 @@ type AdditionalRepositoriesItems struct {
 	// Path to where the repository should be checked out relative to `/workspace`. Defaults to the simple repository name.
 	CheckoutLocation string `yaml:"checkoutLocation,omitempty" json:"checkoutLocation,omitempty"`
 
+	// Partial clone filter which omits objects from the initial clone, they are fetched on demand. `blob:none` omits all file contents, `tree:0` additionally omits all trees.
+	CloneFilter string `yaml:"cloneFilter,omitempty" json:"cloneFilter,omitempty"`
+
+	// Configures which Git LFS objects are fetched after the clone. All objects of the checked out revision are fetched by default.
+	GitLfs *GitLfs `yaml:"gitLfs,omitempty" json:"gitLfs,omitempty"`
+
+	// Only check out the given paths of the repository. Paths without wildcards are directories, other patterns are interpreted like `.gitignore` entries. The complete repository is checked out by default.
+	SparseCheckout []string `yaml:"sparseCheckout,omitempty" json:"sparseCheckout,omitempty"`
+
 	// The url of the git repository to clone. Supports any context URLs.
 	Url string `yaml:"url" json:"url"`
 }
@@ -38,6 +47,19 @@ type Github struct {
 	Prebuilds interface{} `yaml:"prebuilds,omitempty" json:"prebuilds,omitempty"`
 }
 
+// GitLfs Configures which Git LFS objects are fetched after the clone. All objects of the checked out revision are fetched by default.
+type GitLfs struct {
+
+	// Do not fetch the Git LFS objects of files matching the given patterns.
+	Exclude []string `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Only fetch the Git LFS objects of files matching the given patterns.
+	Include []string `yaml:"include,omitempty" json:"include,omitempty"`
+
+	// Leave the Git LFS pointer files in place instead of fetching the objects.
+	SkipFetch bool `yaml:"skipFetch,omitempty" json:"skipFetch,omitempty"`
+}
+
 // GitpodConfig
 type GitpodConfig struct {
 
@@ -47,6 +69,9 @@ type GitpodConfig struct {
 	// Path to where the repository should be checked out relative to `/workspace`. Defaults to the simple repository name.
 	CheckoutLocation string `yaml:"checkoutLocation,omitempty" json:"checkoutLocation,omitempty"`
 
+	// Partial clone filter which omits objects from the initial clone, they are fetched on demand. `blob:none` omits all file contents, `tree:0` additionally omits all trees.
+	CloneFilter string `yaml:"cloneFilter,omitempty" json:"cloneFilter,omitempty"`
+
 	// Configure the default action of certain signals is to cause a process to terminate and produce a core dump file, a file containing an image of the process's memory at the time of termination. Disabled by default.
 	CoreDump *CoreDump `yaml:"coreDump,omitempty" json:"coreDump,omitempty"`
 
@@ -56,9 +81,15 @@ type GitpodConfig struct {
 	// Experimental network configuration in workspaces (deprecated). Enabled by default
 	ExperimentalNetwork bool `yaml:"experimentalNetwork,omitempty" json:"experimentalNetwork,omitempty"`
 
+	// Dev container features to install on top of the workspace image, keyed by their OCI reference. The values are the options passed to the feature.
+	Features map[string]interface{} `yaml:"features,omitempty" json:"features,omitempty"`
+
 	// Git config values should be provided in pairs. E.g. `core.autocrlf: input`. See https://git-scm.com/docs/git-config#_values.
 	GitConfig map[string]string `yaml:"gitConfig,omitempty" json:"gitConfig,omitempty"`
 
+	// Configures which Git LFS objects are fetched after the clone. All objects of the checked out revision are fetched by default.
+	GitLfs *GitLfs `yaml:"gitLfs,omitempty" json:"gitLfs,omitempty"`
+
 	// Configures Gitpod's GitHub app (deprecated)
 	Github *Github `yaml:"github,omitempty" json:"github,omitempty"`
 
@@ -74,6 +105,9 @@ type GitpodConfig struct {
 	// List of exposed ports.
 	Ports []*PortsItems `yaml:"ports,omitempty" json:"ports,omitempty"`
 
+	// Only check out the given paths of the repository. Paths without wildcards are directories, other patterns are interpreted like `.gitignore` entries. The complete repository is checked out by default.
+	SparseCheckout []string `yaml:"sparseCheckout,omitempty" json:"sparseCheckout,omitempty"`
+
 	// List of tasks to run on start. Each task will open a terminal in the IDE.
 	Tasks []*TasksItems `yaml:"tasks,omitempty" json:"tasks,omitempty"`
 
@@ -84,6 +118,25 @@ type GitpodConfig struct {
 	WorkspaceLocation string `yaml:"workspaceLocation,omitempty" json:"workspaceLocation,omitempty"`
 }
 
+// HealthCheck Periodically checks whether the task is healthy. If the task has a restart policy, it is restarted once the check fails `failureThreshold` times in a row.
+type HealthCheck struct {
+
+	// A shell command which exits with 0 if the task is healthy.
+	Command string `yaml:"command,omitempty" json:"command,omitempty"`
+
+	// The number of consecutive failed checks after which the task is considered unhealthy. Default is 3.
+	FailureThreshold float64 `yaml:"failureThreshold,omitempty" json:"failureThreshold,omitempty"`
+
+	// The number of seconds between two checks. Default is 10.
+	Interval float64 `yaml:"interval,omitempty" json:"interval,omitempty"`
+
+	// The path of the HTTP request sent to `port`. Default is '/'.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+
+	// A port on which an HTTP GET request must succeed (status code < 400) if the task is healthy.
+	Port float64 `yaml:"port,omitempty" json:"port,omitempty"`
+}
+
 // Image_object The Docker image to run your workspace in.
 type Image_object struct {
 
@@ -144,6 +197,9 @@ type JetbrainsProduct struct {
 // PortsItems
 type PortsItems struct {
 
+	// Only apply this configuration to ports opened by a process whose command line matches this regular expression.
+	CommandLine string `yaml:"commandLine,omitempty" json:"commandLine,omitempty"`
+
 	// A description to identify what is this port used for.
 	Description string `yaml:"description,omitempty" json:"description,omitempty"`
 
@@ -156,10 +212,13 @@ type PortsItems struct {
 	// The port number (e.g. 1337) or range (e.g. 3000-3999) to expose.
 	Port interface{} `yaml:"port" json:"port"`
 
-	// The protocol of workspace port.
+	// Only apply this configuration to ports opened by a process with this name (e.g. 'java'). Supports glob patterns like 'node*'.
+	Process string `yaml:"process,omitempty" json:"process,omitempty"`
+
+	// The protocol of workspace port. Ports with the tcp or udp protocol are forwarded through the port tunnel instead of being proxied over HTTP.
 	Protocol string `yaml:"protocol,omitempty" json:"protocol,omitempty"`
 
-	// Whether the port visibility should be private or public. 'private' (default) will only allow users with workspace access to access the port. 'public' will allow everyone with the port URL to access the port.
+	// Whether the port visibility should be private, public or organization. 'private' (default) will only allow users with workspace access to access the port. 'public' will allow everyone with the port URL to access the port. 'organization' will allow all members of the workspace's organization to access the port.
 	Visibility string `yaml:"visibility,omitempty" json:"visibility,omitempty"`
 }
 
@@ -195,6 +254,19 @@ type Prebuilds_object struct {
 	PullRequestsFromForks bool `yaml:"pullRequestsFromForks,omitempty" json:"pullRequestsFromForks,omitempty"`
 }
 
+// ReadyWhen Condition which marks this task as ready for the tasks depending on it. By default a task is ready as soon as its terminal has been started.
+type ReadyWhen struct {
+
+	// The task is ready once this file exists. Relative paths are resolved against the repository root.
+	File string `yaml:"file,omitempty" json:"file,omitempty"`
+
+	// The task is ready once this port accepts TCP connections.
+	Port float64 `yaml:"port,omitempty" json:"port,omitempty"`
+
+	// The task is ready once all of its commands have completed successfully.
+	Succeeded bool `yaml:"succeeded,omitempty" json:"succeeded,omitempty"`
+}
+
 // TasksItems
 type TasksItems struct {
 
@@ -204,12 +276,21 @@ type TasksItems struct {
 	// The main shell command to run after `before` and `init`. This command is executed last on every start and doesn't have to terminate.
 	Command string `yaml:"command,omitempty" json:"command,omitempty"`
 
+	// Names of tasks that must be ready before this task is started.
+	DependsOn []string `yaml:"dependsOn,omitempty" json:"dependsOn,omitempty"`
+
 	// Environment variables to set.
 	Env *Env `yaml:"env,omitempty" json:"env,omitempty"`
 
+	// Periodically checks whether the task is healthy. If the task has a restart policy, it is restarted once the check fails `failureThreshold` times in a row.
+	HealthCheck *HealthCheck `yaml:"healthCheck,omitempty" json:"healthCheck,omitempty"`
+
 	// A shell command to run between `before` and the main `command`. This command is executed only on after initializing a workspace with a fresh clone, but not on restarts and snapshots. This command is expected to terminate. If it fails, the `command` property will not be executed.
 	Init string `yaml:"init,omitempty" json:"init,omitempty"`
 
+	// The maximum number of times the task is restarted. Default is 10.
+	MaxRestarts float64 `yaml:"maxRestarts,omitempty" json:"maxRestarts,omitempty"`
+
 	// Name of the task. Shown on the tab of the opened terminal.
 	Name string `yaml:"name,omitempty" json:"name,omitempty"`
 
@@ -221,6 +302,12 @@ type TasksItems struct {
 
 	// A shell command to run after `before`. This command is executed only on during workspace prebuilds. This command is expected to terminate. If it fails, the workspace build fails.
 	Prebuild string `yaml:"prebuild,omitempty" json:"prebuild,omitempty"`
+
+	// Condition which marks this task as ready for the tasks depending on it. By default a task is ready as soon as its terminal has been started.
+	ReadyWhen *ReadyWhen `yaml:"readyWhen,omitempty" json:"readyWhen,omitempty"`
+
+	// Whether to restart the task when its `command` exits. Default is 'never'. Ignored in prebuilds.
+	Restart string `yaml:"restart,omitempty" json:"restart,omitempty"`
 }
 
 // Vscode Configure VS Code integration
@@ -56,6 +56,7 @@ type APIInterface interface {
 	IsPrebuildDone(ctx context.Context, pwsid string) (res bool, err error)
 	SetWorkspaceTimeout(ctx context.Context, workspaceID string, duration time.Duration) (res *SetWorkspaceTimeoutResult, err error)
 	GetWorkspaceTimeout(ctx context.Context, workspaceID string) (res *GetWorkspaceTimeoutResult, err error)
+	ResizeWorkspaceDisk(ctx context.Context, workspaceID string, size string) (err error)
 	GetOpenPorts(ctx context.Context, workspaceID string) (res []*WorkspaceInstancePort, err error)
 	OpenPort(ctx context.Context, workspaceID string, port *WorkspaceInstancePort) (res *WorkspaceInstancePort, err error)
 	ClosePort(ctx context.Context, workspaceID string, port float32) (err error)
@@ -172,6 +173,8 @@ const (
 	FunctionSetWorkspaceTimeout FunctionName = "setWorkspaceTimeout"
 	// FunctionGetWorkspaceTimeout is the name of the getWorkspaceTimeout function
 	FunctionGetWorkspaceTimeout FunctionName = "getWorkspaceTimeout"
+	// FunctionResizeWorkspaceDisk is the name of the resizeWorkspaceDisk function
+	FunctionResizeWorkspaceDisk FunctionName = "resizeWorkspaceDisk"
 	// FunctionGetOpenPorts is the name of the getOpenPorts function
 	FunctionGetOpenPorts FunctionName = "getOpenPorts"
 	// FunctionOpenPort is the name of the openPort function
@@ -950,6 +953,21 @@ func (gp *APIoverJSONRPC) GetWorkspaceTimeout(ctx context.Context, workspaceID s
 	return
 }
 
+// ResizeWorkspaceDisk calls resizeWorkspaceDisk on the server
+func (gp *APIoverJSONRPC) ResizeWorkspaceDisk(ctx context.Context, workspaceID string, size string) (err error) {
+	if gp == nil {
+		err = errNotConnected
+		return
+	}
+	var _params []interface{}
+
+	_params = append(_params, workspaceID)
+	_params = append(_params, size)
+
+	err = gp.C.Call(ctx, "resizeWorkspaceDisk", _params, nil)
+	return
+}
+
 // SendHeartBeat calls sendHeartBeat on the server
 func (gp *APIoverJSONRPC) SendHeartBeat(ctx context.Context, options *SendHeartBeatOptions) (err error) {
 	if gp == nil {
@@ -1822,16 +1840,20 @@ type WorkspaceInstancePort struct {
 	URL        string  `json:"url,omitempty"`
 	Visibility string  `json:"visibility,omitempty"`
 	Protocol   string  `json:"protocol,omitempty"`
+	ShareID    string  `json:"shareId,omitempty"`
 }
 
 const (
-	PortVisibilityPublic  = "public"
-	PortVisibilityPrivate = "private"
+	PortVisibilityPublic       = "public"
+	PortVisibilityPrivate      = "private"
+	PortVisibilityOrganization = "organization"
 )
 
 const (
 	PortProtocolHTTP  = "http"
 	PortProtocolHTTPS = "https"
+	PortProtocolTCP   = "tcp"
+	PortProtocolUDP   = "udp"
 )
 
 // GithubAppConfig is the GithubAppConfig message type
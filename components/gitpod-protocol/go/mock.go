@@ -834,6 +834,20 @@ func (mr *MockAPIInterfaceMockRecorder) ResetGenericInvite(ctx, teamID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetGenericInvite", reflect.TypeOf((*MockAPIInterface)(nil).ResetGenericInvite), ctx, teamID)
 }
 
+// ResizeWorkspaceDisk mocks base method.
+func (m *MockAPIInterface) ResizeWorkspaceDisk(ctx context.Context, workspaceID, size string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeWorkspaceDisk", ctx, workspaceID, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeWorkspaceDisk indicates an expected call of ResizeWorkspaceDisk.
+func (mr *MockAPIInterfaceMockRecorder) ResizeWorkspaceDisk(ctx, workspaceID, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeWorkspaceDisk", reflect.TypeOf((*MockAPIInterface)(nil).ResizeWorkspaceDisk), ctx, workspaceID, size)
+}
+
 // SendHeartBeat mocks base method.
 func (m *MockAPIInterface) SendHeartBeat(ctx context.Context, options *SendHeartBeatOptions) error {
 	m.ctrl.T.Helper()
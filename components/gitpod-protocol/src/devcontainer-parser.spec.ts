@@ -0,0 +1,104 @@
+/**
+ * Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import { suite, test } from "@testdeck/mocha";
+import * as chai from "chai";
+
+import { DevcontainerParser } from "./devcontainer-parser";
+
+const expect = chai.expect;
+
+@suite
+class TestDevcontainerParser {
+    protected parser: DevcontainerParser;
+
+    public before() {
+        this.parser = new DevcontainerParser();
+    }
+
+    @test public testImageAndFeatures() {
+        const content = `{
+            // comments and trailing commas are fine
+            "image": "mcr.microsoft.com/devcontainers/go:1",
+            "features": {
+                "ghcr.io/devcontainers/features/node:1": { "version": "20" },
+                "ghcr.io/devcontainers/features/docker-in-docker:2": "latest",
+                "ghcr.io/devcontainers/features/disabled:1": false,
+            },
+        }`;
+
+        const result = this.parser.parse(content, ".devcontainer/devcontainer.json");
+        expect(result.validationErrors).to.be.undefined;
+        expect(result.warnings).to.be.empty;
+        expect(result.config).to.deep.equal({
+            image: "mcr.microsoft.com/devcontainers/go:1",
+            features: {
+                "ghcr.io/devcontainers/features/node:1": { version: "20" },
+                "ghcr.io/devcontainers/features/docker-in-docker:2": { version: "latest" },
+            },
+        });
+    }
+
+    @test public testDockerfile() {
+        const content = `{"build": {"dockerfile": "Dockerfile", "context": "..", "args": {"FOO": "bar"}}}`;
+
+        const result = this.parser.parse(content, ".devcontainer/devcontainer.json");
+        expect(result.config).to.deep.equal({
+            image: { file: ".devcontainer/Dockerfile", context: "." },
+        });
+        expect(result.warnings).to.deep.equal(["devcontainer.json: build args are not supported and will be ignored"]);
+    }
+
+    @test public testPortsTasksAndEnv() {
+        const content = `{
+            "name": "app",
+            "forwardPorts": [3000, "db:5432"],
+            "portsAttributes": {
+                "3000": { "label": "web", "onAutoForward": "openPreview" },
+                "9000-9010": { "onAutoForward": "silent" }
+            },
+            "postCreateCommand": ["npm", "install"],
+            "postStartCommand": { "web": "npm start", "worker": "npm run worker" },
+            "containerEnv": { "FOO": "bar" },
+            "remoteEnv": { "PATH": "\${containerEnv:PATH}:/go/bin" },
+            "runArgs": ["--privileged"]
+        }`;
+
+        const result = this.parser.parse(content, ".devcontainer.json", { acceptPortRanges: true });
+        expect(result.config).to.deep.equal({
+            ports: [
+                { port: 3000, name: "web", onOpen: "open-preview" },
+                { port: "9000-9010", onOpen: "ignore" },
+            ],
+            tasks: [
+                {
+                    name: "app",
+                    init: "npm install",
+                    command: "(npm start) & (npm run worker) & wait",
+                },
+            ],
+            env: { FOO: "bar", PATH: "${containerEnv:PATH}:/go/bin" },
+        });
+        expect(result.warnings).to.deep.equal([
+            'devcontainer.json: "runArgs" is not supported and will be ignored',
+            'devcontainer.json: forwarding port "db:5432" of another host is not supported',
+            "devcontainer.json: variable references in PATH are not supported and will be passed as is",
+        ]);
+    }
+
+    @test public testPortRangesNotAccepted() {
+        const content = `{"portsAttributes": {"9000-9010": {"onAutoForward": "silent"}}}`;
+
+        const result = this.parser.parse(content, ".devcontainer.json");
+        expect(result.config).to.deep.equal({});
+    }
+
+    @test public testInvalid() {
+        const result = this.parser.parse(`["not", "an", "object"]`, ".devcontainer.json");
+        expect(result.validationErrors).to.deep.equal(["The dev container configuration must be an object"]);
+    }
+}
+module.exports = new TestDevcontainerParser(); // Only to circumvent no usage warning :-/
@@ -0,0 +1,303 @@
+/**
+ * Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import { injectable } from "inversify";
+import * as path from "path";
+import { log } from "./util/logging";
+import { PortConfig, PortRangeConfig, WorkspaceConfig } from "./protocol";
+
+/**
+ * The locations of a dev container config relative to the repository root, in order of precedence.
+ */
+export const DEVCONTAINER_LOCATIONS = [".devcontainer/devcontainer.json", ".devcontainer.json"];
+
+export interface DevcontainerParseResult {
+    config: WorkspaceConfig;
+    /** explain which parts of the dev container config were not mapped */
+    warnings: string[];
+    validationErrors?: string[];
+}
+
+const supportedProperties = new Set([
+    "$schema",
+    "name",
+    "image",
+    "build",
+    "dockerFile",
+    "context",
+    "forwardPorts",
+    "portsAttributes",
+    "onCreateCommand",
+    "updateContentCommand",
+    "postCreateCommand",
+    "postStartCommand",
+    "containerEnv",
+    "remoteEnv",
+    "features",
+    "customizations",
+]);
+
+const onAutoForwardMapping: { [key: string]: PortConfig["onOpen"] } = {
+    notify: "notify",
+    openBrowser: "open-browser",
+    openBrowserOnce: "open-browser",
+    openPreview: "open-preview",
+    silent: "ignore",
+    ignore: "ignore-completely",
+};
+
+/**
+ * Maps a dev container config (https://containers.dev/implementors/json_reference/) onto a workspace config.
+ * Keep in sync with components/gitpod-protocol/go/devcontainer.go, which supervisor uses.
+ */
+@injectable()
+export class DevcontainerParser {
+    /**
+     * @param content the dev container config
+     * @param location the location of the dev container config relative to the repository root, which relative paths are resolved against
+     */
+    public parse(content: string, location: string, parseOptions = { acceptPortRanges: false }): DevcontainerParseResult {
+        let dc: any;
+        try {
+            dc = JSON.parse(stripJSONC(content));
+        } catch (err) {
+            log.error("Unparsable dev container configuration", err, { content });
+            return {
+                config: {},
+                warnings: [],
+                validationErrors: ["Unparsable dev container configuration: " + err.toString()],
+            };
+        }
+        if (typeof dc !== "object" || dc === null || Array.isArray(dc)) {
+            return {
+                config: {},
+                warnings: [],
+                validationErrors: ["The dev container configuration must be an object"],
+            };
+        }
+
+        const dir = path.posix.dirname(location);
+        const config: WorkspaceConfig = {};
+        const warnings = Object.keys(dc)
+            .filter((k) => !supportedProperties.has(k))
+            .sort()
+            .map((k) => `devcontainer.json: "${k}" is not supported and will be ignored`);
+
+        let dockerfile: string | undefined = dc.dockerFile;
+        let context: string | undefined = dc.context;
+        if (dc.build) {
+            dockerfile = dc.build.dockerfile || dockerfile;
+            context = dc.build.context || context;
+            if (dc.build.args && Object.keys(dc.build.args).length > 0) {
+                warnings.push("devcontainer.json: build args are not supported and will be ignored");
+            }
+            if (dc.build.target) {
+                warnings.push("devcontainer.json: build target is not supported and will be ignored");
+            }
+        }
+        if (dockerfile) {
+            config.image = {
+                file: path.posix.join(dir, dockerfile),
+                context: path.posix.join(dir, context || "."),
+            };
+        } else if (typeof dc.image === "string" && dc.image) {
+            config.image = dc.image;
+        }
+
+        for (const [ref, opts] of Object.entries<any>(dc.features || {})) {
+            // features can be configured with an object of options, or in the legacy format with a version string or a boolean
+            let options: { [option: string]: string | boolean };
+            if (typeof opts === "string") {
+                options = { version: opts };
+            } else if (opts === true) {
+                options = {};
+            } else if (opts === false) {
+                continue;
+            } else if (typeof opts === "object" && opts !== null && !Array.isArray(opts)) {
+                options = opts;
+            } else {
+                warnings.push(`devcontainer.json: invalid options of feature ${ref}`);
+                continue;
+            }
+            config.features = { ...config.features, [ref]: options };
+        }
+
+        const ports = this.parsePorts(dc, warnings).filter(
+            (p) => parseOptions.acceptPortRanges || !PortRangeConfig.is(p),
+        );
+        if (ports.length > 0) {
+            config.ports = ports;
+        }
+
+        const init = joinCommands(dc.onCreateCommand, dc.updateContentCommand, dc.postCreateCommand);
+        const command = joinCommands(dc.postStartCommand);
+        if (init || command) {
+            config.tasks = [
+                {
+                    name: dc.name || "devcontainer",
+                    ...(init ? { init } : {}),
+                    ...(command ? { command } : {}),
+                },
+            ];
+        }
+
+        const env = { ...dc.containerEnv, ...dc.remoteEnv };
+        for (const k of Object.keys(env).sort()) {
+            if (typeof env[k] === "string" && env[k].includes("${")) {
+                warnings.push(
+                    `devcontainer.json: variable references in ${k} are not supported and will be passed as is`,
+                );
+            }
+        }
+        if (Object.keys(env).length > 0) {
+            config.env = env;
+        }
+
+        const extensions = dc.customizations?.vscode?.extensions;
+        if (Array.isArray(extensions) && extensions.length > 0) {
+            config.vscode = { extensions };
+        }
+
+        return { config, warnings };
+    }
+
+    protected parsePorts(dc: any, warnings: string[]): PortConfig[] {
+        const ports: PortConfig[] = [];
+        const index = new Map<string, PortConfig>();
+        const add = (port: number | string) => {
+            let p = index.get(String(port));
+            if (!p) {
+                p = { port } as PortConfig;
+                index.set(String(port), p);
+                ports.push(p);
+            }
+            return p;
+        };
+
+        for (const fp of dc.forwardPorts || []) {
+            if (typeof fp === "number") {
+                add(fp);
+            } else if (typeof fp === "string" && /^\d+$/.test(fp)) {
+                add(parseInt(fp, 10));
+            } else if (typeof fp === "string") {
+                warnings.push(`devcontainer.json: forwarding port "${fp}" of another host is not supported`);
+            } else {
+                warnings.push(`devcontainer.json: invalid forward port ${fp}`);
+            }
+        }
+
+        for (const k of Object.keys(dc.portsAttributes || {}).sort()) {
+            let port: number | string;
+            if (/^\d+$/.test(k)) {
+                port = parseInt(k, 10);
+            } else if (/^\d+-\d+$/.test(k)) {
+                port = k;
+            } else {
+                warnings.push(
+                    `devcontainer.json: port attributes for "${k}" are not supported, only ports and port ranges are`,
+                );
+                continue;
+            }
+
+            const attrs = dc.portsAttributes[k] || {};
+            const p = add(port);
+            if (attrs.label) {
+                p.name = attrs.label;
+            }
+            if (attrs.onAutoForward) {
+                const onOpen = onAutoForwardMapping[attrs.onAutoForward];
+                if (onOpen) {
+                    p.onOpen = onOpen;
+                } else {
+                    warnings.push(
+                        `devcontainer.json: onAutoForward "${attrs.onAutoForward}" of port ${k} is not supported`,
+                    );
+                }
+            }
+            if (attrs.protocol === "http" || attrs.protocol === "https") {
+                p.protocol = attrs.protocol;
+            } else if (attrs.protocol) {
+                warnings.push(`devcontainer.json: protocol "${attrs.protocol}" of port ${k} is not supported`);
+            }
+        }
+        return ports;
+    }
+}
+
+/**
+ * produces a single shell command from dev container lifecycle commands, which are run in order
+ */
+function joinCommands(...cmds: any[]): string {
+    return cmds
+        .map(shellCommand)
+        .filter((c) => !!c)
+        .join("\n");
+}
+
+function shellCommand(cmd: any): string {
+    if (typeof cmd === "string") {
+        return cmd;
+    }
+    if (Array.isArray(cmd)) {
+        return cmd.map((a) => shellQuote(String(a))).join(" ");
+    }
+    if (typeof cmd === "object" && cmd !== null) {
+        // commands of an object run in parallel
+        const parallel = Object.keys(cmd)
+            .sort()
+            .map((k) => shellCommand(cmd[k]))
+            .filter((c) => !!c)
+            .map((c) => `(${c}) &`);
+        return parallel.length > 0 ? parallel.join(" ") + " wait" : "";
+    }
+    return "";
+}
+
+function shellQuote(s: string): string {
+    if (/^[a-zA-Z0-9\-_./=:,@+]+$/.test(s)) {
+        return s;
+    }
+    return "'" + s.replace(/'/g, `'\\''`) + "'";
+}
+
+/**
+ * removes comments and trailing commas from JSON with comments
+ */
+export function stripJSONC(content: string): string {
+    let res = "";
+    for (let i = 0; i < content.length; i++) {
+        const c = content[i];
+        if (c === '"') {
+            // copy strings verbatim, including escaped quotes
+            const start = i;
+            for (i++; i < content.length && content[i] !== '"'; i++) {
+                if (content[i] === "\\") {
+                    i++;
+                }
+            }
+            res += content.substring(start, i + 1);
+        } else if (c === "/" && content[i + 1] === "/") {
+            while (i < content.length && content[i] !== "\n") {
+                i++;
+            }
+            if (i < content.length) {
+                res += "\n";
+            }
+        } else if (c === "/" && content[i + 1] === "*") {
+            i += 2;
+            while (i + 1 < content.length && !(content[i] === "*" && content[i + 1] === "/")) {
+                i++;
+            }
+            i++;
+        } else if (c === "}" || c === "]") {
+            // drop a trailing comma before the closing bracket
+            res = res.replace(/,(\s*)$/, "$1") + c;
+        } else {
+            res += c;
+        }
+    }
+    return res;
+}
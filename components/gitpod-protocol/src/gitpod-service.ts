@@ -144,6 +144,9 @@ export interface GitpodServer extends JsonRpcServer<GitpodClient>, AdminServer,
     setWorkspaceTimeout(workspaceId: string, duration: WorkspaceTimeoutDuration): Promise<SetWorkspaceTimeoutResult>;
     getWorkspaceTimeout(workspaceId: string): Promise<GetWorkspaceTimeoutResult>;
 
+    // Workspace disk
+    resizeWorkspaceDisk(workspaceId: string, size: string): Promise<void>;
+
     // Port management
     getOpenPorts(workspaceId: string): Promise<WorkspaceInstancePort[]>;
     openPort(workspaceId: string, port: WorkspaceInstancePort): Promise<WorkspaceInstancePort | undefined>;
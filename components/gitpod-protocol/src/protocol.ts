@@ -856,6 +856,20 @@ export interface JetBrainsPrebuilds {
 export interface RepositoryCloneInformation {
     url: string;
     checkoutLocation?: string;
+    sparseCheckout?: string[];
+    cloneFilter?: CloneFilter;
+    gitLfs?: GitLfsConfig;
+}
+
+/**
+ * Partial clone filter, see `git clone --filter`
+ */
+export type CloneFilter = "blob:none" | "tree:0";
+
+export interface GitLfsConfig {
+    include?: string[];
+    exclude?: string[];
+    skipFetch?: boolean;
 }
 
 export interface CoreDumpConfig {
@@ -868,9 +882,14 @@ export interface WorkspaceConfig {
     mainConfiguration?: string;
     additionalRepositories?: RepositoryCloneInformation[];
     image?: ImageConfig;
+    /** dev container features to install on top of the image, keyed by their OCI reference */
+    features?: { [ref: string]: { [option: string]: string | boolean } };
     ports?: PortConfig[];
     tasks?: TaskConfig[];
     checkoutLocation?: string;
+    sparseCheckout?: string[];
+    cloneFilter?: CloneFilter;
+    gitLfs?: GitLfsConfig;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
     github?: GithubAppConfig;
@@ -887,11 +906,12 @@ export interface WorkspaceConfig {
      * Where the config object originates from.
      *
      * repo - from the repository
+     * devcontainer - mapped from the dev container config of a repository without .gitpod.yml
      * derived - computed based on analyzing the repository
      * additional-content - config comes from additional content, usually provided through the project's configuration
      * default - our static catch-all default config
      */
-    _origin?: "repo" | "derived" | "additional-content" | "default";
+    _origin?: "repo" | "devcontainer" | "derived" | "additional-content" | "default";
 
     /**
      * Set of automatically infered feature flags. That's not something the user can set, but
@@ -1010,6 +1030,8 @@ export interface PortConfig {
     protocol?: PortProtocol;
     description?: string;
     name?: string;
+    process?: string;
+    commandLine?: string;
 }
 export namespace PortConfig {
     export function is(config: any): config is PortConfig {
@@ -1020,6 +1042,8 @@ export namespace PortConfig {
 export interface PortRangeConfig {
     port: string;
     onOpen?: PortOnOpen;
+    process?: string;
+    commandLine?: string;
 }
 export namespace PortRangeConfig {
     export function is(config: any): config is PortRangeConfig {
@@ -1036,6 +1060,21 @@ export interface TaskConfig {
     env?: { [env: string]: any };
     openIn?: "bottom" | "main" | "left" | "right";
     openMode?: "split-top" | "split-left" | "split-right" | "split-bottom" | "tab-before" | "tab-after";
+    dependsOn?: string[];
+    readyWhen?: {
+        port?: number;
+        file?: string;
+        succeeded?: boolean;
+    };
+    restart?: "never" | "on-failure" | "always";
+    maxRestarts?: number;
+    healthCheck?: {
+        command?: string;
+        port?: number;
+        path?: string;
+        interval?: number;
+        failureThreshold?: number;
+    };
 }
 
 export namespace TaskConfig {
@@ -1299,6 +1338,9 @@ export interface GitCheckoutInfo extends Commit {
     checkoutLocation?: string;
     upstreamRemoteURI?: string;
     localBranch?: string;
+    sparseCheckout?: string[];
+    cloneFilter?: CloneFilter;
+    gitLfs?: GitLfsConfig;
 }
 
 export namespace CommitContext {
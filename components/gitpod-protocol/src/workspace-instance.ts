@@ -185,11 +185,12 @@ export interface WorkspaceInstanceConditions {
 // AdmissionLevel describes who can access a workspace instance and its ports.
 export type AdmissionLevel = "owner_only" | "everyone";
 
-// PortVisibility describes how a port can be accessed
-export type PortVisibility = "public" | "private";
+// PortVisibility describes how a port can be accessed. "organization" ports are accessible to all members of the
+// organization which owns the workspace.
+export type PortVisibility = "public" | "private" | "organization";
 
 // PortProtocol
-export type PortProtocol = "http" | "https";
+export type PortProtocol = "http" | "https" | "tcp" | "udp";
 
 // WorkspaceInstancePort describes a port exposed on a workspace instance
 export interface WorkspaceInstancePort {
@@ -203,6 +204,9 @@ export interface WorkspaceInstancePort {
     url?: string;
 
     protocol?: PortProtocol;
+
+    // Identifies the share links which grant access to this port. Changing it revokes all links issued before.
+    shareId?: string;
 }
 
 // WorkspaceInstanceRepoStatus describes the status of th Git working copy of a workspace
@@ -359,6 +363,7 @@ export interface InitializerMetrics {
     backup?: InitializerMetric;
     prebuild?: InitializerMetric;
     composite?: InitializerMetric;
+    gitLfs?: InitializerMetric;
 }
 
 export interface InitializerMetric {
@@ -371,4 +376,9 @@ export interface InitializerMetric {
      * Size in bytes
      */
     size: number;
+
+    /**
+     * Bytes fetched from a remote, e.g. a Git repository
+     */
+    bytesFetched?: number;
 }
@@ -51,6 +51,64 @@ type Configuration struct {
 	SubassemblyBucketName string `json:"subassemblyBucketName,omitempty"`
 	// SubassemblyBucketPrefix configures an optional key prefix used for locating subassemblies in the bucket
 	SubassemblyBucketPrefix string `json:"subassemblyBucketPrefix,omitempty"`
+
+	// SBOM configures the software bill of materials which is attached to every workspace image we build
+	SBOM SBOMConfig `json:"sbom,omitempty"`
+
+	// ImageRefresh optionally rebuilds stale workspace images in the background
+	ImageRefresh *ImageRefreshConfig `json:"imageRefresh,omitempty"`
+}
+
+// ImageRefreshConfig configures the refresh of stale workspace images. The recently built images are remembered
+// in the workspace image repository, hence the refresh requires one. Their build requests are stored without any
+// credentials, so that only images whose sources need no credentials are rebuilt.
+type ImageRefreshConfig struct {
+	// Interval is the duration between two checks for stale workspace images, e.g. 6h.
+	// If empty, stale images are only refreshed on demand.
+	Interval string `json:"interval,omitempty"`
+
+	// MaxAge is the maximum age of a workspace image, e.g. 720h. Older images are rebuilt.
+	// If empty, images do not age.
+	MaxAge string `json:"maxAge,omitempty"`
+
+	// RebuildOnBaseChange rebuilds workspace images whose base image tag points to a different image by now
+	RebuildOnBaseChange bool `json:"rebuildOnBaseChange,omitempty"`
+
+	// MaxImages is the number of recently built workspace images considered for a refresh. Defaults to 500.
+	MaxImages int `json:"maxImages,omitempty"`
+}
+
+// SBOMConfig configures SBOM generation and evaluation
+type SBOMConfig struct {
+	// Generator is the image of the buildkit SBOM generator. Defaults to buildkit's default generator.
+	Generator string `json:"generator,omitempty"`
+
+	// Policy optionally evaluates the SBOM of every workspace image we build against a vulnerability database
+	Policy *SBOMPolicyConfig `json:"policy,omitempty"`
+}
+
+// SBOMPolicyAction determines what happens if a workspace image contains vulnerable packages
+type SBOMPolicyAction string
+
+const (
+	// SBOMPolicyActionWarn reports vulnerable packages in the build log
+	SBOMPolicyActionWarn SBOMPolicyAction = "warn"
+	// SBOMPolicyActionFail reports vulnerable packages in the build log and fails the build. Workspace images
+	// are only available once they passed, and builds fail as well if we cannot evaluate the image.
+	SBOMPolicyActionFail SBOMPolicyAction = "fail"
+)
+
+// SBOMPolicyConfig configures the vulnerability policy of workspace images
+type SBOMPolicyConfig struct {
+	// VulnerabilityDB points to an offline vulnerability database file
+	VulnerabilityDB string `json:"vulnerabilityDB"`
+
+	// Action determines what happens if a workspace image contains vulnerable packages. Defaults to warn.
+	Action SBOMPolicyAction `json:"action,omitempty"`
+
+	// MinSeverity is the lowest severity (low, medium, high or critical) a vulnerability must have to be reported.
+	// Defaults to all vulnerabilities.
+	MinSeverity string `json:"minSeverity,omitempty"`
 }
 
 type TLS struct {
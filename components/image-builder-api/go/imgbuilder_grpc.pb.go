@@ -37,6 +37,8 @@ type ImageBuilderClient interface {
 	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (ImageBuilder_LogsClient, error)
 	// ListBuilds returns a list of currently running builds
 	ListBuilds(ctx context.Context, in *ListBuildsRequest, opts ...grpc.CallOption) (*ListBuildsResponse, error)
+	// RefreshImages checks recently built workspace images for staleness and rebuilds the stale ones ahead of demand
+	RefreshImages(ctx context.Context, in *RefreshImagesRequest, opts ...grpc.CallOption) (*RefreshImagesResponse, error)
 }
 
 type imageBuilderClient struct {
@@ -138,6 +140,15 @@ func (c *imageBuilderClient) ListBuilds(ctx context.Context, in *ListBuildsReque
 	return out, nil
 }
 
+func (c *imageBuilderClient) RefreshImages(ctx context.Context, in *RefreshImagesRequest, opts ...grpc.CallOption) (*RefreshImagesResponse, error) {
+	out := new(RefreshImagesResponse)
+	err := c.cc.Invoke(ctx, "/builder.ImageBuilder/RefreshImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImageBuilderServer is the server API for ImageBuilder service.
 // All implementations must embed UnimplementedImageBuilderServer
 // for forward compatibility
@@ -153,6 +164,8 @@ type ImageBuilderServer interface {
 	Logs(*LogsRequest, ImageBuilder_LogsServer) error
 	// ListBuilds returns a list of currently running builds
 	ListBuilds(context.Context, *ListBuildsRequest) (*ListBuildsResponse, error)
+	// RefreshImages checks recently built workspace images for staleness and rebuilds the stale ones ahead of demand
+	RefreshImages(context.Context, *RefreshImagesRequest) (*RefreshImagesResponse, error)
 	mustEmbedUnimplementedImageBuilderServer()
 }
 
@@ -175,6 +188,9 @@ func (UnimplementedImageBuilderServer) Logs(*LogsRequest, ImageBuilder_LogsServe
 func (UnimplementedImageBuilderServer) ListBuilds(context.Context, *ListBuildsRequest) (*ListBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBuilds not implemented")
 }
+func (UnimplementedImageBuilderServer) RefreshImages(context.Context, *RefreshImagesRequest) (*RefreshImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshImages not implemented")
+}
 func (UnimplementedImageBuilderServer) mustEmbedUnimplementedImageBuilderServer() {}
 
 // UnsafeImageBuilderServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +300,24 @@ func _ImageBuilder_ListBuilds_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageBuilder_RefreshImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageBuilderServer).RefreshImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/builder.ImageBuilder/RefreshImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageBuilderServer).RefreshImages(ctx, req.(*RefreshImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ImageBuilder_ServiceDesc is the grpc.ServiceDesc for ImageBuilder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +337,10 @@ var ImageBuilder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBuilds",
 			Handler:    _ImageBuilder_ListBuilds_Handler,
 		},
+		{
+			MethodName: "RefreshImages",
+			Handler:    _ImageBuilder_RefreshImages_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -101,6 +101,26 @@ func (mr *MockImageBuilderClientMockRecorder) Logs(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockImageBuilderClient)(nil).Logs), varargs...)
 }
 
+// RefreshImages mocks base method.
+func (m *MockImageBuilderClient) RefreshImages(arg0 context.Context, arg1 *api.RefreshImagesRequest, arg2 ...grpc.CallOption) (*api.RefreshImagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshImages", varargs...)
+	ret0, _ := ret[0].(*api.RefreshImagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshImages indicates an expected call of RefreshImages.
+func (mr *MockImageBuilderClientMockRecorder) RefreshImages(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshImages", reflect.TypeOf((*MockImageBuilderClient)(nil).RefreshImages), varargs...)
+}
+
 // ResolveBaseImage mocks base method.
 func (m *MockImageBuilderClient) ResolveBaseImage(arg0 context.Context, arg1 *api.ResolveBaseImageRequest, arg2 ...grpc.CallOption) (*api.ResolveBaseImageResponse, error) {
 	m.ctrl.T.Helper()
@@ -453,6 +473,21 @@ func (mr *MockImageBuilderServerMockRecorder) Logs(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockImageBuilderServer)(nil).Logs), arg0, arg1)
 }
 
+// RefreshImages mocks base method.
+func (m *MockImageBuilderServer) RefreshImages(arg0 context.Context, arg1 *api.RefreshImagesRequest) (*api.RefreshImagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshImages", arg0, arg1)
+	ret0, _ := ret[0].(*api.RefreshImagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshImages indicates an expected call of RefreshImages.
+func (mr *MockImageBuilderServerMockRecorder) RefreshImages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshImages", reflect.TypeOf((*MockImageBuilderServer)(nil).RefreshImages), arg0, arg1)
+}
+
 // ResolveBaseImage mocks base method.
 func (m *MockImageBuilderServer) ResolveBaseImage(arg0 context.Context, arg1 *api.ResolveBaseImageRequest) (*api.ResolveBaseImageResponse, error) {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ interface IImageBuilderService extends grpc.ServiceDefinition<grpc.UntypedServic
     build: IImageBuilderService_IBuild;
     logs: IImageBuilderService_ILogs;
     listBuilds: IImageBuilderService_IListBuilds;
+    refreshImages: IImageBuilderService_IRefreshImages;
 }
 
 interface IImageBuilderService_IResolveBaseImage extends grpc.MethodDefinition<imgbuilder_pb.ResolveBaseImageRequest, imgbuilder_pb.ResolveBaseImageResponse> {
@@ -67,6 +68,15 @@ interface IImageBuilderService_IListBuilds extends grpc.MethodDefinition<imgbuil
     responseSerialize: grpc.serialize<imgbuilder_pb.ListBuildsResponse>;
     responseDeserialize: grpc.deserialize<imgbuilder_pb.ListBuildsResponse>;
 }
+interface IImageBuilderService_IRefreshImages extends grpc.MethodDefinition<imgbuilder_pb.RefreshImagesRequest, imgbuilder_pb.RefreshImagesResponse> {
+    path: "/builder.ImageBuilder/RefreshImages";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<imgbuilder_pb.RefreshImagesRequest>;
+    requestDeserialize: grpc.deserialize<imgbuilder_pb.RefreshImagesRequest>;
+    responseSerialize: grpc.serialize<imgbuilder_pb.RefreshImagesResponse>;
+    responseDeserialize: grpc.deserialize<imgbuilder_pb.RefreshImagesResponse>;
+}
 
 export const ImageBuilderService: IImageBuilderService;
 
@@ -76,6 +86,7 @@ export interface IImageBuilderServer extends grpc.UntypedServiceImplementation {
     build: grpc.handleServerStreamingCall<imgbuilder_pb.BuildRequest, imgbuilder_pb.BuildResponse>;
     logs: grpc.handleServerStreamingCall<imgbuilder_pb.LogsRequest, imgbuilder_pb.LogsResponse>;
     listBuilds: grpc.handleUnaryCall<imgbuilder_pb.ListBuildsRequest, imgbuilder_pb.ListBuildsResponse>;
+    refreshImages: grpc.handleUnaryCall<imgbuilder_pb.RefreshImagesRequest, imgbuilder_pb.RefreshImagesResponse>;
 }
 
 export interface IImageBuilderClient {
@@ -92,6 +103,9 @@ export interface IImageBuilderClient {
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
+    refreshImages(request: imgbuilder_pb.RefreshImagesRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
+    refreshImages(request: imgbuilder_pb.RefreshImagesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
+    refreshImages(request: imgbuilder_pb.RefreshImagesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class ImageBuilderClient extends grpc.Client implements IImageBuilderClient {
@@ -109,4 +123,7 @@ export class ImageBuilderClient extends grpc.Client implements IImageBuilderClie
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
     public listBuilds(request: imgbuilder_pb.ListBuildsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.ListBuildsResponse) => void): grpc.ClientUnaryCall;
+    public refreshImages(request: imgbuilder_pb.RefreshImagesRequest, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
+    public refreshImages(request: imgbuilder_pb.RefreshImagesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
+    public refreshImages(request: imgbuilder_pb.RefreshImagesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: imgbuilder_pb.RefreshImagesResponse) => void): grpc.ClientUnaryCall;
 }
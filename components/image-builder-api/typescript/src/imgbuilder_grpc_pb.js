@@ -77,6 +77,28 @@ function deserialize_builder_LogsResponse(buffer_arg) {
   return imgbuilder_pb.LogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_builder_RefreshImagesRequest(arg) {
+  if (!(arg instanceof imgbuilder_pb.RefreshImagesRequest)) {
+    throw new Error('Expected argument of type builder.RefreshImagesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_builder_RefreshImagesRequest(buffer_arg) {
+  return imgbuilder_pb.RefreshImagesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_builder_RefreshImagesResponse(arg) {
+  if (!(arg instanceof imgbuilder_pb.RefreshImagesResponse)) {
+    throw new Error('Expected argument of type builder.RefreshImagesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_builder_RefreshImagesResponse(buffer_arg) {
+  return imgbuilder_pb.RefreshImagesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_builder_ResolveBaseImageRequest(arg) {
   if (!(arg instanceof imgbuilder_pb.ResolveBaseImageRequest)) {
     throw new Error('Expected argument of type builder.ResolveBaseImageRequest');
@@ -184,6 +206,18 @@ listBuilds: {
     responseSerialize: serialize_builder_ListBuildsResponse,
     responseDeserialize: deserialize_builder_ListBuildsResponse,
   },
+  // RefreshImages checks recently built workspace images for staleness and rebuilds the stale ones ahead of demand
+refreshImages: {
+    path: '/builder.ImageBuilder/RefreshImages',
+    requestStream: false,
+    responseStream: false,
+    requestType: imgbuilder_pb.RefreshImagesRequest,
+    responseType: imgbuilder_pb.RefreshImagesResponse,
+    requestSerialize: serialize_builder_RefreshImagesRequest,
+    requestDeserialize: deserialize_builder_RefreshImagesRequest,
+    responseSerialize: serialize_builder_RefreshImagesResponse,
+    responseDeserialize: deserialize_builder_RefreshImagesResponse,
+  },
 };
 
 exports.ImageBuilderClient = grpc.makeGenericClientConstructor(ImageBuilderService);
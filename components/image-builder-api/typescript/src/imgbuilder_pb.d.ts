@@ -24,6 +24,10 @@ export class BuildSource extends jspb.Message {
     clearFile(): void;
     getFile(): BuildSourceDockerfile | undefined;
     setFile(value?: BuildSourceDockerfile): BuildSource;
+    clearFeaturesList(): void;
+    getFeaturesList(): Array<BuildSourceFeature>;
+    setFeaturesList(value: Array<BuildSourceFeature>): BuildSource;
+    addFeatures(value?: BuildSourceFeature, index?: number): BuildSourceFeature;
 
     getFromCase(): BuildSource.FromCase;
 
@@ -41,6 +45,7 @@ export namespace BuildSource {
     export type AsObject = {
         ref?: BuildSourceReference.AsObject,
         file?: BuildSourceDockerfile.AsObject,
+        featuresList: Array<BuildSourceFeature.AsObject>,
     }
 
     export enum FromCase {
@@ -103,6 +108,31 @@ export namespace BuildSourceDockerfile {
     }
 }
 
+export class BuildSourceFeature extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): BuildSourceFeature;
+
+    getOptionsMap(): jspb.Map<string, string>;
+    clearOptionsMap(): void;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildSourceFeature.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildSourceFeature): BuildSourceFeature.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildSourceFeature, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildSourceFeature;
+    static deserializeBinaryFromReader(message: BuildSourceFeature, reader: jspb.BinaryReader): BuildSourceFeature;
+}
+
+export namespace BuildSourceFeature {
+    export type AsObject = {
+        ref: string,
+
+        optionsMap: Array<[string, string]>,
+    }
+}
+
 export class ResolveBaseImageRequest extends jspb.Message {
     getRef(): string;
     setRef(value: string): ResolveBaseImageRequest;
@@ -113,6 +143,10 @@ export class ResolveBaseImageRequest extends jspb.Message {
     setAuth(value?: BuildRegistryAuth): ResolveBaseImageRequest;
     getUseRetryClient(): boolean;
     setUseRetryClient(value: boolean): ResolveBaseImageRequest;
+    clearPlatformsList(): void;
+    getPlatformsList(): Array<string>;
+    setPlatformsList(value: Array<string>): ResolveBaseImageRequest;
+    addPlatforms(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ResolveBaseImageRequest.AsObject;
@@ -129,6 +163,7 @@ export namespace ResolveBaseImageRequest {
         ref: string,
         auth?: BuildRegistryAuth.AsObject,
         useRetryClient: boolean,
+        platformsList: Array<string>,
     }
 }
 
@@ -165,6 +200,10 @@ export class ResolveWorkspaceImageRequest extends jspb.Message {
     setAuth(value?: BuildRegistryAuth): ResolveWorkspaceImageRequest;
     getUseRetryClient(): boolean;
     setUseRetryClient(value: boolean): ResolveWorkspaceImageRequest;
+    clearPlatformsList(): void;
+    getPlatformsList(): Array<string>;
+    setPlatformsList(value: Array<string>): ResolveWorkspaceImageRequest;
+    addPlatforms(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ResolveWorkspaceImageRequest.AsObject;
@@ -181,6 +220,7 @@ export namespace ResolveWorkspaceImageRequest {
         source?: BuildSource.AsObject,
         auth?: BuildRegistryAuth.AsObject,
         useRetryClient: boolean,
+        platformsList: Array<string>,
     }
 }
 
@@ -231,6 +271,14 @@ export class BuildRequest extends jspb.Message {
     setBaseImageNameResolved(value: string): BuildRequest;
     getUseRetryClient(): boolean;
     setUseRetryClient(value: boolean): BuildRequest;
+    getCacheScope(): string;
+    setCacheScope(value: string): BuildRequest;
+    clearPlatformsList(): void;
+    getPlatformsList(): Array<string>;
+    setPlatformsList(value: Array<string>): BuildRequest;
+    addPlatforms(value: string, index?: number): string;
+    getCacheImportOnly(): boolean;
+    setCacheImportOnly(value: boolean): BuildRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BuildRequest.AsObject;
@@ -251,6 +299,9 @@ export namespace BuildRequest {
         supervisorRef: string,
         baseImageNameResolved: string,
         useRetryClient: boolean,
+        cacheScope: string,
+        platformsList: Array<string>,
+        cacheImportOnly: boolean,
     }
 }
 
@@ -484,6 +535,15 @@ export class BuildInfo extends jspb.Message {
     getLogInfo(): LogInfo | undefined;
     setLogInfo(value?: LogInfo): BuildInfo;
 
+    hasCache(): boolean;
+    clearCache(): void;
+    getCache(): BuildCacheStats | undefined;
+    setCache(value?: BuildCacheStats): BuildInfo;
+    clearPlatformsList(): void;
+    getPlatformsList(): Array<BuildPlatformInfo>;
+    setPlatformsList(value: Array<BuildPlatformInfo>): BuildInfo;
+    addPlatforms(value?: BuildPlatformInfo, index?: number): BuildPlatformInfo;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BuildInfo.AsObject;
     static toObject(includeInstance: boolean, msg: BuildInfo): BuildInfo.AsObject;
@@ -502,6 +562,8 @@ export namespace BuildInfo {
         startedAt: number,
         buildId: string,
         logInfo?: LogInfo.AsObject,
+        cache?: BuildCacheStats.AsObject,
+        platformsList: Array<BuildPlatformInfo.AsObject>,
     }
 }
 
@@ -530,6 +592,123 @@ export namespace LogInfo {
     }
 }
 
+export class BuildCacheStats extends jspb.Message {
+    getSteps(): number;
+    setSteps(value: number): BuildCacheStats;
+    getCached(): number;
+    setCached(value: number): BuildCacheStats;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildCacheStats.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildCacheStats): BuildCacheStats.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildCacheStats, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildCacheStats;
+    static deserializeBinaryFromReader(message: BuildCacheStats, reader: jspb.BinaryReader): BuildCacheStats;
+}
+
+export namespace BuildCacheStats {
+    export type AsObject = {
+        steps: number,
+        cached: number,
+    }
+}
+
+export class BuildPlatformInfo extends jspb.Message {
+    getPlatform(): string;
+    setPlatform(value: string): BuildPlatformInfo;
+    getStatus(): BuildStatus;
+    setStatus(value: BuildStatus): BuildPlatformInfo;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BuildPlatformInfo.AsObject;
+    static toObject(includeInstance: boolean, msg: BuildPlatformInfo): BuildPlatformInfo.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BuildPlatformInfo, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BuildPlatformInfo;
+    static deserializeBinaryFromReader(message: BuildPlatformInfo, reader: jspb.BinaryReader): BuildPlatformInfo;
+}
+
+export namespace BuildPlatformInfo {
+    export type AsObject = {
+        platform: string,
+        status: BuildStatus,
+    }
+}
+
+export class RefreshImagesRequest extends jspb.Message {
+    getDryRun(): boolean;
+    setDryRun(value: boolean): RefreshImagesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RefreshImagesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RefreshImagesRequest): RefreshImagesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RefreshImagesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RefreshImagesRequest;
+    static deserializeBinaryFromReader(message: RefreshImagesRequest, reader: jspb.BinaryReader): RefreshImagesRequest;
+}
+
+export namespace RefreshImagesRequest {
+    export type AsObject = {
+        dryRun: boolean,
+    }
+}
+
+export class RefreshImagesResponse extends jspb.Message {
+    clearImagesList(): void;
+    getImagesList(): Array<RefreshedImage>;
+    setImagesList(value: Array<RefreshedImage>): RefreshImagesResponse;
+    addImages(value?: RefreshedImage, index?: number): RefreshedImage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RefreshImagesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RefreshImagesResponse): RefreshImagesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RefreshImagesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RefreshImagesResponse;
+    static deserializeBinaryFromReader(message: RefreshImagesResponse, reader: jspb.BinaryReader): RefreshImagesResponse;
+}
+
+export namespace RefreshImagesResponse {
+    export type AsObject = {
+        imagesList: Array<RefreshedImage.AsObject>,
+    }
+}
+
+export class RefreshedImage extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): RefreshedImage;
+    getBaseRef(): string;
+    setBaseRef(value: string): RefreshedImage;
+    getStaleReason(): string;
+    setStaleReason(value: string): RefreshedImage;
+    getRebuilding(): boolean;
+    setRebuilding(value: boolean): RefreshedImage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RefreshedImage.AsObject;
+    static toObject(includeInstance: boolean, msg: RefreshedImage): RefreshedImage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RefreshedImage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RefreshedImage;
+    static deserializeBinaryFromReader(message: RefreshedImage, reader: jspb.BinaryReader): RefreshedImage;
+}
+
+export namespace RefreshedImage {
+    export type AsObject = {
+        ref: string,
+        baseRef: string,
+        staleReason: string,
+        rebuilding: boolean,
+    }
+}
+
 export enum BuildStatus {
     UNKNOWN = 0,
     RUNNING = 1,
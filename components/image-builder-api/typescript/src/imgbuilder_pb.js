@@ -23,7 +23,9 @@ var global = (function() { return this || window || global || self || Function('
 
 var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
+goog.exportSymbol('proto.builder.BuildCacheStats', null, global);
 goog.exportSymbol('proto.builder.BuildInfo', null, global);
+goog.exportSymbol('proto.builder.BuildPlatformInfo', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuth', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuth.ModeCase', null, global);
 goog.exportSymbol('proto.builder.BuildRegistryAuthSelective', null, global);
@@ -33,6 +35,7 @@ goog.exportSymbol('proto.builder.BuildResponse', null, global);
 goog.exportSymbol('proto.builder.BuildSource', null, global);
 goog.exportSymbol('proto.builder.BuildSource.FromCase', null, global);
 goog.exportSymbol('proto.builder.BuildSourceDockerfile', null, global);
+goog.exportSymbol('proto.builder.BuildSourceFeature', null, global);
 goog.exportSymbol('proto.builder.BuildSourceReference', null, global);
 goog.exportSymbol('proto.builder.BuildStatus', null, global);
 goog.exportSymbol('proto.builder.ListBuildsRequest', null, global);
@@ -40,6 +43,9 @@ goog.exportSymbol('proto.builder.ListBuildsResponse', null, global);
 goog.exportSymbol('proto.builder.LogInfo', null, global);
 goog.exportSymbol('proto.builder.LogsRequest', null, global);
 goog.exportSymbol('proto.builder.LogsResponse', null, global);
+goog.exportSymbol('proto.builder.RefreshImagesRequest', null, global);
+goog.exportSymbol('proto.builder.RefreshImagesResponse', null, global);
+goog.exportSymbol('proto.builder.RefreshedImage', null, global);
 goog.exportSymbol('proto.builder.ResolveBaseImageRequest', null, global);
 goog.exportSymbol('proto.builder.ResolveBaseImageResponse', null, global);
 goog.exportSymbol('proto.builder.ResolveWorkspaceImageRequest', null, global);
@@ -55,7 +61,7 @@ goog.exportSymbol('proto.builder.ResolveWorkspaceImageResponse', null, global);
  * @constructor
  */
 proto.builder.BuildSource = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.builder.BuildSource.oneofGroups_);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.BuildSource.repeatedFields_, proto.builder.BuildSource.oneofGroups_);
 };
 goog.inherits(proto.builder.BuildSource, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -117,9 +123,30 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.builder.ResolveBaseImageRequest = function(opt_data) {
+proto.builder.BuildSourceFeature = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.builder.BuildSourceFeature, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildSourceFeature.displayName = 'proto.builder.BuildSourceFeature';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.ResolveBaseImageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.ResolveBaseImageRequest.repeatedFields_, null);
+};
 goog.inherits(proto.builder.ResolveBaseImageRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -160,7 +187,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.builder.ResolveWorkspaceImageRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.ResolveWorkspaceImageRequest.repeatedFields_, null);
 };
 goog.inherits(proto.builder.ResolveWorkspaceImageRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -202,7 +229,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.builder.BuildRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.BuildRequest.repeatedFields_, null);
 };
 goog.inherits(proto.builder.BuildRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -391,7 +418,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.builder.BuildInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.BuildInfo.repeatedFields_, null);
 };
 goog.inherits(proto.builder.BuildInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -422,6 +449,117 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.builder.LogInfo.displayName = 'proto.builder.LogInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.BuildCacheStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.BuildCacheStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildCacheStats.displayName = 'proto.builder.BuildCacheStats';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.BuildPlatformInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.BuildPlatformInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.BuildPlatformInfo.displayName = 'proto.builder.BuildPlatformInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.RefreshImagesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.RefreshImagesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.RefreshImagesRequest.displayName = 'proto.builder.RefreshImagesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.RefreshImagesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.builder.RefreshImagesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.builder.RefreshImagesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.RefreshImagesResponse.displayName = 'proto.builder.RefreshImagesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.builder.RefreshedImage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.builder.RefreshedImage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.builder.RefreshedImage.displayName = 'proto.builder.RefreshedImage';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.BuildSource.repeatedFields_ = [3];
 
 /**
  * Oneof group definitions for this message. Each group defines the field
@@ -481,7 +619,9 @@ proto.builder.BuildSource.prototype.toObject = function(opt_includeInstance) {
 proto.builder.BuildSource.toObject = function(includeInstance, msg) {
   var f, obj = {
     ref: (f = msg.getRef()) && proto.builder.BuildSourceReference.toObject(includeInstance, f),
-    file: (f = msg.getFile()) && proto.builder.BuildSourceDockerfile.toObject(includeInstance, f)
+    file: (f = msg.getFile()) && proto.builder.BuildSourceDockerfile.toObject(includeInstance, f),
+    featuresList: jspb.Message.toObjectList(msg.getFeaturesList(),
+    proto.builder.BuildSourceFeature.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -528,6 +668,11 @@ proto.builder.BuildSource.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.builder.BuildSourceDockerfile.deserializeBinaryFromReader);
       msg.setFile(value);
       break;
+    case 3:
+      var value = new proto.builder.BuildSourceFeature;
+      reader.readMessage(value,proto.builder.BuildSourceFeature.deserializeBinaryFromReader);
+      msg.addFeatures(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -573,6 +718,14 @@ proto.builder.BuildSource.serializeBinaryToWriter = function(message, writer) {
       proto.builder.BuildSourceDockerfile.serializeBinaryToWriter
     );
   }
+  f = message.getFeaturesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.builder.BuildSourceFeature.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -650,6 +803,44 @@ proto.builder.BuildSource.prototype.hasFile = function() {
 };
 
 
+/**
+ * repeated BuildSourceFeature features = 3;
+ * @return {!Array<!proto.builder.BuildSourceFeature>}
+ */
+proto.builder.BuildSource.prototype.getFeaturesList = function() {
+  return /** @type{!Array<!proto.builder.BuildSourceFeature>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildSourceFeature, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.builder.BuildSourceFeature>} value
+ * @return {!proto.builder.BuildSource} returns this
+*/
+proto.builder.BuildSource.prototype.setFeaturesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.builder.BuildSourceFeature=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildSourceFeature}
+ */
+proto.builder.BuildSource.prototype.addFeatures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.builder.BuildSourceFeature, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildSource} returns this
+ */
+proto.builder.BuildSource.prototype.clearFeaturesList = function() {
+  return this.setFeaturesList([]);
+};
+
+
 
 
 
@@ -1022,8 +1213,6 @@ proto.builder.BuildSourceDockerfile.prototype.setContextPath = function(value) {
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1037,8 +1226,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ResolveBaseImageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ResolveBaseImageRequest.toObject(opt_includeInstance, this);
+proto.builder.BuildSourceFeature.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildSourceFeature.toObject(opt_includeInstance, this);
 };
 
 
@@ -1047,15 +1236,14 @@ proto.builder.ResolveBaseImageRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ResolveBaseImageRequest} msg The msg instance to transform.
+ * @param {!proto.builder.BuildSourceFeature} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveBaseImageRequest.toObject = function(includeInstance, msg) {
+proto.builder.BuildSourceFeature.toObject = function(includeInstance, msg) {
   var f, obj = {
     ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    auth: (f = msg.getAuth()) && proto.builder.BuildRegistryAuth.toObject(includeInstance, f),
-    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    optionsMap: (f = msg.getOptionsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -1069,23 +1257,23 @@ proto.builder.ResolveBaseImageRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ResolveBaseImageRequest}
+ * @return {!proto.builder.BuildSourceFeature}
  */
-proto.builder.ResolveBaseImageRequest.deserializeBinary = function(bytes) {
+proto.builder.BuildSourceFeature.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ResolveBaseImageRequest;
-  return proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildSourceFeature;
+  return proto.builder.BuildSourceFeature.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ResolveBaseImageRequest} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildSourceFeature} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ResolveBaseImageRequest}
+ * @return {!proto.builder.BuildSourceFeature}
  */
-proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildSourceFeature.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1097,13 +1285,10 @@ proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader = function(msg
       msg.setRef(value);
       break;
     case 2:
-      var value = new proto.builder.BuildRegistryAuth;
-      reader.readMessage(value,proto.builder.BuildRegistryAuth.deserializeBinaryFromReader);
-      msg.setAuth(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUseRetryClient(value);
+      var value = msg.getOptionsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -1118,9 +1303,9 @@ proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ResolveBaseImageRequest.prototype.serializeBinary = function() {
+proto.builder.BuildSourceFeature.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildSourceFeature.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1128,11 +1313,11 @@ proto.builder.ResolveBaseImageRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ResolveBaseImageRequest} message
+ * @param {!proto.builder.BuildSourceFeature} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildSourceFeature.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRef();
   if (f.length > 0) {
@@ -1141,20 +1326,9 @@ proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter = function(message
       f
     );
   }
-  f = message.getAuth();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.builder.BuildRegistryAuth.serializeBinaryToWriter
-    );
-  }
-  f = message.getUseRetryClient();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
+  f = message.getOptionsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
@@ -1163,75 +1337,49 @@ proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter = function(message
  * optional string ref = 1;
  * @return {string}
  */
-proto.builder.ResolveBaseImageRequest.prototype.getRef = function() {
+proto.builder.BuildSourceFeature.prototype.getRef = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ * @return {!proto.builder.BuildSourceFeature} returns this
  */
-proto.builder.ResolveBaseImageRequest.prototype.setRef = function(value) {
+proto.builder.BuildSourceFeature.prototype.setRef = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional BuildRegistryAuth auth = 2;
- * @return {?proto.builder.BuildRegistryAuth}
- */
-proto.builder.ResolveBaseImageRequest.prototype.getAuth = function() {
-  return /** @type{?proto.builder.BuildRegistryAuth} */ (
-    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuth, 2));
-};
-
-
-/**
- * @param {?proto.builder.BuildRegistryAuth|undefined} value
- * @return {!proto.builder.ResolveBaseImageRequest} returns this
-*/
-proto.builder.ResolveBaseImageRequest.prototype.setAuth = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ * map<string, string> options = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.builder.ResolveBaseImageRequest.prototype.clearAuth = function() {
-  return this.setAuth(undefined);
+proto.builder.BuildSourceFeature.prototype.getOptionsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.builder.BuildSourceFeature} returns this
  */
-proto.builder.ResolveBaseImageRequest.prototype.hasAuth = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
+proto.builder.BuildSourceFeature.prototype.clearOptionsMap = function() {
+  this.getOptionsMap().clear();
+  return this;};
 
-/**
- * optional bool use_retry_client = 3;
- * @return {boolean}
- */
-proto.builder.ResolveBaseImageRequest.prototype.getUseRetryClient = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
 
 
 /**
- * @param {boolean} value
- * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.builder.ResolveBaseImageRequest.prototype.setUseRetryClient = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
+proto.builder.ResolveBaseImageRequest.repeatedFields_ = [4];
 
 
 
@@ -1248,8 +1396,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ResolveBaseImageResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ResolveBaseImageResponse.toObject(opt_includeInstance, this);
+proto.builder.ResolveBaseImageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ResolveBaseImageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1258,13 +1406,16 @@ proto.builder.ResolveBaseImageResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ResolveBaseImageResponse} msg The msg instance to transform.
+ * @param {!proto.builder.ResolveBaseImageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveBaseImageResponse.toObject = function(includeInstance, msg) {
+proto.builder.ResolveBaseImageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ref: jspb.Message.getFieldWithDefault(msg, 1, "")
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    auth: (f = msg.getAuth()) && proto.builder.BuildRegistryAuth.toObject(includeInstance, f),
+    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    platformsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1278,23 +1429,23 @@ proto.builder.ResolveBaseImageResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ResolveBaseImageResponse}
+ * @return {!proto.builder.ResolveBaseImageRequest}
  */
-proto.builder.ResolveBaseImageResponse.deserializeBinary = function(bytes) {
+proto.builder.ResolveBaseImageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ResolveBaseImageResponse;
-  return proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.ResolveBaseImageRequest;
+  return proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ResolveBaseImageResponse} msg The message object to deserialize into.
+ * @param {!proto.builder.ResolveBaseImageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ResolveBaseImageResponse}
+ * @return {!proto.builder.ResolveBaseImageRequest}
  */
-proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.ResolveBaseImageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1305,6 +1456,19 @@ proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader = function(ms
       var value = /** @type {string} */ (reader.readString());
       msg.setRef(value);
       break;
+    case 2:
+      var value = new proto.builder.BuildRegistryAuth;
+      reader.readMessage(value,proto.builder.BuildRegistryAuth.deserializeBinaryFromReader);
+      msg.setAuth(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUseRetryClient(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlatforms(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1318,9 +1482,9 @@ proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ResolveBaseImageResponse.prototype.serializeBinary = function() {
+proto.builder.ResolveBaseImageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter(this, writer);
+  proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1328,11 +1492,11 @@ proto.builder.ResolveBaseImageResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ResolveBaseImageResponse} message
+ * @param {!proto.builder.ResolveBaseImageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter = function(message, writer) {
+proto.builder.ResolveBaseImageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getRef();
   if (f.length > 0) {
@@ -1341,6 +1505,28 @@ proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter = function(messag
       f
     );
   }
+  f = message.getAuth();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.builder.BuildRegistryAuth.serializeBinaryToWriter
+    );
+  }
+  f = message.getUseRetryClient();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getPlatformsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -1348,20 +1534,112 @@ proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter = function(messag
  * optional string ref = 1;
  * @return {string}
  */
-proto.builder.ResolveBaseImageResponse.prototype.getRef = function() {
+proto.builder.ResolveBaseImageRequest.prototype.getRef = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.ResolveBaseImageResponse} returns this
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
  */
-proto.builder.ResolveBaseImageResponse.prototype.setRef = function(value) {
+proto.builder.ResolveBaseImageRequest.prototype.setRef = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional BuildRegistryAuth auth = 2;
+ * @return {?proto.builder.BuildRegistryAuth}
+ */
+proto.builder.ResolveBaseImageRequest.prototype.getAuth = function() {
+  return /** @type{?proto.builder.BuildRegistryAuth} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildRegistryAuth, 2));
+};
+
+
+/**
+ * @param {?proto.builder.BuildRegistryAuth|undefined} value
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+*/
+proto.builder.ResolveBaseImageRequest.prototype.setAuth = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ */
+proto.builder.ResolveBaseImageRequest.prototype.clearAuth = function() {
+  return this.setAuth(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.ResolveBaseImageRequest.prototype.hasAuth = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool use_retry_client = 3;
+ * @return {boolean}
+ */
+proto.builder.ResolveBaseImageRequest.prototype.getUseRetryClient = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ */
+proto.builder.ResolveBaseImageRequest.prototype.setUseRetryClient = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * repeated string platforms = 4;
+ * @return {!Array<string>}
+ */
+proto.builder.ResolveBaseImageRequest.prototype.getPlatformsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ */
+proto.builder.ResolveBaseImageRequest.prototype.setPlatformsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ */
+proto.builder.ResolveBaseImageRequest.prototype.addPlatforms = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.ResolveBaseImageRequest} returns this
+ */
+proto.builder.ResolveBaseImageRequest.prototype.clearPlatformsList = function() {
+  return this.setPlatformsList([]);
+};
+
+
 
 
 
@@ -1378,8 +1656,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ResolveWorkspaceImageRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ResolveWorkspaceImageRequest.toObject(opt_includeInstance, this);
+proto.builder.ResolveBaseImageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ResolveBaseImageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1388,15 +1666,13 @@ proto.builder.ResolveWorkspaceImageRequest.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ResolveWorkspaceImageRequest} msg The msg instance to transform.
+ * @param {!proto.builder.ResolveBaseImageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveWorkspaceImageRequest.toObject = function(includeInstance, msg) {
+proto.builder.ResolveBaseImageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    source: (f = msg.getSource()) && proto.builder.BuildSource.toObject(includeInstance, f),
-    auth: (f = msg.getAuth()) && proto.builder.BuildRegistryAuth.toObject(includeInstance, f),
-    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    ref: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1410,23 +1686,23 @@ proto.builder.ResolveWorkspaceImageRequest.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ResolveWorkspaceImageRequest}
+ * @return {!proto.builder.ResolveBaseImageResponse}
  */
-proto.builder.ResolveWorkspaceImageRequest.deserializeBinary = function(bytes) {
+proto.builder.ResolveBaseImageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ResolveWorkspaceImageRequest;
-  return proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.ResolveBaseImageResponse;
+  return proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ResolveWorkspaceImageRequest} msg The message object to deserialize into.
+ * @param {!proto.builder.ResolveBaseImageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ResolveWorkspaceImageRequest}
+ * @return {!proto.builder.ResolveBaseImageResponse}
  */
-proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.ResolveBaseImageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1434,18 +1710,8 @@ proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.builder.BuildSource;
-      reader.readMessage(value,proto.builder.BuildSource.deserializeBinaryFromReader);
-      msg.setSource(value);
-      break;
-    case 2:
-      var value = new proto.builder.BuildRegistryAuth;
-      reader.readMessage(value,proto.builder.BuildRegistryAuth.deserializeBinaryFromReader);
-      msg.setAuth(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setUseRetryClient(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
       break;
     default:
       reader.skipField();
@@ -1460,9 +1726,9 @@ proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ResolveWorkspaceImageRequest.prototype.serializeBinary = function() {
+proto.builder.ResolveBaseImageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ResolveWorkspaceImageRequest.serializeBinaryToWriter(this, writer);
+  proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1470,32 +1736,16 @@ proto.builder.ResolveWorkspaceImageRequest.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ResolveWorkspaceImageRequest} message
+ * @param {!proto.builder.ResolveBaseImageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ResolveWorkspaceImageRequest.serializeBinaryToWriter = function(message, writer) {
+proto.builder.ResolveBaseImageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSource();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.builder.BuildSource.serializeBinaryToWriter
-    );
-  }
-  f = message.getAuth();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.builder.BuildRegistryAuth.serializeBinaryToWriter
-    );
-  }
-  f = message.getUseRetryClient();
-  if (f) {
-    writer.writeBool(
-      3,
       f
     );
   }
@@ -1503,45 +1753,222 @@ proto.builder.ResolveWorkspaceImageRequest.serializeBinaryToWriter = function(me
 
 
 /**
- * optional BuildSource source = 1;
- * @return {?proto.builder.BuildSource}
+ * optional string ref = 1;
+ * @return {string}
  */
-proto.builder.ResolveWorkspaceImageRequest.prototype.getSource = function() {
-  return /** @type{?proto.builder.BuildSource} */ (
-    jspb.Message.getWrapperField(this, proto.builder.BuildSource, 1));
-};
-
-
-/**
- * @param {?proto.builder.BuildSource|undefined} value
- * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
-*/
-proto.builder.ResolveWorkspaceImageRequest.prototype.setSource = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.builder.ResolveBaseImageResponse.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+ * @param {string} value
+ * @return {!proto.builder.ResolveBaseImageResponse} returns this
  */
-proto.builder.ResolveWorkspaceImageRequest.prototype.clearSource = function() {
-  return this.setSource(undefined);
+proto.builder.ResolveBaseImageResponse.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.builder.ResolveWorkspaceImageRequest.prototype.hasSource = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
+proto.builder.ResolveWorkspaceImageRequest.repeatedFields_ = [4];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional BuildRegistryAuth auth = 2;
- * @return {?proto.builder.BuildRegistryAuth}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ResolveWorkspaceImageRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.ResolveWorkspaceImageRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ResolveWorkspaceImageRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    source: (f = msg.getSource()) && proto.builder.BuildSource.toObject(includeInstance, f),
+    auth: (f = msg.getAuth()) && proto.builder.BuildRegistryAuth.toObject(includeInstance, f),
+    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    platformsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.ResolveWorkspaceImageRequest}
+ */
+proto.builder.ResolveWorkspaceImageRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.ResolveWorkspaceImageRequest;
+  return proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.ResolveWorkspaceImageRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.ResolveWorkspaceImageRequest}
+ */
+proto.builder.ResolveWorkspaceImageRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.builder.BuildSource;
+      reader.readMessage(value,proto.builder.BuildSource.deserializeBinaryFromReader);
+      msg.setSource(value);
+      break;
+    case 2:
+      var value = new proto.builder.BuildRegistryAuth;
+      reader.readMessage(value,proto.builder.BuildRegistryAuth.deserializeBinaryFromReader);
+      msg.setAuth(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUseRetryClient(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlatforms(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.ResolveWorkspaceImageRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.ResolveWorkspaceImageRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ResolveWorkspaceImageRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSource();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.builder.BuildSource.serializeBinaryToWriter
+    );
+  }
+  f = message.getAuth();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.builder.BuildRegistryAuth.serializeBinaryToWriter
+    );
+  }
+  f = message.getUseRetryClient();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getPlatformsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional BuildSource source = 1;
+ * @return {?proto.builder.BuildSource}
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.getSource = function() {
+  return /** @type{?proto.builder.BuildSource} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildSource, 1));
+};
+
+
+/**
+ * @param {?proto.builder.BuildSource|undefined} value
+ * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+*/
+proto.builder.ResolveWorkspaceImageRequest.prototype.setSource = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.clearSource = function() {
+  return this.setSource(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.hasSource = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional BuildRegistryAuth auth = 2;
+ * @return {?proto.builder.BuildRegistryAuth}
  */
 proto.builder.ResolveWorkspaceImageRequest.prototype.getAuth = function() {
   return /** @type{?proto.builder.BuildRegistryAuth} */ (
@@ -1594,6 +2021,43 @@ proto.builder.ResolveWorkspaceImageRequest.prototype.setUseRetryClient = functio
 };
 
 
+/**
+ * repeated string platforms = 4;
+ * @return {!Array<string>}
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.getPlatformsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.setPlatformsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.addPlatforms = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.ResolveWorkspaceImageRequest} returns this
+ */
+proto.builder.ResolveWorkspaceImageRequest.prototype.clearPlatformsList = function() {
+  return this.setPlatformsList([]);
+};
+
+
 
 
 
@@ -1785,6 +2249,13 @@ proto.builder.ResolveWorkspaceImageResponse.prototype.setStatus = function(value
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.BuildRequest.repeatedFields_ = [9];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1822,7 +2293,10 @@ proto.builder.BuildRequest.toObject = function(includeInstance, msg) {
     triggeredBy: jspb.Message.getFieldWithDefault(msg, 4, ""),
     supervisorRef: jspb.Message.getFieldWithDefault(msg, 5, ""),
     baseImageNameResolved: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    useRetryClient: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    cacheScope: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    platformsList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
+    cacheImportOnly: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -1889,6 +2363,18 @@ proto.builder.BuildRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setUseRetryClient(value);
       break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCacheScope(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPlatforms(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCacheImportOnly(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1969,6 +2455,27 @@ proto.builder.BuildRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getCacheScope();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getPlatformsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
+  f = message.getCacheImportOnly();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -2136,24 +2643,97 @@ proto.builder.BuildRequest.prototype.setUseRetryClient = function(value) {
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * optional string cache_scope = 8;
+ * @return {string}
  */
-proto.builder.BuildRegistryAuth.oneofGroups_ = [[1,2]];
+proto.builder.BuildRequest.prototype.getCacheScope = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
 
 /**
- * @enum {number}
+ * @param {string} value
+ * @return {!proto.builder.BuildRequest} returns this
  */
-proto.builder.BuildRegistryAuth.ModeCase = {
-  MODE_NOT_SET: 0,
-  TOTAL: 1,
-  SELECTIVE: 2
+proto.builder.BuildRequest.prototype.setCacheScope = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * repeated string platforms = 9;
+ * @return {!Array<string>}
+ */
+proto.builder.BuildRequest.prototype.getPlatformsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.setPlatformsList = function(value) {
+  return jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.addPlatforms = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.clearPlatformsList = function() {
+  return this.setPlatformsList([]);
+};
+
+
+/**
+ * optional bool cache_import_only = 10;
+ * @return {boolean}
+ */
+proto.builder.BuildRequest.prototype.getCacheImportOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.BuildRequest} returns this
+ */
+proto.builder.BuildRequest.prototype.setCacheImportOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.builder.BuildRegistryAuth.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.builder.BuildRegistryAuth.ModeCase = {
+  MODE_NOT_SET: 0,
+  TOTAL: 1,
+  SELECTIVE: 2
 };
 
 /**
@@ -3350,14 +3930,849 @@ proto.builder.LogsResponse.prototype.getContent_asU8 = function() {
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.builder.LogsResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.builder.LogsResponse} returns this
+ */
+proto.builder.LogsResponse.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ListBuildsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.ListBuildsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.ListBuildsRequest}
+ */
+proto.builder.ListBuildsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.ListBuildsRequest;
+  return proto.builder.ListBuildsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.ListBuildsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.ListBuildsRequest}
+ */
+proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.ListBuildsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.ListBuildsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.ListBuildsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.ListBuildsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.ListBuildsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    buildsList: jspb.Message.toObjectList(msg.getBuildsList(),
+    proto.builder.BuildInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.ListBuildsResponse}
+ */
+proto.builder.ListBuildsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.ListBuildsResponse;
+  return proto.builder.ListBuildsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.ListBuildsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.ListBuildsResponse}
+ */
+proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.builder.BuildInfo;
+      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
+      msg.addBuilds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.ListBuildsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.ListBuildsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.ListBuildsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBuildsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.builder.BuildInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated BuildInfo builds = 1;
+ * @return {!Array<!proto.builder.BuildInfo>}
+ */
+proto.builder.ListBuildsResponse.prototype.getBuildsList = function() {
+  return /** @type{!Array<!proto.builder.BuildInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.builder.BuildInfo>} value
+ * @return {!proto.builder.ListBuildsResponse} returns this
+*/
+proto.builder.ListBuildsResponse.prototype.setBuildsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.builder.BuildInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildInfo}
+ */
+proto.builder.ListBuildsResponse.prototype.addBuilds = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.builder.BuildInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.ListBuildsResponse} returns this
+ */
+proto.builder.ListBuildsResponse.prototype.clearBuildsList = function() {
+  return this.setBuildsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.builder.BuildInfo.repeatedFields_ = [8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.BuildInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    startedAt: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    buildId: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    logInfo: (f = msg.getLogInfo()) && proto.builder.LogInfo.toObject(includeInstance, f),
+    cache: (f = msg.getCache()) && proto.builder.BuildCacheStats.toObject(includeInstance, f),
+    platformsList: jspb.Message.toObjectList(msg.getPlatformsList(),
+    proto.builder.BuildPlatformInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.BuildInfo}
+ */
+proto.builder.BuildInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.BuildInfo;
+  return proto.builder.BuildInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.BuildInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.BuildInfo}
+ */
+proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBaseRef(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartedAt(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
+      break;
+    case 6:
+      var value = new proto.builder.LogInfo;
+      reader.readMessage(value,proto.builder.LogInfo.deserializeBinaryFromReader);
+      msg.setLogInfo(value);
+      break;
+    case 7:
+      var value = new proto.builder.BuildCacheStats;
+      reader.readMessage(value,proto.builder.BuildCacheStats.deserializeBinaryFromReader);
+      msg.setCache(value);
+      break;
+    case 8:
+      var value = new proto.builder.BuildPlatformInfo;
+      reader.readMessage(value,proto.builder.BuildPlatformInfo.deserializeBinaryFromReader);
+      msg.addPlatforms(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.BuildInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.BuildInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.BuildInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.BuildInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBaseRef();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLogInfo();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.builder.LogInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getCache();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.builder.BuildCacheStats.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlatformsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.builder.BuildPlatformInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string ref = 1;
+ * @return {string}
+ */
+proto.builder.BuildInfo.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string base_ref = 4;
+ * @return {string}
+ */
+proto.builder.BuildInfo.prototype.getBaseRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.setBaseRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional BuildStatus status = 2;
+ * @return {!proto.builder.BuildStatus}
+ */
+proto.builder.BuildInfo.prototype.getStatus = function() {
+  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.builder.BuildStatus} value
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional int64 started_at = 3;
+ * @return {number}
+ */
+proto.builder.BuildInfo.prototype.getStartedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.setStartedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string build_id = 5;
+ * @return {string}
+ */
+proto.builder.BuildInfo.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional LogInfo log_info = 6;
+ * @return {?proto.builder.LogInfo}
+ */
+proto.builder.BuildInfo.prototype.getLogInfo = function() {
+  return /** @type{?proto.builder.LogInfo} */ (
+    jspb.Message.getWrapperField(this, proto.builder.LogInfo, 6));
+};
+
+
+/**
+ * @param {?proto.builder.LogInfo|undefined} value
+ * @return {!proto.builder.BuildInfo} returns this
+*/
+proto.builder.BuildInfo.prototype.setLogInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.clearLogInfo = function() {
+  return this.setLogInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.BuildInfo.prototype.hasLogInfo = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional BuildCacheStats cache = 7;
+ * @return {?proto.builder.BuildCacheStats}
+ */
+proto.builder.BuildInfo.prototype.getCache = function() {
+  return /** @type{?proto.builder.BuildCacheStats} */ (
+    jspb.Message.getWrapperField(this, proto.builder.BuildCacheStats, 7));
+};
+
+
+/**
+ * @param {?proto.builder.BuildCacheStats|undefined} value
+ * @return {!proto.builder.BuildInfo} returns this
+*/
+proto.builder.BuildInfo.prototype.setCache = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.clearCache = function() {
+  return this.setCache(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.builder.BuildInfo.prototype.hasCache = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * repeated BuildPlatformInfo platforms = 8;
+ * @return {!Array<!proto.builder.BuildPlatformInfo>}
+ */
+proto.builder.BuildInfo.prototype.getPlatformsList = function() {
+  return /** @type{!Array<!proto.builder.BuildPlatformInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildPlatformInfo, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.builder.BuildPlatformInfo>} value
+ * @return {!proto.builder.BuildInfo} returns this
+*/
+proto.builder.BuildInfo.prototype.setPlatformsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.builder.BuildPlatformInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.BuildPlatformInfo}
+ */
+proto.builder.BuildInfo.prototype.addPlatforms = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.builder.BuildPlatformInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.BuildInfo} returns this
+ */
+proto.builder.BuildInfo.prototype.clearPlatformsList = function() {
+  return this.setPlatformsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.LogInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.LogInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.LogInfo}
+ */
+proto.builder.LogInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.LogInfo;
+  return proto.builder.LogInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.LogInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.LogInfo}
+ */
+proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = msg.getHeadersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.builder.LogInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.LogInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.LogInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.builder.LogInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHeadersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.builder.LogInfo.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.LogInfo} returns this
+ */
+proto.builder.LogInfo.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> headers = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.builder.LogsResponse.prototype.setContent = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.builder.LogInfo.prototype.getHeadersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.builder.LogInfo} returns this
+ */
+proto.builder.LogInfo.prototype.clearHeadersMap = function() {
+  this.getHeadersMap().clear();
+  return this;};
+
 
 
 
@@ -3374,8 +4789,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ListBuildsRequest.toObject(opt_includeInstance, this);
+proto.builder.BuildCacheStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildCacheStats.toObject(opt_includeInstance, this);
 };
 
 
@@ -3384,13 +4799,14 @@ proto.builder.ListBuildsRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ListBuildsRequest} msg The msg instance to transform.
+ * @param {!proto.builder.BuildCacheStats} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
+proto.builder.BuildCacheStats.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    steps: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    cached: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3404,29 +4820,37 @@ proto.builder.ListBuildsRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ListBuildsRequest}
+ * @return {!proto.builder.BuildCacheStats}
  */
-proto.builder.ListBuildsRequest.deserializeBinary = function(bytes) {
+proto.builder.BuildCacheStats.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ListBuildsRequest;
-  return proto.builder.ListBuildsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildCacheStats;
+  return proto.builder.BuildCacheStats.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ListBuildsRequest} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildCacheStats} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ListBuildsRequest}
+ * @return {!proto.builder.BuildCacheStats}
  */
-proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildCacheStats.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSteps(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCached(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3440,9 +4864,9 @@ proto.builder.ListBuildsRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
+proto.builder.BuildCacheStats.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ListBuildsRequest.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildCacheStats.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3450,22 +4874,64 @@ proto.builder.ListBuildsRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ListBuildsRequest} message
+ * @param {!proto.builder.BuildCacheStats} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildCacheStats.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getSteps();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getCached();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional int32 steps = 1;
+ * @return {number}
+ */
+proto.builder.BuildCacheStats.prototype.getSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.builder.BuildCacheStats} returns this
  */
-proto.builder.ListBuildsResponse.repeatedFields_ = [1];
+proto.builder.BuildCacheStats.prototype.setSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 cached = 2;
+ * @return {number}
+ */
+proto.builder.BuildCacheStats.prototype.getCached = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.builder.BuildCacheStats} returns this
+ */
+proto.builder.BuildCacheStats.prototype.setCached = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
 
 
 
@@ -3482,8 +4948,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.ListBuildsResponse.toObject(opt_includeInstance, this);
+proto.builder.BuildPlatformInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.BuildPlatformInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3492,14 +4958,14 @@ proto.builder.ListBuildsResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.ListBuildsResponse} msg The msg instance to transform.
+ * @param {!proto.builder.BuildPlatformInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
+proto.builder.BuildPlatformInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    buildsList: jspb.Message.toObjectList(msg.getBuildsList(),
-    proto.builder.BuildInfo.toObject, includeInstance)
+    platform: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3513,23 +4979,23 @@ proto.builder.ListBuildsResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.ListBuildsResponse}
+ * @return {!proto.builder.BuildPlatformInfo}
  */
-proto.builder.ListBuildsResponse.deserializeBinary = function(bytes) {
+proto.builder.BuildPlatformInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.ListBuildsResponse;
-  return proto.builder.ListBuildsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.BuildPlatformInfo;
+  return proto.builder.BuildPlatformInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.ListBuildsResponse} msg The message object to deserialize into.
+ * @param {!proto.builder.BuildPlatformInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.ListBuildsResponse}
+ * @return {!proto.builder.BuildPlatformInfo}
  */
-proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.BuildPlatformInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3537,9 +5003,12 @@ proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.builder.BuildInfo;
-      reader.readMessage(value,proto.builder.BuildInfo.deserializeBinaryFromReader);
-      msg.addBuilds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlatform(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
+      msg.setStatus(value);
       break;
     default:
       reader.skipField();
@@ -3554,9 +5023,9 @@ proto.builder.ListBuildsResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
+proto.builder.BuildPlatformInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.ListBuildsResponse.serializeBinaryToWriter(this, writer);
+  proto.builder.BuildPlatformInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3564,64 +5033,66 @@ proto.builder.ListBuildsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.ListBuildsResponse} message
+ * @param {!proto.builder.BuildPlatformInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.ListBuildsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.builder.BuildPlatformInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBuildsList();
+  f = message.getPlatform();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.builder.BuildInfo.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated BuildInfo builds = 1;
- * @return {!Array<!proto.builder.BuildInfo>}
+ * optional string platform = 1;
+ * @return {string}
  */
-proto.builder.ListBuildsResponse.prototype.getBuildsList = function() {
-  return /** @type{!Array<!proto.builder.BuildInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.builder.BuildInfo, 1));
+proto.builder.BuildPlatformInfo.prototype.getPlatform = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.builder.BuildInfo>} value
- * @return {!proto.builder.ListBuildsResponse} returns this
-*/
-proto.builder.ListBuildsResponse.prototype.setBuildsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.builder.BuildPlatformInfo} returns this
+ */
+proto.builder.BuildPlatformInfo.prototype.setPlatform = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.builder.BuildInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.builder.BuildInfo}
+ * optional BuildStatus status = 2;
+ * @return {!proto.builder.BuildStatus}
  */
-proto.builder.ListBuildsResponse.prototype.addBuilds = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.builder.BuildInfo, opt_index);
+proto.builder.BuildPlatformInfo.prototype.getStatus = function() {
+  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.builder.ListBuildsResponse} returns this
+ * @param {!proto.builder.BuildStatus} value
+ * @return {!proto.builder.BuildPlatformInfo} returns this
  */
-proto.builder.ListBuildsResponse.prototype.clearBuildsList = function() {
-  return this.setBuildsList([]);
+proto.builder.BuildPlatformInfo.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3635,8 +5106,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.BuildInfo.toObject(opt_includeInstance, this);
+proto.builder.RefreshImagesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.RefreshImagesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3645,18 +5116,13 @@ proto.builder.BuildInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.BuildInfo} msg The msg instance to transform.
+ * @param {!proto.builder.RefreshImagesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
+proto.builder.RefreshImagesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    baseRef: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    status: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    startedAt: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    buildId: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    logInfo: (f = msg.getLogInfo()) && proto.builder.LogInfo.toObject(includeInstance, f)
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -3670,23 +5136,23 @@ proto.builder.BuildInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.BuildInfo}
+ * @return {!proto.builder.RefreshImagesRequest}
  */
-proto.builder.BuildInfo.deserializeBinary = function(bytes) {
+proto.builder.RefreshImagesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.BuildInfo;
-  return proto.builder.BuildInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.RefreshImagesRequest;
+  return proto.builder.RefreshImagesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.BuildInfo} msg The message object to deserialize into.
+ * @param {!proto.builder.RefreshImagesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.BuildInfo}
+ * @return {!proto.builder.RefreshImagesRequest}
  */
-proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.RefreshImagesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3694,235 +5160,227 @@ proto.builder.BuildInfo.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRef(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBaseRef(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.builder.BuildStatus} */ (reader.readEnum());
-      msg.setStatus(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setStartedAt(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildId(value);
-      break;
-    case 6:
-      var value = new proto.builder.LogInfo;
-      reader.readMessage(value,proto.builder.LogInfo.deserializeBinaryFromReader);
-      msg.setLogInfo(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
       break;
     default:
       reader.skipField();
       break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.builder.BuildInfo.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.builder.BuildInfo.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.builder.BuildInfo} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.builder.BuildInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getRef();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getBaseRef();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getStartedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-  f = message.getBuildId();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getLogInfo();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.builder.LogInfo.serializeBinaryToWriter
-    );
+    }
   }
+  return msg;
 };
 
 
 /**
- * optional string ref = 1;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.builder.BuildInfo.prototype.getRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.builder.RefreshImagesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.RefreshImagesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.RefreshImagesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.prototype.setRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.builder.RefreshImagesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDryRun();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string base_ref = 4;
- * @return {string}
+ * optional bool dry_run = 1;
+ * @return {boolean}
  */
-proto.builder.BuildInfo.prototype.getBaseRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.builder.RefreshImagesRequest.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {boolean} value
+ * @return {!proto.builder.RefreshImagesRequest} returns this
  */
-proto.builder.BuildInfo.prototype.setBaseRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.builder.RefreshImagesRequest.prototype.setDryRun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
+
 /**
- * optional BuildStatus status = 2;
- * @return {!proto.builder.BuildStatus}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.builder.BuildInfo.prototype.getStatus = function() {
-  return /** @type {!proto.builder.BuildStatus} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.builder.RefreshImagesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.builder.RefreshImagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.RefreshImagesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.builder.BuildStatus} value
- * @return {!proto.builder.BuildInfo} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.builder.RefreshImagesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.builder.RefreshImagesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    imagesList: jspb.Message.toObjectList(msg.getImagesList(),
+    proto.builder.RefreshedImage.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int64 started_at = 3;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.builder.RefreshImagesResponse}
  */
-proto.builder.BuildInfo.prototype.getStartedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.builder.RefreshImagesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.builder.RefreshImagesResponse;
+  return proto.builder.RefreshImagesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.builder.BuildInfo} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.builder.RefreshImagesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.builder.RefreshImagesResponse}
  */
-proto.builder.BuildInfo.prototype.setStartedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.builder.RefreshImagesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.builder.RefreshedImage;
+      reader.readMessage(value,proto.builder.RefreshedImage.deserializeBinaryFromReader);
+      msg.addImages(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string build_id = 5;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.builder.BuildInfo.prototype.getBuildId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.builder.RefreshImagesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.builder.RefreshImagesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.builder.BuildInfo} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.builder.RefreshImagesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.BuildInfo.prototype.setBuildId = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.builder.RefreshImagesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.builder.RefreshedImage.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional LogInfo log_info = 6;
- * @return {?proto.builder.LogInfo}
+ * repeated RefreshedImage images = 1;
+ * @return {!Array<!proto.builder.RefreshedImage>}
  */
-proto.builder.BuildInfo.prototype.getLogInfo = function() {
-  return /** @type{?proto.builder.LogInfo} */ (
-    jspb.Message.getWrapperField(this, proto.builder.LogInfo, 6));
+proto.builder.RefreshImagesResponse.prototype.getImagesList = function() {
+  return /** @type{!Array<!proto.builder.RefreshedImage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.builder.RefreshedImage, 1));
 };
 
 
 /**
- * @param {?proto.builder.LogInfo|undefined} value
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {!Array<!proto.builder.RefreshedImage>} value
+ * @return {!proto.builder.RefreshImagesResponse} returns this
 */
-proto.builder.BuildInfo.prototype.setLogInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+proto.builder.RefreshImagesResponse.prototype.setImagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.builder.BuildInfo} returns this
+ * @param {!proto.builder.RefreshedImage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.builder.RefreshedImage}
  */
-proto.builder.BuildInfo.prototype.clearLogInfo = function() {
-  return this.setLogInfo(undefined);
+proto.builder.RefreshImagesResponse.prototype.addImages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.builder.RefreshedImage, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.builder.RefreshImagesResponse} returns this
  */
-proto.builder.BuildInfo.prototype.hasLogInfo = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.builder.RefreshImagesResponse.prototype.clearImagesList = function() {
+  return this.setImagesList([]);
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3936,8 +5394,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.builder.LogInfo.toObject(opt_includeInstance, this);
+proto.builder.RefreshedImage.prototype.toObject = function(opt_includeInstance) {
+  return proto.builder.RefreshedImage.toObject(opt_includeInstance, this);
 };
 
 
@@ -3946,14 +5404,16 @@ proto.builder.LogInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.builder.LogInfo} msg The msg instance to transform.
+ * @param {!proto.builder.RefreshedImage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogInfo.toObject = function(includeInstance, msg) {
+proto.builder.RefreshedImage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : []
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    baseRef: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    staleReason: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    rebuilding: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -3967,23 +5427,23 @@ proto.builder.LogInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.builder.LogInfo}
+ * @return {!proto.builder.RefreshedImage}
  */
-proto.builder.LogInfo.deserializeBinary = function(bytes) {
+proto.builder.RefreshedImage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.builder.LogInfo;
-  return proto.builder.LogInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.builder.RefreshedImage;
+  return proto.builder.RefreshedImage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.builder.LogInfo} msg The message object to deserialize into.
+ * @param {!proto.builder.RefreshedImage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.builder.LogInfo}
+ * @return {!proto.builder.RefreshedImage}
  */
-proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.builder.RefreshedImage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3992,13 +5452,19 @@ proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setRef(value);
       break;
     case 2:
-      var value = msg.getHeadersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBaseRef(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStaleReason(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRebuilding(value);
       break;
     default:
       reader.skipField();
@@ -4013,9 +5479,9 @@ proto.builder.LogInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.builder.LogInfo.prototype.serializeBinary = function() {
+proto.builder.RefreshedImage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.builder.LogInfo.serializeBinaryToWriter(this, writer);
+  proto.builder.RefreshedImage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4023,64 +5489,113 @@ proto.builder.LogInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.builder.LogInfo} message
+ * @param {!proto.builder.RefreshedImage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.builder.LogInfo.serializeBinaryToWriter = function(message, writer) {
+proto.builder.RefreshedImage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getRef();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getHeadersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getBaseRef();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getStaleReason();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRebuilding();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
   }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string ref = 1;
  * @return {string}
  */
-proto.builder.LogInfo.prototype.getUrl = function() {
+proto.builder.RefreshedImage.prototype.getRef = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.builder.LogInfo} returns this
+ * @return {!proto.builder.RefreshedImage} returns this
  */
-proto.builder.LogInfo.prototype.setUrl = function(value) {
+proto.builder.RefreshedImage.prototype.setRef = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, string> headers = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string base_ref = 2;
+ * @return {string}
  */
-proto.builder.LogInfo.prototype.getHeadersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.builder.RefreshedImage.prototype.getBaseRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.builder.LogInfo} returns this
+ * @param {string} value
+ * @return {!proto.builder.RefreshedImage} returns this
  */
-proto.builder.LogInfo.prototype.clearHeadersMap = function() {
-  this.getHeadersMap().clear();
-  return this;};
+proto.builder.RefreshedImage.prototype.setBaseRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string stale_reason = 3;
+ * @return {string}
+ */
+proto.builder.RefreshedImage.prototype.getStaleReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.builder.RefreshedImage} returns this
+ */
+proto.builder.RefreshedImage.prototype.setStaleReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool rebuilding = 4;
+ * @return {boolean}
+ */
+proto.builder.RefreshedImage.prototype.getRebuilding = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.builder.RefreshedImage} returns this
+ */
+proto.builder.RefreshedImage.prototype.setRebuilding = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
 
 
 /**
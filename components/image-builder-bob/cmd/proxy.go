@@ -19,6 +19,9 @@ import (
 
 var proxyOpts struct {
 	BaseRef, TargetRef string
+	CacheRef           string
+	PreviousCacheRef   string
+	CacheReadOnly      bool
 	Auth               string
 	AdditionalAuth     string
 }
@@ -60,7 +63,7 @@ var proxyCmd = &cobra.Command{
 
 		auth := func() docker.Authorizer { return docker.NewDockerAuthorizer(docker.WithAuthCreds(authP.Authorize)) }
 		mirrorAuth := func() docker.Authorizer { return docker.NewDockerAuthorizer(docker.WithAuthCreds(authA.Authorize)) }
-		prx, err := proxy.NewProxy(&url.URL{Host: "localhost:8080", Scheme: "http"}, map[string]proxy.Repo{
+		aliases := map[string]proxy.Repo{
 			"base": {
 				Host: reference.Domain(baseref),
 				Repo: reference.Path(baseref),
@@ -73,7 +76,38 @@ var proxyCmd = &cobra.Command{
 				Tag:  targettag,
 				Auth: auth,
 			},
-		}, mirrorAuth)
+		}
+		// builds of untrusted sources must not write to the cache other builds import,
+		// and the cache of the previous period is only ever imported from
+		caches := []struct {
+			Alias    string
+			Ref      string
+			ReadOnly bool
+		}{
+			{Alias: "cache", Ref: proxyOpts.CacheRef, ReadOnly: proxyOpts.CacheReadOnly},
+			{Alias: "cache-previous", Ref: proxyOpts.PreviousCacheRef, ReadOnly: true},
+		}
+		for _, c := range caches {
+			if c.Ref == "" {
+				continue
+			}
+			cacheref, err := reference.ParseNormalizedNamed(c.Ref)
+			if err != nil {
+				log.WithError(err).WithField("alias", c.Alias).Fatal("cannot parse cache ref")
+			}
+			var cachetag string
+			if r, ok := cacheref.(reference.NamedTagged); ok {
+				cachetag = r.Tag()
+			}
+			aliases[c.Alias] = proxy.Repo{
+				Host:     reference.Domain(cacheref),
+				Repo:     reference.Path(cacheref),
+				Tag:      cachetag,
+				Auth:     auth,
+				ReadOnly: c.ReadOnly,
+			}
+		}
+		prx, err := proxy.NewProxy(&url.URL{Host: "localhost:8080", Scheme: "http"}, aliases, mirrorAuth)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -93,6 +127,9 @@ func init() {
 	// These env vars start with `WORKSPACEKIT_` so that they aren't passed on to ring2
 	proxyCmd.Flags().StringVar(&proxyOpts.BaseRef, "base-ref", os.Getenv("WORKSPACEKIT_BOBPROXY_BASEREF"), "ref of the base image")
 	proxyCmd.Flags().StringVar(&proxyOpts.TargetRef, "target-ref", os.Getenv("WORKSPACEKIT_BOBPROXY_TARGETREF"), "ref of the target image")
+	proxyCmd.Flags().StringVar(&proxyOpts.CacheRef, "cache-ref", os.Getenv("WORKSPACEKIT_BOBPROXY_CACHEREF"), "ref of the build cache")
+	proxyCmd.Flags().StringVar(&proxyOpts.PreviousCacheRef, "previous-cache-ref", os.Getenv("WORKSPACEKIT_BOBPROXY_PREVIOUSCACHEREF"), "ref of the build cache of the previous period, which is only imported from")
+	proxyCmd.Flags().BoolVar(&proxyOpts.CacheReadOnly, "cache-read-only", os.Getenv("WORKSPACEKIT_BOBPROXY_CACHEREADONLY") == "true", "do not allow writes to the build cache")
 	proxyCmd.Flags().StringVar(&proxyOpts.Auth, "auth", os.Getenv("WORKSPACEKIT_BOBPROXY_AUTH"), "authentication to use")
 	proxyCmd.Flags().StringVar(&proxyOpts.AdditionalAuth, "additional-auth", os.Getenv("WORKSPACEKIT_BOBPROXY_ADDITIONALAUTH"), "additional authentication to use")
 }
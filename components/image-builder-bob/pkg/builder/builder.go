@@ -8,11 +8,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
@@ -20,8 +21,6 @@ import (
 
 	"github.com/docker/cli/cli/config/configfile"
 	"github.com/docker/cli/cli/config/types"
-	"github.com/google/go-containerregistry/pkg/crane"
-	"github.com/google/go-containerregistry/pkg/logs"
 	"github.com/moby/buildkit/client"
 	"golang.org/x/xerrors"
 )
@@ -83,18 +82,38 @@ func (b *Builder) buildBaseLayer(ctx context.Context, cl *client.Client) error {
 	}
 
 	log.Info("building base image")
-	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef)
+	err := checkEmulation(b.Config.Platforms)
+	if err != nil {
+		return err
+	}
+	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef, buildCache{Export: b.Config.CacheRef, Import: b.Config.CacheImportRefs}, b.Config.Platforms, b.Config.SBOMGenerator)
 }
 
 func (b *Builder) buildWorkspaceImage(ctx context.Context) (err error) {
 	log.Info("building workspace image")
 
-	logs.Progress.SetOutput(os.Stderr)
+	if len(b.Config.Features) > 0 {
+		return b.buildFeatureLayers(ctx)
+	}
+
+	// Rather than copying the base image we build the workspace image from it, so that buildkit attaches
+	// an SBOM to the workspace image even if the base image carries none.
+	contextDir, err := os.MkdirTemp("", "workspace")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(contextDir)
+
+	dockerfile := filepath.Join(contextDir, "Dockerfile")
+	err = os.WriteFile(dockerfile, []byte("FROM "+b.Config.BaseRef+"\n"), 0644)
+	if err != nil {
+		return err
+	}
 
-	return crane.Copy(b.Config.BaseRef, b.Config.TargetRef, crane.Insecure, crane.WithJobs(runtime.GOMAXPROCS(0)))
+	return buildImage(ctx, contextDir, dockerfile, b.Config.WorkspaceLayerAuth, b.Config.TargetRef, buildCache{}, b.Config.Platforms, b.Config.SBOMGenerator)
 }
 
-func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string) (err error) {
+func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string, cache buildCache, platforms []string, sbomGenerator string) (err error) {
 	log.Info("waiting for build context")
 	waitctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
@@ -136,18 +155,26 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		"build",
 		"--progress=plain",
 		"--output=type=image,name=" + target + ",push=true,oci-mediatypes=true",
-		//"--export-cache=type=inline",
 		"--local=context=" + contextdir,
-		//"--export-cache=type=registry,ref=" + target + "-cache",
-		//"--import-cache=type=registry,ref=" + target + "-cache",
 		"--frontend=dockerfile.v0",
 		"--local=dockerfile=" + filepath.Dir(dockerfile),
 		"--opt=filename=" + filepath.Base(dockerfile),
+		// buildkit attaches an SPDX SBOM to the image as attestation
+		"--opt=attest:sbom=" + sbomOpt(sbomGenerator),
+		// the provenance attestation records the base image digest and build time which image-builder-mk3
+		// uses to find stale workspace images
+		"--opt=attest:provenance=mode=min",
 	}
+	if len(platforms) > 0 {
+		// buildkit pushes an image index with one manifest per platform
+		buildctlArgs = append(buildctlArgs, "--opt=platform="+strings.Join(platforms, ","))
+	}
+	buildctlArgs = append(buildctlArgs, cache.buildctlArgs()...)
 
 	buildctlCmd := exec.Command("buildctl", buildctlArgs...)
 
-	buildctlCmd.Stderr = os.Stderr
+	stats := newCacheStats()
+	buildctlCmd.Stderr = io.MultiWriter(os.Stderr, stats)
 	buildctlCmd.Stdout = os.Stdout
 
 	env := os.Environ()
@@ -165,9 +192,21 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		return err
 	}
 
+	if cache.enabled() {
+		// image-builder picks this line up from the build log
+		fmt.Println(stats.String())
+	}
+
 	return nil
 }
 
+func sbomOpt(generator string) string {
+	if generator == "" {
+		return ""
+	}
+	return "generator=" + generator
+}
+
 func waitForBuildContext(ctx context.Context) error {
 	done := make(chan struct{})
 
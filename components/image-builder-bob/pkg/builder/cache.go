@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+var (
+	// matches the header of a Dockerfile instruction in buildctl's plain progress output, e.g.
+	// "#5 [2/3] RUN apt-get update", "#7 [builder 2/4] RUN go build" or "#9 [linux/arm64 builder 2/4] RUN go build"
+	stepHeader = regexp.MustCompile(`^#(\d+) \[(?:\S+ )*\d+/\d+\] (\S+)`)
+	// matches the line buildctl prints when a vertex was found in the cache, e.g. "#5 CACHED"
+	stepCached = regexp.MustCompile(`^#(\d+) CACHED\s*$`)
+)
+
+// buildCache is the registry build cache of a build
+type buildCache struct {
+	// Export is the cache the build imports from and exports to. Builds of untrusted sources don't have one.
+	Export string
+	// Import are further caches the build only imports from
+	Import []string
+}
+
+func (c buildCache) enabled() bool {
+	return c.Export != "" || len(c.Import) > 0
+}
+
+// buildctlArgs returns the buildctl arguments which import and export the cache.
+// A missing cache is not an error on import, and failing to export the cache must not fail the build.
+func (c buildCache) buildctlArgs() []string {
+	var args []string
+	if c.Export != "" {
+		args = append(args, "--import-cache=type=registry,ref="+c.Export)
+	}
+	for _, ref := range c.Import {
+		args = append(args, "--import-cache=type=registry,ref="+ref)
+	}
+	if c.Export != "" {
+		args = append(args, "--export-cache=type=registry,ref="+c.Export+",mode=max,oci-mediatypes=true,ignore-error=true")
+	}
+	return args
+}
+
+// cacheStats counts the Dockerfile instructions of a build and how many of them buildkit found in its cache.
+// It consumes the plain progress output of buildctl.
+type cacheStats struct {
+	mu     sync.Mutex
+	steps  map[string]struct{}
+	cached map[string]struct{}
+	line   []byte
+}
+
+func newCacheStats() *cacheStats {
+	return &cacheStats{
+		steps:  make(map[string]struct{}),
+		cached: make(map[string]struct{}),
+	}
+}
+
+// Write implements io.Writer
+func (c *cacheStats) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.line = append(c.line, p...)
+	for {
+		i := bytes.IndexByte(c.line, '\n')
+		if i < 0 {
+			break
+		}
+		c.handleLine(string(bytes.TrimSuffix(c.line[:i], []byte("\r"))))
+		c.line = c.line[i+1:]
+	}
+	return len(p), nil
+}
+
+func (c *cacheStats) handleLine(line string) {
+	if m := stepHeader.FindStringSubmatch(line); m != nil {
+		// pulling the base image is not a step we could cache
+		if m[2] != "FROM" {
+			c.steps[m[1]] = struct{}{}
+		}
+		return
+	}
+	if m := stepCached.FindStringSubmatch(line); m != nil {
+		if _, ok := c.steps[m[1]]; ok {
+			c.cached[m[1]] = struct{}{}
+		}
+	}
+}
+
+// String produces the summary line image-builder parses from the build log
+func (c *cacheStats) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return fmt.Sprintf("build cache: %d of %d steps cached", len(c.cached), len(c.steps))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCacheStats(t *testing.T) {
+	const output = `#1 [internal] load build definition from Dockerfile
+#1 transferring dockerfile: 120B done
+#1 DONE 0.0s
+
+#4 [builder 1/3] FROM docker.io/library/golang:1.22@sha256:abc
+#4 CACHED
+
+#5 [builder 2/3] COPY . /src
+#5 CACHED
+
+#6 [builder 3/3] RUN go build ./...
+#6 CACHED
+
+#7 [stage-1 2/3] RUN apt-get update
+#7 0.512 Get:1 http://deb.debian.org/debian bookworm InRelease [151 kB]
+#7 DONE 3.2s
+
+#5 [builder 2/3] COPY . /src
+#8 [stage-1 3/3] COPY --from=builder /src/app /app
+#8 DONE 0.1s
+
+#10 [linux/arm64 stage-1 2/3] RUN apt-get update
+#10 CACHED
+
+#9 exporting to image
+#9 DONE 1.3s
+`
+
+	stats := newCacheStats()
+	// write in small chunks to make sure lines are reassembled
+	_, err := io.Copy(stats, &chunkedReader{R: strings.NewReader(strings.ReplaceAll(output, "\n", "\r\n")), N: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expectation = "build cache: 3 of 5 steps cached"
+	if act := stats.String(); act != expectation {
+		t.Errorf("unexpected summary: expected %q, got %q", expectation, act)
+	}
+}
+
+type chunkedReader struct {
+	R io.Reader
+	N int
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+	if len(p) > r.N {
+		p = p[:r.N]
+	}
+	return r.R.Read(p)
+}
+
+func TestBuildCacheArgs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Cache    buildCache
+		Expected []string
+	}{
+		{
+			Name: "no cache",
+		},
+		{
+			Name:  "import and export",
+			Cache: buildCache{Export: "localhost:8080/cache:latest", Import: []string{"localhost:8080/cache-previous:latest"}},
+			Expected: []string{
+				"--import-cache=type=registry,ref=localhost:8080/cache:latest",
+				"--import-cache=type=registry,ref=localhost:8080/cache-previous:latest",
+				"--export-cache=type=registry,ref=localhost:8080/cache:latest,mode=max,oci-mediatypes=true,ignore-error=true",
+			},
+		},
+		{
+			Name:  "import only",
+			Cache: buildCache{Import: []string{"localhost:8080/cache:latest", "localhost:8080/cache-previous:latest"}},
+			Expected: []string{
+				"--import-cache=type=registry,ref=localhost:8080/cache:latest",
+				"--import-cache=type=registry,ref=localhost:8080/cache-previous:latest",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if diff := cmp.Diff(test.Expected, test.Cache.buildctlArgs()); diff != "" {
+				t.Errorf("unexpected buildctl args (-want +got):\n%s", diff)
+			}
+			if enabled := test.Cache.enabled(); enabled != (len(test.Expected) > 0) {
+				t.Errorf("unexpected enabled: %v", enabled)
+			}
+		})
+	}
+}
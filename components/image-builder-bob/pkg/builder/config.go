@@ -8,6 +8,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +27,11 @@ type Config struct {
 	Dockerfile         string
 	ContextDir         string
 	ExternalBuildkitd  string
+	Features           []Feature
+	CacheRef           string
+	CacheImportRefs    []string
+	Platforms          []string
+	SBOMGenerator      string
 	localCacheImport   string
 }
 
@@ -43,6 +49,8 @@ func GetConfigFromEnv() (*Config, error) {
 		Dockerfile:         os.Getenv("BOB_DOCKERFILE_PATH"),
 		ContextDir:         os.Getenv("BOB_CONTEXT_DIR"),
 		ExternalBuildkitd:  os.Getenv("BOB_EXTERNAL_BUILDKITD"),
+		CacheRef:           os.Getenv("BOB_CACHE_REF"),
+		SBOMGenerator:      os.Getenv("BOB_SBOM_GENERATOR"),
 		localCacheImport:   os.Getenv("BOB_LOCAL_CACHE_IMPORT"),
 	}
 
@@ -52,6 +60,18 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.TargetRef == "" {
 		cfg.TargetRef = "localhost:8080/target:latest"
 	}
+	if refs := os.Getenv("BOB_CACHE_IMPORT_REFS"); refs != "" {
+		cfg.CacheImportRefs = strings.Split(refs, ",")
+	}
+	if ps := os.Getenv("BOB_PLATFORMS"); ps != "" {
+		cfg.Platforms = strings.Split(ps, ",")
+	}
+	if fs := os.Getenv("BOB_FEATURES"); fs != "" {
+		err := json.Unmarshal([]byte(fs), &cfg.Features)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse BOB_FEATURES: %w", err)
+		}
+	}
 	if cfg.BuildBase {
 		if cfg.Dockerfile == "" {
 			return nil, xerrors.Errorf("When building the base image BOB_DOCKERFILE_PATH is mandatory")
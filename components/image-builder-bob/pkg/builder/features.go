@@ -0,0 +1,353 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"golang.org/x/xerrors"
+)
+
+const featuresDir = "/tmp/gitpod-features"
+
+// Feature is a dev container feature (https://containers.dev/implementors/features/) installed on top of the image
+type Feature struct {
+	Ref     string            `json:"ref"`
+	Options map[string]string `json:"options,omitempty"`
+}
+
+func (b *Builder) buildFeatureLayers(ctx context.Context) error {
+	log.WithField("features", len(b.Config.Features)).Info("installing features")
+
+	contextDir, err := os.MkdirTemp("", "features")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(contextDir)
+
+	// features run their install scripts for every platform we build for
+	err = checkEmulation(b.Config.Platforms)
+	if err != nil {
+		return err
+	}
+
+	features, err := fetchFeatures(contextDir, b.Config.Features)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := crane.Config(b.Config.BaseRef, crane.Insecure)
+	if err != nil {
+		return xerrors.Errorf("cannot get base image config: %w", err)
+	}
+	var baseCfg struct {
+		Config struct {
+			User string `json:"User"`
+		} `json:"config"`
+	}
+	err = json.Unmarshal(cfg, &baseCfg)
+	if err != nil {
+		return xerrors.Errorf("cannot parse base image config: %w", err)
+	}
+
+	dockerfile := filepath.Join(contextDir, "Dockerfile")
+	err = os.WriteFile(dockerfile, []byte(featuresDockerfile(b.Config.BaseRef, baseCfg.Config.User, features)), 0644)
+	if err != nil {
+		return err
+	}
+	for i, f := range features {
+		err = os.WriteFile(filepath.Join(contextDir, fmt.Sprint(i), "gitpod-feature.env"), []byte(featureEnv(f, baseCfg.Config.User)), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return buildImage(ctx, contextDir, dockerfile, b.Config.WorkspaceLayerAuth, b.Config.TargetRef, buildCache{}, b.Config.Platforms, b.Config.SBOMGenerator)
+}
+
+// featuresDockerfile produces a Dockerfile which installs each feature in its own layer on top of the base image.
+// The features are bind mounted rather than copied, so that they don't end up in the image.
+func featuresDockerfile(baseRef, user string, features []Feature) string {
+	var res strings.Builder
+	fmt.Fprintf(&res, "FROM %s\nUSER root\n", baseRef)
+	for i, f := range features {
+		dir := fmt.Sprintf("%s/%d", featuresDir, i)
+		fmt.Fprintf(&res, "# %s\n", f.Ref)
+		fmt.Fprintf(&res, "RUN --mount=type=bind,source=%d,target=%s,rw cd %s && set -a && . ./gitpod-feature.env && set +a && chmod +x install.sh && ./install.sh\n", i, dir, dir)
+	}
+	if user != "" && user != "root" {
+		fmt.Fprintf(&res, "USER %s\n", user)
+	}
+	return res.String()
+}
+
+var invalidOptionChars = regexp.MustCompile(`\W`)
+
+// featureEnv produces the environment the install script of a feature runs with. Following the spec the options
+// are passed as upper case environment variables.
+func featureEnv(f Feature, user string) string {
+	if user == "" {
+		user = "root"
+	}
+	env := map[string]string{
+		"_REMOTE_USER":    user,
+		"_CONTAINER_USER": user,
+	}
+	for k, v := range f.Options {
+		env[strings.ToUpper(invalidOptionChars.ReplaceAllString(k, "_"))] = v
+	}
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var res strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&res, "%s='%s'\n", k, strings.ReplaceAll(env[k], "'", `'\''`))
+	}
+	return res.String()
+}
+
+// maxFeatures limits the number of features installed into an image, including the ones features depend on
+const maxFeatures = 64
+
+// featureMetadata is the part of a feature's devcontainer-feature.json which determines the order features are installed in
+type featureMetadata struct {
+	InstallsAfter []string                          `json:"installsAfter,omitempty"`
+	DependsOn     map[string]map[string]interface{} `json:"dependsOn,omitempty"`
+}
+
+// fetchFeatures downloads the features and the features they depend on, and returns them in the order
+// they need to be installed in. The i-th feature of the result is extracted to contextDir/i.
+func fetchFeatures(contextDir string, features []Feature) ([]Feature, error) {
+	var (
+		fetched  []Feature
+		metadata []featureMetadata
+		known    = make(map[string]struct{})
+		queue    = append([]Feature(nil), features...)
+	)
+	for len(queue) > 0 {
+		f := queue[0]
+		queue = queue[1:]
+		if _, exists := known[featureID(f.Ref)]; exists {
+			continue
+		}
+		known[featureID(f.Ref)] = struct{}{}
+		if len(fetched) == maxFeatures {
+			return nil, xerrors.Errorf("cannot install more than %d features", maxFeatures)
+		}
+
+		dir := filepath.Join(contextDir, fmt.Sprintf("fetch-%d", len(fetched)))
+		err := fetchFeature(f.Ref, dir)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot fetch feature %s: %w", f.Ref, err)
+		}
+		md, err := readFeatureMetadata(dir)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read metadata of feature %s: %w", f.Ref, err)
+		}
+		fetched = append(fetched, f)
+		metadata = append(metadata, md)
+
+		// features a feature depends on get installed even if they're not configured
+		deps := make([]string, 0, len(md.DependsOn))
+		for ref := range md.DependsOn {
+			deps = append(deps, ref)
+		}
+		sort.Strings(deps)
+		for _, ref := range deps {
+			options := make(map[string]string, len(md.DependsOn[ref]))
+			for k, v := range md.DependsOn[ref] {
+				options[k] = fmt.Sprint(v)
+			}
+			queue = append(queue, Feature{Ref: ref, Options: options})
+		}
+	}
+
+	order, err := installOrder(fetched, metadata)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Feature, 0, len(order))
+	for i, idx := range order {
+		err = os.Rename(filepath.Join(contextDir, fmt.Sprintf("fetch-%d", idx)), filepath.Join(contextDir, fmt.Sprint(i)))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, fetched[idx])
+	}
+	return res, nil
+}
+
+// installOrder sorts the features such that each one is installed after the features it depends on or
+// asks to be installed after. Apart from that the features keep their order.
+func installOrder(features []Feature, metadata []featureMetadata) ([]int, error) {
+	index := make(map[string]int, len(features))
+	for i, f := range features {
+		index[featureID(f.Ref)] = i
+	}
+	after := make([][]int, len(features))
+	for i, md := range metadata {
+		refs := append([]string(nil), md.InstallsAfter...)
+		for ref := range md.DependsOn {
+			refs = append(refs, ref)
+		}
+		for _, ref := range refs {
+			// installsAfter only affects the order of features which are installed anyway
+			if j, ok := index[featureID(ref)]; ok && j != i {
+				after[i] = append(after[i], j)
+			}
+		}
+	}
+
+	var (
+		res       = make([]int, 0, len(features))
+		installed = make([]bool, len(features))
+	)
+	for len(res) < len(features) {
+		next := -1
+		for i := range features {
+			if installed[i] {
+				continue
+			}
+			ready := true
+			for _, j := range after[i] {
+				if !installed[j] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return nil, xerrors.Errorf("features have circular dependencies")
+		}
+		installed[next] = true
+		res = append(res, next)
+	}
+	return res, nil
+}
+
+// featureID returns the reference of a feature without its version, which is how features refer to each other
+func featureID(ref string) string {
+	if i := strings.Index(ref, "@"); i != -1 {
+		ref = ref[:i]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref = ref[:i]
+	}
+	return ref
+}
+
+func readFeatureMetadata(dir string) (res featureMetadata, err error) {
+	data, err := os.ReadFile(filepath.Join(dir, "devcontainer-feature.json"))
+	if os.IsNotExist(err) {
+		return res, nil
+	}
+	if err != nil {
+		return res, err
+	}
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+// fetchFeature downloads the feature artifact and extracts it to dst
+func fetchFeature(ref, dst string) error {
+	img, err := crane.Pull(ref)
+	if err != nil {
+		return err
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+	if len(layers) != 1 {
+		return xerrors.Errorf("expected a single layer, found %d", len(layers))
+	}
+	rc, err := layers[0].Compressed()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// feature layers are plain tar archives, but we accept gzipped ones too
+	var r io.Reader = bufio.NewReader(rc)
+	if magic, err := r.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return extractTar(r, dst)
+}
+
+func extractTar(r io.Reader, dst string) error {
+	err := os.MkdirAll(dst, 0755)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		fn := filepath.Join(dst, hdr.Name)
+		if !strings.HasPrefix(fn, filepath.Clean(dst)+string(os.PathSeparator)) {
+			// the entry is dst itself or would escape it
+			continue
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(fn, 0755)
+		case tar.TypeReg:
+			err = writeFile(fn, tr, os.FileMode(hdr.Mode).Perm())
+		default:
+			log.WithField("name", hdr.Name).Debug("ignoring unsupported feature file")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeFile(fn string, r io.Reader, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(fn, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFeaturesDockerfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		features []Feature
+		expected string
+	}{
+		{
+			name:     "gitpod user",
+			user:     "gitpod",
+			features: []Feature{{Ref: "ghcr.io/devcontainers/features/go:1"}, {Ref: "ghcr.io/devcontainers/features/node:1"}},
+			expected: `FROM localhost:8080/base:latest
+USER root
+# ghcr.io/devcontainers/features/go:1
+RUN --mount=type=bind,source=0,target=/tmp/gitpod-features/0,rw cd /tmp/gitpod-features/0 && set -a && . ./gitpod-feature.env && set +a && chmod +x install.sh && ./install.sh
+# ghcr.io/devcontainers/features/node:1
+RUN --mount=type=bind,source=1,target=/tmp/gitpod-features/1,rw cd /tmp/gitpod-features/1 && set -a && . ./gitpod-feature.env && set +a && chmod +x install.sh && ./install.sh
+USER gitpod
+`,
+		},
+		{
+			name:     "root user",
+			features: []Feature{{Ref: "ghcr.io/devcontainers/features/go:1"}},
+			expected: `FROM localhost:8080/base:latest
+USER root
+# ghcr.io/devcontainers/features/go:1
+RUN --mount=type=bind,source=0,target=/tmp/gitpod-features/0,rw cd /tmp/gitpod-features/0 && set -a && . ./gitpod-feature.env && set +a && chmod +x install.sh && ./install.sh
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			act := featuresDockerfile("localhost:8080/base:latest", test.user, test.features)
+			if diff := cmp.Diff(test.expected, act); diff != "" {
+				t.Errorf("unexpected Dockerfile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstallOrder(t *testing.T) {
+	features := func(refs ...string) []Feature {
+		res := make([]Feature, 0, len(refs))
+		for _, ref := range refs {
+			res = append(res, Feature{Ref: ref})
+		}
+		return res
+	}
+	tests := []struct {
+		name     string
+		features []Feature
+		metadata []featureMetadata
+		expected []int
+		err      string
+	}{
+		{
+			name:     "no constraints",
+			features: features("ghcr.io/devcontainers/features/go:1", "ghcr.io/devcontainers/features/node:1"),
+			metadata: []featureMetadata{{}, {}},
+			expected: []int{0, 1},
+		},
+		{
+			name:     "installs after",
+			features: features("ghcr.io/devcontainers/features/go:1", "ghcr.io/devcontainers/features/common-utils:2", "ghcr.io/devcontainers/features/node:1"),
+			metadata: []featureMetadata{
+				{InstallsAfter: []string{"ghcr.io/devcontainers/features/common-utils"}},
+				{},
+				{InstallsAfter: []string{"ghcr.io/devcontainers/features/common-utils", "ghcr.io/devcontainers/features/python"}},
+			},
+			expected: []int{1, 0, 2},
+		},
+		{
+			name:     "depends on",
+			features: features("ghcr.io/devcontainers/features/a:1", "ghcr.io/devcontainers/features/b:1", "ghcr.io/devcontainers/features/c:1"),
+			metadata: []featureMetadata{
+				{DependsOn: map[string]map[string]interface{}{"ghcr.io/devcontainers/features/c:1": {}}},
+				{},
+				{DependsOn: map[string]map[string]interface{}{"ghcr.io/devcontainers/features/b:1": {}}},
+			},
+			expected: []int{1, 2, 0},
+		},
+		{
+			name:     "circular",
+			features: features("ghcr.io/devcontainers/features/a:1", "ghcr.io/devcontainers/features/b:1"),
+			metadata: []featureMetadata{
+				{InstallsAfter: []string{"ghcr.io/devcontainers/features/b"}},
+				{InstallsAfter: []string{"ghcr.io/devcontainers/features/a"}},
+			},
+			err: "features have circular dependencies",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			act, err := installOrder(test.features, test.metadata)
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != test.err {
+				t.Fatalf("unexpected error: want %q, got %q", test.err, errMsg)
+			}
+			if diff := cmp.Diff(test.expected, act); diff != "" {
+				t.Errorf("unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeatureID(t *testing.T) {
+	tests := map[string]string{
+		"ghcr.io/devcontainers/features/go:1":           "ghcr.io/devcontainers/features/go",
+		"ghcr.io/devcontainers/features/go":             "ghcr.io/devcontainers/features/go",
+		"ghcr.io/devcontainers/features/go@sha256:1234": "ghcr.io/devcontainers/features/go",
+		"localhost:5000/features/go:1":                  "localhost:5000/features/go",
+		"localhost:5000/features/go":                    "localhost:5000/features/go",
+	}
+	for ref, expected := range tests {
+		if act := featureID(ref); act != expected {
+			t.Errorf("unexpected feature id of %s: want %s, got %s", ref, expected, act)
+		}
+	}
+}
+
+func TestFeatureEnv(t *testing.T) {
+	act := featureEnv(Feature{
+		Ref:     "ghcr.io/devcontainers/features/node:1",
+		Options: map[string]string{"version": "20", "node-gyp": "it's true"},
+	}, "gitpod")
+	expected := `NODE_GYP='it'\''s true'
+VERSION='20'
+_CONTAINER_USER='gitpod'
+_REMOTE_USER='gitpod'
+`
+	if diff := cmp.Diff(expected, act); diff != "" {
+		t.Errorf("unexpected env (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// qemuArchs maps Go architectures to the names QEMU's user mode emulators go by
+var qemuArchs = map[string]string{
+	"386":      "i386",
+	"amd64":    "x86_64",
+	"arm":      "arm",
+	"arm64":    "aarch64",
+	"loong64":  "loongarch64",
+	"mips64":   "mips64",
+	"mips64le": "mips64el",
+	"ppc64le":  "ppc64le",
+	"riscv64":  "riscv64",
+	"s390x":    "s390x",
+}
+
+// checkEmulation makes sure buildkit can run the build steps for all platforms. Platforms other than the
+// one of this node need an emulator, which is either registered with binfmt_misc on the node or one of
+// the buildkit-qemu-* emulators which ship with the builder image.
+func checkEmulation(platforms []string) error {
+	missing, err := platformsWithoutEmulation(platforms, runtime.GOARCH, hasEmulator)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return xerrors.Errorf("cannot build for %s: no emulation available on this %s node", strings.Join(missing, ", "), runtime.GOARCH)
+	}
+	return nil
+}
+
+// platformsWithoutEmulation returns the platforms which can neither run natively nor using an emulator
+func platformsWithoutEmulation(platforms []string, nativeArch string, hasEmulator func(qemuArch string) bool) ([]string, error) {
+	var res []string
+	for _, p := range platforms {
+		segs := strings.Split(p, "/")
+		if len(segs) < 2 {
+			return nil, xerrors.Errorf("invalid platform %s", p)
+		}
+		arch := segs[1]
+		if arch == nativeArch {
+			continue
+		}
+		qemuArch, ok := qemuArchs[arch]
+		if !ok || !hasEmulator(qemuArch) {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func hasEmulator(qemuArch string) bool {
+	if _, err := os.Stat(filepath.Join("/proc/sys/fs/binfmt_misc", "qemu-"+qemuArch)); err == nil {
+		return true
+	}
+	_, err := exec.LookPath("buildkit-qemu-" + qemuArch)
+	return err == nil
+}
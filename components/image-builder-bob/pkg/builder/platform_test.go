@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlatformsWithoutEmulation(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Platforms []string
+		Emulators []string
+		Expected  []string
+		Error     bool
+	}{
+		{
+			Name:      "native",
+			Platforms: []string{"linux/amd64"},
+		},
+		{
+			Name:      "emulated",
+			Platforms: []string{"linux/amd64", "linux/arm64/v8"},
+			Emulators: []string{"aarch64"},
+		},
+		{
+			Name:      "no emulator",
+			Platforms: []string{"linux/amd64", "linux/arm64", "linux/riscv64"},
+			Emulators: []string{"riscv64"},
+			Expected:  []string{"linux/arm64"},
+		},
+		{
+			Name:      "unknown architecture",
+			Platforms: []string{"linux/sparc"},
+			Expected:  []string{"linux/sparc"},
+		},
+		{
+			Name:      "invalid platform",
+			Platforms: []string{"arm64"},
+			Error:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			emulators := make(map[string]bool, len(test.Emulators))
+			for _, e := range test.Emulators {
+				emulators[e] = true
+			}
+			act, err := platformsWithoutEmulation(test.Platforms, "amd64", func(arch string) bool { return emulators[arch] })
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expected, act); diff != "" {
+				t.Errorf("unexpected platforms (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -53,6 +53,8 @@ type Repo struct {
 	Repo string
 	Tag  string
 	Auth func() docker.Authorizer
+	// ReadOnly repos can be pulled from but not pushed to
+	ReadOnly bool
 }
 
 func rewriteDockerAPIURL(u *url.URL, fromRepo, toRepo, host, tag string) {
@@ -157,6 +159,11 @@ func (proxy *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		return
 	}
+	if repo.ReadOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		log.WithField("alias", alias).WithField("method", r.Method).Warn("refusing to write to read-only repo")
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
 
 	r.Host = r.URL.Host
 
@@ -5,6 +5,8 @@
 package proxy
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -219,3 +221,32 @@ func TestRewriteDockerAPIURL(t *testing.T) {
 	}
 
 }
+
+func TestReadOnlyRepo(t *testing.T) {
+	prx, err := NewProxy(&url.URL{Host: "localhost:8080", Scheme: "http"}, map[string]Repo{
+		"cache": {Host: "registry.example.com", Repo: "workspace-images", Tag: "cache-1234", ReadOnly: true},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Method string
+		Path   string
+	}{
+		{Method: http.MethodPut, Path: "/v2/cache/manifests/latest"},
+		{Method: http.MethodPost, Path: "/v2/cache/blobs/uploads/"},
+		{Method: http.MethodPatch, Path: "/v2/cache/blobs/uploads/1234"},
+		{Method: http.MethodDelete, Path: "/v2/cache/manifests/latest"},
+		{Method: http.MethodPut, Path: "/cache/artifacts-uploads/1234"},
+	}
+	for _, test := range tests {
+		t.Run(test.Method+" "+test.Path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			prx.ServeHTTP(rec, httptest.NewRequest(test.Method, test.Path, nil))
+			if rec.Code != http.StatusForbidden {
+				t.Errorf("expected status %d but got %d", http.StatusForbidden, rec.Code)
+			}
+		})
+	}
+}
@@ -74,6 +74,9 @@ var runCmd = &cobra.Command{
 		if err != nil {
 			log.WithError(err).Fatal("Failed to start server")
 		}
+
+		cancel()
+		service.Stop()
 	},
 }
 
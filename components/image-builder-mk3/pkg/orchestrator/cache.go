@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// buildCachePeriod is how long builds export to the same build cache tag. Builds import the caches of the
+	// current and the previous period, hence the cache of a scope which wasn't built for a whole period is collected.
+	buildCachePeriod = 7 * 24 * time.Hour
+
+	// buildCacheCollectInterval is the time between two runs of the build cache collector
+	buildCacheCollectInterval = 24 * time.Hour
+
+	// maxTagsPageSize is the number of tags we ask the registry for at once
+	maxTagsPageSize = 1000
+
+	// maxTagListSize limits how much we read when listing a page of tags
+	maxTagListSize = 1 << 20
+)
+
+// matches build cache tags, e.g. cache-<sha256 of the scope>-<period>
+var buildCacheTagPattern = regexp.MustCompile(`^cache-([0-9a-f]{64})-(\d+)$`)
+
+// buildCacheTag returns the tag of the build cache of a scope during the period t falls into
+func buildCacheTag(scope string, t time.Time) string {
+	return fmt.Sprintf("cache-%x-%d", sha256.Sum256([]byte(scope)), buildCachePeriodOf(t))
+}
+
+func buildCachePeriodOf(t time.Time) int64 {
+	return t.Unix() / int64(buildCachePeriod/time.Second)
+}
+
+// expiredBuildCacheTags returns the build cache tags no build imports anymore. For each of them it also returns
+// the tags of the same scope which are still in use.
+func expiredBuildCacheTags(tags []string, now time.Time) map[string][]string {
+	current := buildCachePeriodOf(now)
+
+	inUse := make(map[string][]string)
+	var expired []string
+	for _, tag := range tags {
+		m := buildCacheTagPattern.FindStringSubmatch(tag)
+		if m == nil {
+			continue
+		}
+		period, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		if period < current-1 {
+			expired = append(expired, tag)
+		} else {
+			inUse[m[1]] = append(inUse[m[1]], tag)
+		}
+	}
+
+	res := make(map[string][]string, len(expired))
+	for _, tag := range expired {
+		scope := buildCacheTagPattern.FindStringSubmatch(tag)[1]
+		res[tag] = inUse[scope]
+	}
+	return res
+}
+
+// buildCacheCollector deletes the build caches no build imports anymore from the workspace image repository
+type buildCacheCollector struct {
+	Repository *imageRepository
+	Interval   time.Duration
+}
+
+func newBuildCacheCollector(repository *imageRepository) *buildCacheCollector {
+	return &buildCacheCollector{
+		Repository: repository,
+		Interval:   buildCacheCollectInterval,
+	}
+}
+
+// Run collects build caches at the configured interval. This function blocks until the context is canceled.
+func (c *buildCacheCollector) Run(ctx context.Context) {
+	log.WithField("interval", c.Interval.String()).Info("starting build cache collection")
+
+	t := time.NewTicker(c.Interval)
+	defer t.Stop()
+	for {
+		c.Collect(ctx)
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			log.Debug("context cancelled - shutting down build cache collection")
+			return
+		}
+	}
+}
+
+// Collect deletes all expired build caches. Failures are logged only, as the next run tries again.
+func (c *buildCacheCollector) Collect(ctx context.Context) {
+	tags, err := c.Repository.listTags(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.WithError(err).Warn("cannot list build caches")
+		}
+		return
+	}
+
+	var deleted int
+	for tag, inUse := range expiredBuildCacheTags(tags, time.Now()) {
+		ok, err := c.deleteTag(ctx, tag, inUse)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.WithError(err).WithField("tag", tag).Warn("cannot delete build cache")
+			continue
+		}
+		if ok {
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		log.WithField("deleted", deleted).Info("deleted expired build caches")
+	}
+}
+
+// deleteTag deletes the manifest a tag points to, unless one of the tags in use points to the same manifest.
+// It returns true if the manifest was deleted.
+func (c *buildCacheCollector) deleteTag(ctx context.Context, tag string, inUse []string) (bool, error) {
+	dgst, err := c.Repository.manifestDigest(ctx, tag)
+	if err != nil {
+		return false, err
+	}
+	if dgst == "" {
+		// the tag is gone already
+		return false, nil
+	}
+	for _, t := range inUse {
+		d, err := c.Repository.manifestDigest(ctx, t)
+		if err != nil {
+			return false, err
+		}
+		if d == dgst {
+			log.WithField("tag", tag).WithField("inUse", t).Debug("build cache is still in use by another tag")
+			return false, nil
+		}
+	}
+
+	return c.Repository.deleteManifest(ctx, dgst)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpiredBuildCacheTags(t *testing.T) {
+	now := time.Now()
+	var (
+		current  = buildCacheTag("project-a", now)
+		previous = buildCacheTag("project-a", now.Add(-buildCachePeriod))
+		expired  = buildCacheTag("project-a", now.Add(-2*buildCachePeriod))
+		other    = buildCacheTag("project-b", now.Add(-3*buildCachePeriod))
+	)
+
+	act := expiredBuildCacheTags([]string{
+		current,
+		previous,
+		expired,
+		other,
+		"cache-1234",
+		"3f8a0c2d9e1b4a5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4",
+	}, now)
+	exp := map[string][]string{
+		expired: {current, previous},
+		other:   nil,
+	}
+	if diff := cmp.Diff(exp, act); diff != "" {
+		t.Errorf("unexpected expired tags (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuildCacheRefs(t *testing.T) {
+	o := &Orchestrator{}
+	o.Config.WorkspaceImageRepository = "registry.example.com/workspace-images"
+
+	now := time.Now()
+	current, previous := o.getBuildCacheRefs("project-a", now)
+	if current == previous {
+		t.Fatalf("current and previous cache refs must differ, both are %s", current)
+	}
+	if next, _ := o.getBuildCacheRefs("project-a", now.Add(buildCachePeriod)); next == current {
+		t.Errorf("cache ref did not change after a period: %s", next)
+	}
+	if _, prev := o.getBuildCacheRefs("project-a", now.Add(buildCachePeriod)); prev != current {
+		t.Errorf("previous cache ref of the next period is %s, expected %s", prev, current)
+	}
+	if other, _ := o.getBuildCacheRefs("project-b", now); other == current {
+		t.Errorf("cache refs of different scopes are the same: %s", other)
+	}
+}
+
+func TestBuildCacheCollector(t *testing.T) {
+	now := time.Now()
+	var (
+		current  = buildCacheTag("project-a", now)
+		expired  = buildCacheTag("project-a", now.Add(-2*buildCachePeriod))
+		shared   = buildCacheTag("project-b", now.Add(-2*buildCachePeriod))
+		reused   = buildCacheTag("project-b", now)
+		workload = "3f8a0c2d9e1b4a5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4"
+	)
+	reg := &fakeRegistry{
+		Tags: map[string]string{
+			current:  "sha256:current",
+			expired:  "sha256:expired",
+			shared:   "sha256:shared",
+			reused:   "sha256:shared",
+			workload: "sha256:workload",
+		},
+	}
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &buildCacheCollector{Repository: testImageRepository(srv, u.Host+"/workspace-images")}
+	c.Collect(context.Background())
+
+	var remaining []string
+	for tag := range reg.Tags {
+		remaining = append(remaining, tag)
+	}
+	sort.Strings(remaining)
+	exp := []string{current, shared, reused, workload}
+	sort.Strings(exp)
+	if diff := cmp.Diff(exp, remaining); diff != "" {
+		t.Errorf("unexpected remaining tags (-want +got):\n%s", diff)
+	}
+}
+
+func testImageRepository(srv *httptest.Server, name string) *imageRepository {
+	return &imageRepository{
+		Name: name,
+		Hosts: func(host string) ([]dockerremote.RegistryHost, error) {
+			return []dockerremote.RegistryHost{{
+				Client:       srv.Client(),
+				Authorizer:   dockerremote.NewDockerAuthorizer(),
+				Host:         host,
+				Scheme:       "http",
+				Path:         "/v2",
+				Capabilities: dockerremote.HostCapabilityPull | dockerremote.HostCapabilityResolve | dockerremote.HostCapabilityPush,
+			}}, nil
+		},
+	}
+}
+
+// fakeRegistry serves the tags of a single repository two at a time
+type fakeRegistry struct {
+	mu   sync.Mutex
+	Tags map[string]string
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	const prefix = "/v2/workspace-images/"
+	path := strings.TrimPrefix(req.URL.Path, prefix)
+	switch {
+	case req.Method == http.MethodGet && path == "tags/list":
+		var tags []string
+		for tag := range r.Tags {
+			if tag > req.URL.Query().Get("last") {
+				tags = append(tags, tag)
+			}
+		}
+		sort.Strings(tags)
+		if len(tags) > 2 {
+			tags = tags[:2]
+			w.Header().Set("Link", `<`+prefix+`tags/list?n=2&last=`+tags[1]+`>; rel="next"`)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "workspace-images", "tags": tags})
+	case req.Method == http.MethodHead && strings.HasPrefix(path, "manifests/"):
+		dgst, ok := r.Tags[strings.TrimPrefix(path, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", dgst)
+	case req.Method == http.MethodDelete && strings.HasPrefix(path, "manifests/sha256:"):
+		dgst := strings.TrimPrefix(path, "manifests/")
+		var found bool
+		for tag, d := range r.Tags {
+			if d == dgst {
+				delete(r.Tags, tag)
+				found = true
+			}
+		}
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
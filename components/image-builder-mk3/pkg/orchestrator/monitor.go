@@ -5,6 +5,7 @@
 package orchestrator
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,8 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +32,7 @@ const (
 	annotationRef       = "ref"
 	annotationBaseRef   = "baseref"
 	annotationManagedBy = "managed-by"
+	annotationPlatforms = "platforms"
 )
 
 type orchestrator interface {
@@ -41,7 +45,8 @@ func newBuildMonitor(o orchestrator, wsman wsmanapi.WorkspaceManagerClient) *bui
 		O:             o,
 		wsman:         wsman,
 		runningBuilds: make(map[string]*runningBuild),
-		logs:          map[string]context.CancelFunc{},
+		cacheStats:    make(map[string]*api.BuildCacheStats),
+		logs:          map[string]*headlessLogs{},
 	}
 }
 
@@ -50,9 +55,34 @@ type buildMonitor struct {
 
 	wsman           wsmanapi.WorkspaceManagerClient
 	runningBuilds   map[string]*runningBuild
+	cacheStats      map[string]*api.BuildCacheStats
 	runningBuildsMu sync.RWMutex
 
-	logs map[string]context.CancelFunc
+	logs   map[string]*headlessLogs
+	logsMu sync.Mutex
+}
+
+// logDrainTimeout is how long we wait for the rest of the build log once a build is done
+const logDrainTimeout = 5 * time.Second
+
+// headlessLogs is the listener of the log output of a build
+type headlessLogs struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// drainOnce makes sure we publish the final status only once, even though ws-manager sends several of them
+	drainOnce sync.Once
+	// final is the latest final status of the build, guarded by buildMonitor.logsMu
+	final *api.BuildResponse
+}
+
+// drain waits for the log output to end, but at most logDrainTimeout, and stops listening afterwards
+func (l *headlessLogs) drain() {
+	select {
+	case <-l.done:
+	case <-time.After(logDrainTimeout):
+	}
+	l.cancel()
 }
 
 type runningBuild struct {
@@ -125,35 +155,88 @@ func (m *buildMonitor) handleStatusUpdate(status *wsmanapi.WorkspaceStatus) {
 		bld  = extractRunningBuild(status)
 		resp = extractBuildResponse(status)
 	)
-	m.runningBuildsMu.Lock()
-	if resp.Status != api.BuildStatus_running {
-		delete(m.runningBuilds, status.Id)
-	} else {
+	if resp.Status == api.BuildStatus_running {
+		m.runningBuildsMu.Lock()
 		m.runningBuilds[status.Id] = bld
-	}
-	m.runningBuildsMu.Unlock()
+		m.runningBuildsMu.Unlock()
 
-	m.O.PublishStatus(status.Id, resp)
+		m.O.PublishStatus(status.Id, resp)
 
-	// handleStatusUpdate is called from a single go-routine, hence there's no need to synchronize
-	// access to m.logs
-	if bld.Info.Status == api.BuildStatus_running {
+		m.logsMu.Lock()
 		if _, ok := m.logs[status.Id]; !ok {
 			// we don't have a headless log listener yet, but need one
 			ctx, cancel := context.WithCancel(context.Background())
-			go listenToHeadlessLogs(ctx, bld.Logs.IdeURL, bld.Logs.OwnerToken, m.handleHeadlessLogs(status.Id))
-			m.logs[status.Id] = cancel
-		}
-	} else {
-		if cancel, ok := m.logs[status.Id]; ok {
-			// we have a headless log listener, and need to stop it
-			cancel()
-			delete(m.logs, status.Id)
+			l := &headlessLogs{cancel: cancel, done: make(chan struct{})}
+			go func() {
+				defer close(l.done)
+				listenToHeadlessLogs(ctx, bld.Logs.IdeURL, bld.Logs.OwnerToken, m.handleHeadlessLogs(status.Id))
+			}()
+			m.logs[status.Id] = l
 		}
+		m.logsMu.Unlock()
+		return
 	}
+
+	m.runningBuildsMu.Lock()
+	delete(m.runningBuilds, status.Id)
+	m.runningBuildsMu.Unlock()
+
+	m.logsMu.Lock()
+	l, ok := m.logs[status.Id]
+	if ok {
+		l.final = resp
+	}
+	m.logsMu.Unlock()
+	if !ok {
+		m.publishDone(status.Id, resp)
+		m.forgetBuild(status.Id, nil)
+		return
+	}
+
+	// bob prints the build cache stats at the very end of the build log, which we might not have seen yet.
+	// We publish the latest final status once we've seen the rest of the log.
+	l.drainOnce.Do(func() {
+		go func() {
+			l.drain()
+
+			m.logsMu.Lock()
+			resp := l.final
+			m.logsMu.Unlock()
+
+			m.publishDone(status.Id, resp)
+			m.forgetBuild(status.Id, l)
+		}()
+	})
+}
+
+// publishDone publishes the final status of a build together with the build cache stats we've seen in its log
+func (m *buildMonitor) publishDone(buildID string, resp *api.BuildResponse) {
+	m.runningBuildsMu.RLock()
+	if stats, ok := m.cacheStats[buildID]; ok {
+		resp.Info.Cache = stats
+	}
+	m.runningBuildsMu.RUnlock()
+
+	m.O.PublishStatus(buildID, resp)
+}
+
+// forgetBuild drops what we know about a finished build unless there's a new log listener
+func (m *buildMonitor) forgetBuild(buildID string, l *headlessLogs) {
+	m.logsMu.Lock()
+	defer m.logsMu.Unlock()
+	if m.logs[buildID] != l {
+		return
+	}
+	delete(m.logs, buildID)
+
+	m.runningBuildsMu.Lock()
+	delete(m.cacheStats, buildID)
+	m.runningBuildsMu.Unlock()
 }
 
 func (m *buildMonitor) handleHeadlessLogs(buildID string) listenToHeadlessLogsCallback {
+	// the callback is called from a single go-routine, hence there's no need to synchronize access to line
+	var line []byte
 	return func(content []byte, err error) {
 		if err != nil && !errors.Is(err, context.Canceled) {
 			log.WithError(err).WithField("buildID", buildID).Warn("headless log listener failed")
@@ -163,10 +246,52 @@ func (m *buildMonitor) handleHeadlessLogs(buildID string) listenToHeadlessLogsCa
 
 		if len(content) > 0 {
 			m.O.PublishLog(buildID, string(content))
+
+			line = append(line, content...)
+			for {
+				i := bytes.IndexByte(line, '\n')
+				if i < 0 {
+					break
+				}
+				if stats := parseBuildCacheStats(string(line[:i])); stats != nil {
+					m.runningBuildsMu.Lock()
+					m.cacheStats[buildID] = stats
+					m.runningBuildsMu.Unlock()
+				}
+				line = line[i+1:]
+			}
+			if len(line) > maxLogLineLength {
+				// we're only interested in short lines
+				line = nil
+			}
 		}
 	}
 }
 
+const maxLogLineLength = 4096
+
+// bob prints this line once the build is done, if it used a build cache
+var buildCacheStatsLine = regexp.MustCompile(`build cache: (\d+) of (\d+) steps cached`)
+
+func parseBuildCacheStats(line string) *api.BuildCacheStats {
+	m := buildCacheStatsLine.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	cached, err := strconv.ParseInt(m[1], 10, 32)
+	if err != nil {
+		return nil
+	}
+	steps, err := strconv.ParseInt(m[2], 10, 32)
+	if err != nil {
+		return nil
+	}
+	return &api.BuildCacheStats{
+		Steps:  int32(steps),
+		Cached: int32(cached),
+	}
+}
+
 var errOutOfRetries = xerrors.Errorf("out of retries")
 
 // retry makes multiple attempts to execute op if op returns an UNAVAILABLE gRPC status code
@@ -202,6 +327,14 @@ func extractBuildStatus(status *wsmanapi.WorkspaceStatus) *api.BuildInfo {
 		}
 	}
 
+	var pltfs []*api.BuildPlatformInfo
+	if ps := status.Metadata.Annotations[annotationPlatforms]; ps != "" {
+		// all platforms are built by the same buildkit invocation, hence they share the status of the build
+		for _, p := range strings.Split(ps, ",") {
+			pltfs = append(pltfs, &api.BuildPlatformInfo{Platform: p, Status: s})
+		}
+	}
+
 	return &api.BuildInfo{
 		BuildId:   status.Metadata.MetaId,
 		Ref:       status.Metadata.Annotations[annotationRef],
@@ -214,6 +347,7 @@ func extractBuildStatus(status *wsmanapi.WorkspaceStatus) *api.BuildInfo {
 				"x-gitpod-owner-token": status.Auth.OwnerToken,
 			},
 		},
+		Platforms: pltfs,
 	}
 }
 
@@ -131,3 +131,122 @@ func TestExtractBuildResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBuildCacheStats(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Line        string
+		Expectation *api.BuildCacheStats
+	}{
+		{
+			Name:        "summary",
+			Line:        "build cache: 7 of 9 steps cached",
+			Expectation: &api.BuildCacheStats{Steps: 9, Cached: 7},
+		},
+		{
+			Name:        "terminal output",
+			Line:        "\x1b[0mbuild cache: 0 of 3 steps cached\r",
+			Expectation: &api.BuildCacheStats{Steps: 3, Cached: 0},
+		},
+		{
+			Name: "other output",
+			Line: "#5 [2/3] RUN echo build cache: yes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := parseBuildCacheStats(test.Line)
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.BuildCacheStats{})); diff != "" {
+				t.Errorf("parseBuildCacheStats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleStatusUpdateWaitsForBuildCacheStats(t *testing.T) {
+	const buildID = "build-id"
+
+	o := &recordingOrchestrator{statuses: make(chan *api.BuildResponse, 10)}
+	m := newBuildMonitor(o, nil)
+	logs := &headlessLogs{cancel: func() {}, done: make(chan struct{})}
+	m.logs[buildID] = logs
+
+	m.handleStatusUpdate(&wsmanapi.WorkspaceStatus{
+		Id:         buildID,
+		Phase:      wsmanapi.WorkspacePhase_STOPPING,
+		Metadata:   &wsmanapi.WorkspaceMetadata{MetaId: buildID, StartedAt: timestamppb.Now()},
+		Spec:       &wsmanapi.WorkspaceSpec{},
+		Conditions: &wsmanapi.WorkspaceConditions{},
+		Auth:       &wsmanapi.WorkspaceAuthentication{},
+	})
+	select {
+	case resp := <-o.statuses:
+		t.Fatalf("final status was published before the end of the build log: %v", resp)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the stats arrive after the final status
+	m.handleHeadlessLogs(buildID)([]byte("build cache: 2 of 3 steps cached\n"), nil)
+	close(logs.done)
+
+	select {
+	case resp := <-o.statuses:
+		if diff := cmp.Diff(&api.BuildCacheStats{Steps: 3, Cached: 2}, resp.Info.Cache, cmpopts.IgnoreUnexported(api.BuildCacheStats{})); diff != "" {
+			t.Errorf("unexpected build cache stats (-want +got):\n%s", diff)
+		}
+	case <-time.After(logDrainTimeout):
+		t.Fatal("final status was not published")
+	}
+}
+
+func TestHandleStatusUpdatePublishesFinalStatusOnce(t *testing.T) {
+	const buildID = "build-id"
+
+	o := &recordingOrchestrator{statuses: make(chan *api.BuildResponse, 10)}
+	m := newBuildMonitor(o, nil)
+	logs := &headlessLogs{cancel: func() {}, done: make(chan struct{})}
+	m.logs[buildID] = logs
+
+	for _, phase := range []wsmanapi.WorkspacePhase{wsmanapi.WorkspacePhase_STOPPING, wsmanapi.WorkspacePhase_STOPPED} {
+		m.handleStatusUpdate(&wsmanapi.WorkspaceStatus{
+			Id:         buildID,
+			Phase:      phase,
+			Message:    phase.String(),
+			Metadata:   &wsmanapi.WorkspaceMetadata{MetaId: buildID, StartedAt: timestamppb.Now()},
+			Spec:       &wsmanapi.WorkspaceSpec{},
+			Conditions: &wsmanapi.WorkspaceConditions{},
+			Auth:       &wsmanapi.WorkspaceAuthentication{},
+		})
+	}
+	m.handleHeadlessLogs(buildID)([]byte("build cache: 2 of 3 steps cached\n"), nil)
+	close(logs.done)
+
+	select {
+	case resp := <-o.statuses:
+		if resp.Message != wsmanapi.WorkspacePhase_STOPPED.String() {
+			t.Errorf("expected the latest final status, got %q", resp.Message)
+		}
+		if resp.Info.Cache == nil {
+			t.Error("final status has no build cache stats")
+		}
+	case <-time.After(logDrainTimeout):
+		t.Fatal("final status was not published")
+	}
+	select {
+	case resp := <-o.statuses:
+		t.Errorf("final status was published more than once: %v", resp)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// recordingOrchestrator records the build status updates it receives
+type recordingOrchestrator struct {
+	statuses chan *api.BuildResponse
+}
+
+func (o *recordingOrchestrator) PublishStatus(buildID string, resp *api.BuildResponse) {
+	o.statuses <- resp
+}
+
+func (o *recordingOrchestrator) PublishLog(buildID string, message string) {}
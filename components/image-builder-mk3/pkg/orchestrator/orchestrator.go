@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/containerd/containerd/platforms"
 	"github.com/distribution/reference"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-retryablehttp"
@@ -55,6 +56,9 @@ const (
 	// workspaceBuildProcessVersion controls how we build workspace images.
 	// Incrementing this value will trigger a rebuild of all workspace images.
 	workspaceBuildProcessVersion = 2
+
+	// defaultPlatform is the platform images are built for unless the build request asks for others
+	defaultPlatform = "linux/amd64"
 )
 
 // NewOrchestratingBuilder creates a new orchestrating image builder
@@ -110,6 +114,11 @@ func NewOrchestratingBuilder(cfg config.Configuration) (res *Orchestrator, err e
 
 	retryResolveClient := NewRetryTimeoutClient()
 
+	sbomPolicy, err := newSBOMPolicy(cfg.SBOM.Policy)
+	if err != nil {
+		return nil, err
+	}
+
 	o := &Orchestrator{
 		Config: cfg,
 		Auth:   authentication,
@@ -120,6 +129,7 @@ func NewOrchestratingBuilder(cfg config.Configuration) (res *Orchestrator, err e
 		RefResolver: &resolve.StandaloneRefResolver{},
 
 		retryResolveClient: retryResolveClient,
+		sbomPolicy:         sbomPolicy,
 
 		wsman:         wsman,
 		buildListener: make(map[string]map[buildListener]struct{}),
@@ -129,6 +139,16 @@ func NewOrchestratingBuilder(cfg config.Configuration) (res *Orchestrator, err e
 	}
 	o.monitor = newBuildMonitor(o, o.wsman)
 
+	var wsrepo *imageRepository
+	if cfg.WorkspaceImageRepository != "" {
+		wsrepo = newImageRepository(cfg.WorkspaceImageRepository, authentication)
+		o.cacheCollector = newBuildCacheCollector(wsrepo)
+	}
+	o.refresher, err = newImageRefresher(cfg.ImageRefresh, wsrepo, o.AuthResolver, o.registryResolver, o.rebuildImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return o, nil
 }
 
@@ -140,6 +160,9 @@ type Orchestrator struct {
 	RefResolver  resolve.DockerRefResolver
 
 	retryResolveClient *http.Client
+	sbomPolicy         *sbomPolicy
+	refresher          *imageRefresher
+	cacheCollector     *buildCacheCollector
 
 	wsman wsmanapi.WorkspaceManagerClient
 
@@ -158,9 +181,23 @@ type Orchestrator struct {
 // Start fires up the internals of this image builder
 func (o *Orchestrator) Start(ctx context.Context) error {
 	go o.monitor.Run()
+	if o.refresher != nil {
+		o.refresher.Start(ctx)
+	}
+	if o.cacheCollector != nil {
+		go o.cacheCollector.Run(ctx)
+	}
 	return nil
 }
 
+// Stop waits for the background work of this image builder, e.g. rebuilds of stale images, to end.
+// Cancel the context passed to Start first.
+func (o *Orchestrator) Stop() {
+	if o.refresher != nil {
+		o.refresher.Stop()
+	}
+}
+
 // ResolveBaseImage returns the "digest" form of a Docker image tag thereby making it absolute.
 func (o *Orchestrator) ResolveBaseImage(ctx context.Context, req *protocol.ResolveBaseImageRequest) (resp *protocol.ResolveBaseImageResponse, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ResolveBaseImage")
@@ -168,8 +205,12 @@ func (o *Orchestrator) ResolveBaseImage(ctx context.Context, req *protocol.Resol
 	tracing.LogRequestSafe(span, req)
 
 	reqauth := o.AuthResolver.ResolveRequestAuth(ctx, req.Auth)
+	pltfs, err := normalizePlatforms(req.GetPlatforms())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid platforms: %v", err)
+	}
 
-	refstr, err := o.getAbsoluteImageRef(ctx, req.Ref, reqauth, req.GetUseRetryClient())
+	refstr, _, err := o.getAbsoluteImageRef(ctx, req.Ref, reqauth, req.GetUseRetryClient(), pltfs)
 	if err != nil {
 		return nil, err
 	}
@@ -187,14 +228,18 @@ func (o *Orchestrator) ResolveWorkspaceImage(ctx context.Context, req *protocol.
 
 	reqauth := o.AuthResolver.ResolveRequestAuth(ctx, req.Auth)
 	useRetryClient := req.GetUseRetryClient()
-	baseref, err := o.getBaseImageRef(ctx, req.Source, reqauth, useRetryClient)
+	pltfs, err := normalizePlatforms(req.GetPlatforms())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid platforms: %v", err)
+	}
+	baseref, _, err := o.getBaseImageRef(ctx, req.Source, reqauth, useRetryClient, pltfs)
 	if _, ok := status.FromError(err); err != nil && ok {
 		return nil, err
 	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot resolve base image: %s", err.Error())
 	}
-	refstr, err := o.getWorkspaceImageRef(ctx, baseref)
+	refstr, err := o.getWorkspaceImageRef(ctx, baseref, req.Source.GetFeatures(), pltfs)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot produce image ref: %v", err)
 	}
@@ -240,6 +285,11 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	useRetryClient := req.GetUseRetryClient()
 	log.WithField("forceRebuild", req.GetForceRebuild()).WithField("baseImageNameResolved", req.BaseImageNameResolved).WithField("useRetryClient", useRetryClient).Info("build request")
 
+	pltfs, err := normalizePlatforms(req.GetPlatforms())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid platforms: %v", err)
+	}
+
 	// resolve to ref to baseImageNameResolved (if it exists)
 	if req.BaseImageNameResolved != "" && !req.GetForceRebuild() {
 		if req.Auth != nil && req.Auth.GetSelective() != nil {
@@ -248,7 +298,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			reqauth = o.AuthResolver.ResolveRequestAuth(ctx, req.Auth)
 		}
 
-		wsrefstr, err := o.getWorkspaceImageRef(ctx, req.BaseImageNameResolved)
+		wsrefstr, err := o.getWorkspaceImageRef(ctx, req.BaseImageNameResolved, req.Source.GetFeatures(), pltfs)
 		if err != nil {
 			return status.Errorf(codes.Internal, "cannot produce workspace image ref: %q", err)
 		}
@@ -263,6 +313,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			return status.Errorf(codes.Internal, "cannot check if image is already built: %q", err)
 		}
 		if exists {
+			o.registerRefreshCandidate(resp, wsrefstr, req.BaseImageNameResolved, req)
 			err = resp.Send(&protocol.BuildResponse{
 				Status:  protocol.BuildStatus_done_success,
 				Ref:     wsrefstr,
@@ -273,7 +324,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			}
 			return nil
 		}
-		baseref, err := o.getAbsoluteImageRef(ctx, req.BaseImageNameResolved, reqauth, useRetryClient)
+		baseref, _, err := o.getAbsoluteImageRef(ctx, req.BaseImageNameResolved, reqauth, useRetryClient, pltfs)
 		if err == nil {
 			req.Source.From = &protocol.BuildSource_Ref{
 				Ref: &protocol.BuildSourceReference{
@@ -284,7 +335,8 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	}
 
 	log.Info("falling through to old way of building")
-	baseref, err := o.getBaseImageRef(ctx, req.Source, reqauth, useRetryClient)
+	// The workspace image ref depends on the requested platforms, but we can only build for those the base image is available for.
+	baseref, buildPltfs, err := o.getBaseImageRef(ctx, req.Source, reqauth, useRetryClient, pltfs)
 	if _, ok := status.FromError(err); err != nil && ok {
 		log.WithError(err).Error("gRPC status error")
 		return err
@@ -294,7 +346,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		return status.Errorf(codes.Internal, "cannot resolve base image: %s", err.Error())
 	}
 
-	wsrefstr, err := o.getWorkspaceImageRef(ctx, baseref)
+	wsrefstr, err := o.getWorkspaceImageRef(ctx, baseref, req.Source.GetFeatures(), pltfs)
 	if err != nil {
 		return status.Errorf(codes.Internal, "cannot produce workspace image ref: %q", err)
 	}
@@ -310,6 +362,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	}
 	if exists && !req.GetForceRebuild() {
 		// image has already been built - no need for us to start building
+		o.registerRefreshCandidate(resp, wsrefstr, baseref, req)
 		err = resp.Send(&protocol.BuildResponse{
 			Status:  protocol.BuildStatus_done_success,
 			Ref:     wsrefstr,
@@ -398,6 +451,37 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		}
 	}
 
+	var features []byte
+	if fs := req.Source.GetFeatures(); len(fs) > 0 {
+		features, err = json.Marshal(sortedFeatures(fs))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "cannot marshal features: %v", err)
+		}
+	}
+
+	// Workspace images have to pass the vulnerability policy before anyone can use them. Hence bob pushes
+	// them to an unverified ref, from which we promote them to the workspace image ref once they passed.
+	targetRef := wsrefstr
+	if o.sbomPolicy.failsBuilds() {
+		targetRef, err = unverifiedImageRef(wsrefstr)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot produce unverified workspace image ref: %q", err)
+		}
+	}
+
+	// Builds of the same scope share a build cache which lives next to the workspace images.
+	// Builds of untrusted sources only import the cache, so that they cannot poison it for all other builds.
+	var bobCacheRef, bobCacheImportRefs, cacheRef, previousCacheRef string
+	if scope := req.GetCacheScope(); scope != "" {
+		cacheRef, previousCacheRef = o.getBuildCacheRefs(scope, time.Now())
+		bobCacheRef = "localhost:8080/cache:latest"
+		bobCacheImportRefs = "localhost:8080/cache-previous:latest"
+		if req.GetCacheImportOnly() {
+			bobCacheRef = ""
+			bobCacheImportRefs = "localhost:8080/cache:latest,localhost:8080/cache-previous:latest"
+		}
+	}
+
 	var swr *wsmanapi.StartWorkspaceResponse
 	err = retry(ctx, func(ctx context.Context) (err error) {
 		swr, err = o.wsman.StartWorkspace(ctx, &wsmanapi.StartWorkspaceRequest{
@@ -409,6 +493,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 					annotationRef:       wsrefstr,
 					annotationBaseRef:   baseref,
 					annotationManagedBy: buildWorkspaceManagerID,
+					annotationPlatforms: strings.Join(buildPltfs, ","),
 				},
 				Owner: req.GetTriggeredBy(),
 			},
@@ -427,10 +512,18 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 					{Name: "BOB_BUILD_BASE", Value: buildBase},
 					{Name: "BOB_DOCKERFILE_PATH", Value: dockerfilePath},
 					{Name: "BOB_CONTEXT_DIR", Value: contextPath},
+					{Name: "BOB_FEATURES", Value: string(features)},
+					{Name: "BOB_CACHE_REF", Value: bobCacheRef},
+					{Name: "BOB_CACHE_IMPORT_REFS", Value: bobCacheImportRefs},
+					{Name: "BOB_PLATFORMS", Value: strings.Join(buildPltfs, ",")},
+					{Name: "BOB_SBOM_GENERATOR", Value: o.Config.SBOM.Generator},
 					{Name: "GITPOD_TASKS", Value: `[{"name": "build", "init": "sudo -E /app/bob build"}]`},
 					{Name: "WORKSPACEKIT_RING2_ENCLAVE", Value: "/app/bob proxy"},
 					{Name: "WORKSPACEKIT_BOBPROXY_BASEREF", Value: baseref},
-					{Name: "WORKSPACEKIT_BOBPROXY_TARGETREF", Value: wsrefstr},
+					{Name: "WORKSPACEKIT_BOBPROXY_TARGETREF", Value: targetRef},
+					{Name: "WORKSPACEKIT_BOBPROXY_CACHEREF", Value: cacheRef},
+					{Name: "WORKSPACEKIT_BOBPROXY_PREVIOUSCACHEREF", Value: previousCacheRef},
+					{Name: "WORKSPACEKIT_BOBPROXY_CACHEREADONLY", Value: fmt.Sprintf("%v", req.GetCacheImportOnly())},
 					{
 						Name: "WORKSPACEKIT_BOBPROXY_AUTH",
 						Secret: &wsmanapi.EnvironmentVariable_SecretKeyRef{
@@ -463,7 +556,13 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	updates, cancel := o.registerBuildListener(buildID)
 	defer cancel()
 	for {
-		update := <-updates
+		var update *api.BuildResponse
+		select {
+		case update = <-updates:
+		case <-resp.Context().Done():
+			// The client is gone, but the build keeps running and its result ends up in the registry as usual.
+			return status.Error(codes.Canceled, "client stopped listening for build updates")
+		}
 		if update == nil {
 			// channel was closed unexpectatly
 			return status.Error(codes.Aborted, "subscription canceled - please try again")
@@ -474,13 +573,18 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		// "cannot pull from reg.gitpod.io" error message. Instead the image-build should fail properly.
 		// To do this, we resolve the built image afterwards to ensure it was actually built.
 		if update.Status == protocol.BuildStatus_done_success {
-			exists, err := o.checkImageExists(ctx, wsrefstr, wsrefAuth, useRetryClient)
+			exists, err := o.checkImageExists(ctx, targetRef, wsrefAuth, useRetryClient)
 			if err != nil {
 				update.Status = protocol.BuildStatus_done_failure
 				update.Message = fmt.Sprintf("cannot check if workspace image exists after the build: %v", err)
 			} else if !exists {
 				update.Status = protocol.BuildStatus_done_failure
 				update.Message = "image build did not produce a workspace image"
+			} else if err := o.verifyWorkspaceImage(ctx, buildID, targetRef, wsrefstr, wsrefAuth, useRetryClient); err != nil {
+				update.Status = protocol.BuildStatus_done_failure
+				update.Message = err.Error()
+			} else {
+				o.registerRefreshCandidate(resp, wsrefstr, baseref, req)
 			}
 		}
 
@@ -591,6 +695,23 @@ func (o *Orchestrator) ListBuilds(ctx context.Context, req *protocol.ListBuildsR
 	return &protocol.ListBuildsResponse{Builds: res}, nil
 }
 
+// RefreshImages checks recently built workspace images for staleness and rebuilds the stale ones ahead of demand
+func (o *Orchestrator) RefreshImages(ctx context.Context, req *protocol.RefreshImagesRequest) (resp *protocol.RefreshImagesResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RefreshImages")
+	defer tracing.FinishSpan(span, &err)
+	tracing.LogRequestSafe(span, req)
+
+	if o.refresher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "workspace image refresh is not configured")
+	}
+
+	images, err := o.refresher.Refresh(ctx, req.GetDryRun())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot refresh workspace images: %v", err)
+	}
+	return &protocol.RefreshImagesResponse{Images: images}, nil
+}
+
 func (o *Orchestrator) checkImageExists(ctx context.Context, ref string, authentication *auth.Authentication, useRetryClient bool) (exists bool, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "checkImageExists")
 	defer tracing.FinishSpan(span, &err)
@@ -611,7 +732,9 @@ func (o *Orchestrator) checkImageExists(ctx context.Context, ref string, authent
 }
 
 // getAbsoluteImageRef returns the "digest" form of an image, i.e. contains no mutable image tags
-func (o *Orchestrator) getAbsoluteImageRef(ctx context.Context, ref string, allowedAuth auth.AllowedAuthFor, useRetryClient bool) (res string, err error) {
+// For multi-platform builds the ref points to the image index rather than a single platform's manifest.
+// It also returns the platforms the image is available for, in the form normalizePlatforms produces.
+func (o *Orchestrator) getAbsoluteImageRef(ctx context.Context, ref string, allowedAuth auth.AllowedAuthFor, useRetryClient bool, pltfs []string) (res string, available []string, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "getAbsoluteImageRefWithResolver")
 	defer tracing.FinishSpan(span, &err)
 	span.LogKV("ref", ref)
@@ -620,12 +743,14 @@ func (o *Orchestrator) getAbsoluteImageRef(ctx context.Context, ref string, allo
 	log.WithField("ref", ref).WithField("useRetryClient", useRetryClient).Debug("getAbsoluteImageRefWithResolver")
 	auth, err := allowedAuth.GetAuthFor(ctx, o.Auth, ref)
 	if err != nil {
-		return "", status.Errorf(codes.InvalidArgument, "cannt resolve base image ref: %v", err)
+		return "", nil, status.Errorf(codes.InvalidArgument, "cannt resolve base image ref: %v", err)
 	}
 
-	ref, err = o.RefResolver.Resolve(ctx, ref, resolve.WithAuthentication(auth), o.withRetryIfEnabled(useRetryClient))
+	// resolvers which don't know about platforms leave the requested ones untouched
+	resolved := pltfs
+	ref, err = o.RefResolver.Resolve(ctx, ref, resolve.WithAuthentication(auth), o.withRetryIfEnabled(useRetryClient), resolve.WithPlatforms(pltfs), resolve.WithResolvedPlatforms(&resolved))
 	if errors.Is(err, resolve.ErrNotFound) {
-		return "", status.Error(codes.NotFound, "cannot resolve image")
+		return "", nil, status.Error(codes.NotFound, "cannot resolve image")
 	}
 	if errors.Is(err, resolve.ErrUnauthorized) {
 		if auth == nil {
@@ -633,15 +758,23 @@ func (o *Orchestrator) getAbsoluteImageRef(ctx context.Context, ref string, allo
 		} else if auth.Auth == "" && auth.Password == "" {
 			log.WithField("ref", ref).Warn("auth was empty")
 		}
-		return "", status.Error(codes.Unauthenticated, "cannot resolve image")
+		return "", nil, status.Error(codes.Unauthenticated, "cannot resolve image")
 	}
 	if resolve.TooManyRequestsMatcher(err) {
-		return "", status.Errorf(codes.Unavailable, "upstream registry responds with 'too many request': %v", err)
+		return "", nil, status.Errorf(codes.Unavailable, "upstream registry responds with 'too many request': %v", err)
+	}
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "cannot resolve image: %v", err)
 	}
+
+	available, err = normalizePlatforms(resolved)
 	if err != nil {
-		return "", status.Errorf(codes.Internal, "cannot resolve image: %v", err)
+		return "", nil, status.Errorf(codes.Internal, "cannot resolve image: %v", err)
 	}
-	return ref, nil
+	if len(available) < len(pltfs) {
+		log.WithField("ref", ref).WithField("platforms", pltfs).WithField("available", available).Info("image is not available for all platforms")
+	}
+	return ref, available, nil
 }
 
 func (o *Orchestrator) withRetryIfEnabled(useRetryClient bool) resolve.DockerRefResolverOption {
@@ -651,13 +784,14 @@ func (o *Orchestrator) withRetryIfEnabled(useRetryClient bool) resolve.DockerRef
 	return resolve.WithHttpClient(nil)
 }
 
-func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSource, allowedAuth auth.AllowedAuthFor, useRetryClient bool) (res string, err error) {
+// getBaseImageRef returns the ref of the base image and the platforms it is available for
+func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSource, allowedAuth auth.AllowedAuthFor, useRetryClient bool, pltfs []string) (res string, available []string, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "getBaseImageRef")
 	defer tracing.FinishSpan(span, &err)
 
 	switch src := bs.From.(type) {
 	case *protocol.BuildSource_Ref:
-		return o.getAbsoluteImageRef(ctx, src.Ref.Ref, allowedAuth, useRetryClient)
+		return o.getAbsoluteImageRef(ctx, src.Ref.Ref, allowedAuth, useRetryClient, pltfs)
 
 	case *protocol.BuildSource_File:
 		manifest := map[string]string{
@@ -673,7 +807,11 @@ func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSo
 			manifest["CloneTarget"] = fsrc.CloneTaget
 			manifest["RemoteURI"] = fsrc.RemoteUri
 		} else {
-			return "", xerrors.Errorf("unsupported context initializer")
+			return "", nil, xerrors.Errorf("unsupported context initializer")
+		}
+		// single-platform builds keep the ref they had before multi-platform builds existed
+		if len(pltfs) > 0 {
+			manifest["Platforms"] = strings.Join(pltfs, ",")
 		}
 		// Go maps do NOT maintain their order - we must sort the keys to maintain a stable order
 		var keys []string
@@ -690,10 +828,10 @@ func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSo
 		hash := sha256.New()
 		n, err := hash.Write([]byte(dfl))
 		if err != nil {
-			return "", xerrors.Errorf("cannot compute src image ref: %w", err)
+			return "", nil, xerrors.Errorf("cannot compute src image ref: %w", err)
 		}
 		if n < len(dfl) {
-			return "", xerrors.Errorf("cannot compute src image ref: short write")
+			return "", nil, xerrors.Errorf("cannot compute src image ref: short write")
 		}
 
 		// the mkII image builder supported an image hash salt. That salt broke other assumptions,
@@ -702,18 +840,31 @@ func (o *Orchestrator) getBaseImageRef(ctx context.Context, bs *protocol.BuildSo
 		// basically defaulting to an empty salt string.
 		_, err = fmt.Fprintln(hash, "")
 		if err != nil {
-			return "", xerrors.Errorf("cannot compute src image ref: %w", err)
+			return "", nil, xerrors.Errorf("cannot compute src image ref: %w", err)
 		}
 
-		return fmt.Sprintf("%s:%x", o.Config.BaseImageRepository, hash.Sum([]byte{})), nil
+		return fmt.Sprintf("%s:%x", o.Config.BaseImageRepository, hash.Sum([]byte{})), pltfs, nil
 
 	default:
-		return "", xerrors.Errorf("invalid base image")
+		return "", nil, xerrors.Errorf("invalid base image")
 	}
 }
 
-func (o *Orchestrator) getWorkspaceImageRef(ctx context.Context, baseref string) (ref string, err error) {
+func (o *Orchestrator) getWorkspaceImageRef(ctx context.Context, baseref string, features []*protocol.BuildSourceFeature, pltfs []string) (ref string, err error) {
 	cnt := []byte(fmt.Sprintf("%s\n%d\n", baseref, workspaceBuildProcessVersion))
+	if len(pltfs) > 0 {
+		cnt = append(cnt, []byte(fmt.Sprintf("platforms %s\n", strings.Join(pltfs, ",")))...)
+	}
+	// features are installed on top of the base image, hence they're part of the workspace image.
+	// Without features we produce the same ref as before features existed.
+	for _, f := range sortedFeatures(features) {
+		opts := make([]string, 0, len(f.Options))
+		for k, v := range f.Options {
+			opts = append(opts, k+"="+v)
+		}
+		sort.Strings(opts)
+		cnt = append(cnt, []byte(fmt.Sprintf("feature %s %s\n", f.Ref, strings.Join(opts, ",")))...)
+	}
 	hash := sha256.New()
 	n, err := hash.Write(cnt)
 	if err != nil {
@@ -727,6 +878,47 @@ func (o *Orchestrator) getWorkspaceImageRef(ctx context.Context, baseref string)
 	return fmt.Sprintf("%s:%x", o.Config.WorkspaceImageRepository, dst), nil
 }
 
+// getBuildCacheRefs returns the refs of the build cache shared by all builds of the given scope
+// for the current and the previous period
+func (o *Orchestrator) getBuildCacheRefs(scope string, now time.Time) (current, previous string) {
+	current = fmt.Sprintf("%s:%s", o.Config.WorkspaceImageRepository, buildCacheTag(scope, now))
+	previous = fmt.Sprintf("%s:%s", o.Config.WorkspaceImageRepository, buildCacheTag(scope, now.Add(-buildCachePeriod)))
+	return current, previous
+}
+
+// normalizePlatforms validates the requested platforms and brings them into a canonical, sorted form.
+// It returns nil if only the default platform linux/amd64 is requested, so that single-platform builds
+// produce the same image refs as before.
+func normalizePlatforms(pltfs []string) ([]string, error) {
+	idx := make(map[string]struct{}, len(pltfs))
+	for _, p := range pltfs {
+		spec, err := platforms.Parse(p)
+		if err != nil {
+			return nil, err
+		}
+		idx[platforms.Format(spec)] = struct{}{}
+	}
+	if _, ok := idx[defaultPlatform]; len(idx) == 0 || (len(idx) == 1 && ok) {
+		return nil, nil
+	}
+
+	res := make([]string, 0, len(idx))
+	for p := range idx {
+		res = append(res, p)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// sortedFeatures returns the features ordered by their ref, which only keeps the workspace image ref stable.
+// Bob installs the features in this order only as far as their dependsOn and installsAfter allow.
+func sortedFeatures(features []*protocol.BuildSourceFeature) []*protocol.BuildSourceFeature {
+	res := make([]*protocol.BuildSourceFeature, len(features))
+	copy(res, features)
+	sort.Slice(res, func(i, j int) bool { return res[i].Ref < res[j].Ref })
+	return res
+}
+
 func handleFailedBuildStreamResponse(err error, msg string) error {
 	if err == nil {
 		// OK is OK
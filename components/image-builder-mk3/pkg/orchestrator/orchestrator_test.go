@@ -418,3 +418,31 @@ func TestResolveBaseImageWithRetryClientHandlesTLSTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizePlatforms(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Platforms   []string
+		Expectation []string
+		Error       bool
+	}{
+		{Name: "no platforms"},
+		{Name: "default platform", Platforms: []string{"linux/amd64"}},
+		{Name: "default platform twice", Platforms: []string{"linux/amd64", "linux/x86_64"}},
+		{Name: "single non-default platform", Platforms: []string{"linux/arm64"}, Expectation: []string{"linux/arm64"}},
+		{Name: "multiple platforms", Platforms: []string{"linux/arm64/v8", "linux/amd64", "linux/aarch64"}, Expectation: []string{"linux/amd64", "linux/arm64"}},
+		{Name: "invalid platform", Platforms: []string{"linux/foo/bar/baz"}, Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := normalizePlatforms(test.Platforms)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("normalizePlatforms() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,702 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	protocol "github.com/gitpod-io/gitpod/image-builder/api"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/resolve"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/sbom"
+)
+
+const (
+	// defaultMaxRefreshImages is the number of recently built workspace images considered for a refresh unless configured otherwise
+	defaultMaxRefreshImages = 500
+
+	// maxConcurrentRefreshBuilds limits how many stale images we rebuild at the same time.
+	// Stale images which exceed this limit are rebuilt during a later refresh.
+	maxConcurrentRefreshBuilds = 5
+
+	// maxIndexSize limits how much we read when downloading an image index
+	maxIndexSize = 4 << 20
+
+	// refreshCandidateTagPrefix marks the tags of the refresh candidates in the workspace image repository
+	refreshCandidateTagPrefix = "refresh-"
+
+	// mediaTypeRefreshCandidate is the media type of the config of a refresh candidate's manifest
+	mediaTypeRefreshCandidate = "application/vnd.gitpod.image-refresh.candidate.v1+json"
+
+	// annotationRefreshLastUsed and annotationRefreshRebuildStarted record when a candidate was used and rebuilt last
+	annotationRefreshLastUsed       = "io.gitpod.image-refresh.last-used"
+	annotationRefreshRebuildStarted = "io.gitpod.image-refresh.rebuild-started"
+
+	// refreshRegisterInterval is the time during which we record the use of a workspace image only once
+	refreshRegisterInterval = 24 * time.Hour
+
+	// refreshStoreTimeout limits how long recording a refresh candidate may take
+	refreshStoreTimeout = 30 * time.Second
+
+	// maxRefreshCandidateSize limits how much we read when loading a refresh candidate
+	maxRefreshCandidateSize = 1 << 20
+)
+
+// imageRefresher remembers recently built workspace images and rebuilds them once they've become stale.
+// Only images built from a Dockerfile are considered: the ref of an image built from another image is derived
+// from the digest of that image, hence such images pick up a moved base image tag by themselves.
+//
+// The candidates live in the workspace image repository, so that all replicas see the same ones. They hold no
+// credentials, hence only images whose sources we can fetch without the user's credentials can be rebuilt.
+type imageRefresher struct {
+	Interval            time.Duration
+	MaxAge              time.Duration
+	RebuildOnBaseChange bool
+	MaxImages           int
+
+	// Repository stores the refresh candidates
+	Repository *imageRepository
+	// AuthResolver computes the authentication the build request of a candidate allows
+	AuthResolver auth.Resolver
+	// Resolver produces a registry resolver for a ref which authenticates as allowed
+	Resolver func(ctx context.Context, ref string, allowedAuth auth.AllowedAuthFor) (remotes.Resolver, error)
+	// Rebuild builds a workspace image anew and blocks until the build is done
+	Rebuild func(ctx context.Context, req *protocol.BuildRequest) error
+
+	// ctx bounds the background work of the refresher, i.e. recording candidates and rebuilding stale images
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	mu         sync.Mutex
+	registered map[string]time.Time
+	rebuilding map[string]struct{}
+}
+
+// refreshCandidate is a recently built workspace image
+type refreshCandidate struct {
+	Ref     string
+	BaseRef string
+	Request *protocol.BuildRequest
+
+	LastUsed       time.Time
+	RebuildStarted time.Time
+
+	// Digest is the digest of the manifest the candidate is stored as
+	Digest digest.Digest
+}
+
+// storedRefreshCandidate is the config of a refresh candidate's manifest
+type storedRefreshCandidate struct {
+	Ref     string          `json:"ref"`
+	BaseRef string          `json:"baseRef"`
+	Request json.RawMessage `json:"request"`
+}
+
+func newImageRefresher(cfg *config.ImageRefreshConfig, repository *imageRepository, authResolver auth.Resolver, resolver func(ctx context.Context, ref string, allowedAuth auth.AllowedAuthFor) (remotes.Resolver, error), rebuild func(ctx context.Context, req *protocol.BuildRequest) error) (*imageRefresher, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	if repository == nil {
+		return nil, xerrors.Errorf("image refresh requires a workspace image repository")
+	}
+
+	res := &imageRefresher{
+		RebuildOnBaseChange: cfg.RebuildOnBaseChange,
+		MaxImages:           cfg.MaxImages,
+		Repository:          repository,
+		AuthResolver:        authResolver,
+		Resolver:            resolver,
+		Rebuild:             rebuild,
+		registered:          make(map[string]time.Time),
+		rebuilding:          make(map[string]struct{}),
+	}
+	if res.MaxImages <= 0 {
+		res.MaxImages = defaultMaxRefreshImages
+	}
+	if cfg.Interval != "" {
+		interval, err := time.ParseDuration(cfg.Interval)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid image refresh interval: %w", err)
+		}
+		res.Interval = interval
+	}
+	if cfg.MaxAge != "" {
+		maxAge, err := time.ParseDuration(cfg.MaxAge)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid image refresh max age: %w", err)
+		}
+		res.MaxAge = maxAge
+	}
+	return res, nil
+}
+
+// Start runs the background work of the refresher until the context is canceled. It must be called before
+// any images are registered or refreshed.
+func (r *imageRefresher) Start(ctx context.Context) {
+	r.mu.Lock()
+	r.ctx = ctx
+	r.mu.Unlock()
+
+	if r.Interval > 0 {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.Run(ctx)
+		}()
+	}
+}
+
+// Stop waits for the background work of the refresher to end. Cancel the context passed to Start first.
+func (r *imageRefresher) Stop() {
+	r.wg.Wait()
+}
+
+// Register remembers a workspace image for a later refresh. The build request is stripped of all credentials
+// before we store it, see refreshRequest.
+func (r *imageRefresher) Register(ref, baseref string, req *protocol.BuildRequest) {
+	if req.Source.GetFile() == nil {
+		return
+	}
+
+	r.mu.Lock()
+	if t, ok := r.registered[ref]; ok && time.Since(t) < refreshRegisterInterval {
+		r.mu.Unlock()
+		return
+	}
+	if len(r.registered) >= r.MaxImages {
+		// the repository holds the candidates - this only saves us from recording every single use
+		r.registered = make(map[string]time.Time)
+	}
+	r.registered[ref] = time.Now()
+	ctx := r.ctx
+	r.mu.Unlock()
+
+	c := refreshCandidate{
+		Ref:      ref,
+		BaseRef:  baseref,
+		Request:  refreshRequest(req),
+		LastUsed: time.Now(),
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, refreshStoreTimeout)
+		defer cancel()
+		_, err := r.store(ctx, c)
+		if err != nil {
+			log.WithError(err).WithField("ref", ref).Warn("cannot remember workspace image for a later refresh")
+
+			r.mu.Lock()
+			delete(r.registered, ref)
+			r.mu.Unlock()
+		}
+	}()
+}
+
+// refreshRequest strips a build request down to what we need for a rebuild. We don't hold on to the user's
+// credentials: explicit registry credentials are dropped, and so are the credentials of Git initializers.
+func refreshRequest(req *protocol.BuildRequest) *protocol.BuildRequest {
+	res := &protocol.BuildRequest{
+		Source:          proto.Clone(req.Source).(*protocol.BuildSource),
+		TriggeredBy:     req.TriggeredBy,
+		SupervisorRef:   req.SupervisorRef,
+		UseRetryClient:  req.UseRetryClient,
+		CacheScope:      req.CacheScope,
+		Platforms:       append([]string(nil), req.Platforms...),
+		CacheImportOnly: req.CacheImportOnly,
+	}
+	if req.Auth != nil {
+		// the mode only allows the use of our own registry credentials
+		res.Auth = proto.Clone(req.Auth).(*protocol.BuildRegistryAuth)
+		res.Auth.Additional = nil
+	}
+	stripGitCredentials(res.Source.GetFile().GetSource())
+	return res
+}
+
+// stripGitCredentials removes the credentials of all Git initializers of a workspace initializer
+func stripGitCredentials(init *csapi.WorkspaceInitializer) {
+	switch spec := init.GetSpec().(type) {
+	case *csapi.WorkspaceInitializer_Git:
+		stripGitInitializerCredentials(spec.Git)
+	case *csapi.WorkspaceInitializer_Prebuild:
+		for _, git := range spec.Prebuild.GetGit() {
+			stripGitInitializerCredentials(git)
+		}
+	case *csapi.WorkspaceInitializer_Composite:
+		for _, i := range spec.Composite.GetInitializer() {
+			stripGitCredentials(i)
+		}
+	}
+}
+
+func stripGitInitializerCredentials(git *csapi.GitInitializer) {
+	git.RemoteUri = stripURLCredentials(git.RemoteUri)
+	git.Upstream_RemoteUri = stripURLCredentials(git.Upstream_RemoteUri)
+	if git.Config != nil {
+		git.Config.Authentication = csapi.GitAuthMethod_NO_AUTH
+		git.Config.AuthUser = ""
+		git.Config.AuthPassword = ""
+		git.Config.AuthOts = ""
+	}
+}
+
+func stripURLCredentials(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.User == nil {
+		return uri
+	}
+	u.User = nil
+	return u.String()
+}
+
+// refreshCandidateTag returns the tag a workspace image is stored as refresh candidate under
+func refreshCandidateTag(ref string) string {
+	return fmt.Sprintf("%s%x", refreshCandidateTagPrefix, sha256.Sum256([]byte(ref)))
+}
+
+// store records a refresh candidate in the workspace image repository. The candidate's request is the config
+// of an otherwise empty manifest, while its use is recorded in the manifest's annotations.
+// It returns the digest of the manifest.
+func (r *imageRefresher) store(ctx context.Context, c refreshCandidate) (digest.Digest, error) {
+	req, err := protojson.Marshal(c.Request)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := json.Marshal(storedRefreshCandidate{Ref: c.Ref, BaseRef: c.BaseRef, Request: req})
+	if err != nil {
+		return "", err
+	}
+	cfgDesc := ociv1.Descriptor{
+		MediaType: mediaTypeRefreshCandidate,
+		Digest:    digest.FromBytes(cfg),
+		Size:      int64(len(cfg)),
+	}
+
+	annotations := map[string]string{annotationRefreshLastUsed: c.LastUsed.UTC().Format(time.RFC3339)}
+	if !c.RebuildStarted.IsZero() {
+		annotations[annotationRefreshRebuildStarted] = c.RebuildStarted.UTC().Format(time.RFC3339)
+	}
+	mf, err := json.Marshal(ociv1.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ociv1.MediaTypeImageManifest,
+		ArtifactType: mediaTypeRefreshCandidate,
+		Config:       cfgDesc,
+		Layers:       []ociv1.Descriptor{},
+		Annotations:  annotations,
+	})
+	if err != nil {
+		return "", err
+	}
+	mfDesc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageManifest,
+		Digest:    digest.FromBytes(mf),
+		Size:      int64(len(mf)),
+	}
+
+	pusher, err := r.Repository.resolver().Pusher(ctx, r.Repository.ref(refreshCandidateTag(c.Ref)))
+	if err != nil {
+		return "", err
+	}
+	err = pushContent(ctx, pusher, cfgDesc, bytes.NewReader(cfg))
+	if err != nil {
+		return "", xerrors.Errorf("cannot push refresh candidate: %w", err)
+	}
+	err = pushContent(ctx, pusher, mfDesc, bytes.NewReader(mf))
+	if err != nil {
+		return "", xerrors.Errorf("cannot push refresh candidate manifest: %w", err)
+	}
+	return mfDesc.Digest, nil
+}
+
+// candidates loads all refresh candidates from the workspace image repository
+func (r *imageRefresher) candidates(ctx context.Context) ([]refreshCandidate, error) {
+	tags, err := r.Repository.listTags(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list refresh candidates: %w", err)
+	}
+
+	resolver := r.Repository.resolver()
+	var res []refreshCandidate
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, refreshCandidateTagPrefix) {
+			continue
+		}
+		c, err := loadRefreshCandidate(ctx, resolver, r.Repository.ref(tag))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			log.WithError(err).WithField("tag", tag).Warn("cannot load refresh candidate")
+			continue
+		}
+		res = append(res, *c)
+	}
+	return res, nil
+}
+
+func loadRefreshCandidate(ctx context.Context, resolver remotes.Resolver, ref string) (*refreshCandidate, error) {
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var mf ociv1.Manifest
+	err = fetchJSON(ctx, fetcher, desc, &mf)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download manifest: %w", err)
+	}
+	if mf.Config.MediaType != mediaTypeRefreshCandidate {
+		return nil, xerrors.Errorf("unexpected config media type %s", mf.Config.MediaType)
+	}
+	var stored storedRefreshCandidate
+	err = fetchJSON(ctx, fetcher, mf.Config, &stored)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot download refresh candidate: %w", err)
+	}
+	var req protocol.BuildRequest
+	err = protojson.Unmarshal(stored.Request, &req)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal build request: %w", err)
+	}
+
+	res := &refreshCandidate{
+		Ref:     stored.Ref,
+		BaseRef: stored.BaseRef,
+		Request: &req,
+		Digest:  desc.Digest,
+	}
+	// a missing or broken timestamp merely makes the candidate look old
+	res.LastUsed, _ = time.Parse(time.RFC3339, mf.Annotations[annotationRefreshLastUsed])
+	res.RebuildStarted, _ = time.Parse(time.RFC3339, mf.Annotations[annotationRefreshRebuildStarted])
+	return res, nil
+}
+
+func fetchJSON(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor, dst interface{}) error {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return json.NewDecoder(io.LimitReader(rc, maxRefreshCandidateSize)).Decode(dst)
+}
+
+// forget removes a refresh candidate from the workspace image repository
+func (r *imageRefresher) forget(ctx context.Context, c refreshCandidate) {
+	_, err := r.Repository.deleteManifest(ctx, c.Digest.String())
+	if err != nil {
+		log.WithError(err).WithField("ref", c.Ref).Warn("cannot forget refresh candidate")
+	}
+}
+
+// Run refreshes stale images at the configured interval. This function blocks until the context is canceled
+// and is intended to run as a Go routine.
+func (r *imageRefresher) Run(ctx context.Context) {
+	log.WithField("interval", r.Interval.String()).WithField("maxAge", r.MaxAge.String()).WithField("rebuildOnBaseChange", r.RebuildOnBaseChange).Info("starting workspace image refresh")
+
+	t := time.NewTicker(r.Interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			log.Debug("context cancelled - shutting down workspace image refresh")
+			return
+		}
+
+		stale, err := r.Refresh(ctx, false)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithError(err).Warn("cannot refresh workspace images")
+			}
+			continue
+		}
+		if len(stale) > 0 {
+			log.WithField("stale", len(stale)).Info("refreshing stale workspace images")
+		}
+	}
+}
+
+// Refresh checks the most recently used images for staleness and rebuilds the stale ones unless dryRun is set.
+// It returns the stale images. Images beyond the MaxImages most recently used ones are forgotten.
+func (r *imageRefresher) Refresh(ctx context.Context, dryRun bool) ([]*protocol.RefreshedImage, error) {
+	candidates, err := r.candidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].LastUsed.After(candidates[j].LastUsed) })
+	if len(candidates) > r.MaxImages {
+		if !dryRun {
+			for _, c := range candidates[r.MaxImages:] {
+				r.forget(ctx, c)
+			}
+		}
+		candidates = candidates[:r.MaxImages]
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Ref < candidates[j].Ref })
+
+	var res []*protocol.RefreshedImage
+	for _, c := range candidates {
+		reason, err := r.staleReason(ctx, c)
+		if err != nil {
+			log.WithError(err).WithField("ref", c.Ref).WithField("baseref", c.BaseRef).Warn("cannot check if workspace image is stale")
+			continue
+		}
+		if reason == "" {
+			continue
+		}
+
+		img := &protocol.RefreshedImage{
+			Ref:         c.Ref,
+			BaseRef:     c.BaseRef,
+			StaleReason: reason,
+		}
+		if !dryRun {
+			img.Rebuilding = r.rebuild(ctx, c)
+		}
+		res = append(res, img)
+	}
+	return res, nil
+}
+
+// staleReason explains why an image is stale. It returns an empty string if the image is not stale.
+func (r *imageRefresher) staleReason(ctx context.Context, c refreshCandidate) (string, error) {
+	// the base image of a Dockerfile build lives in our own repository, hence carries the provenance
+	resolver, err := r.Resolver(ctx, c.BaseRef, auth.AllowedAuthForAll())
+	if err != nil {
+		return "", err
+	}
+	prov, err := sbom.FetchProvenance(ctx, resolver, c.BaseRef)
+	if errors.Is(err, sbom.ErrNoProvenance) {
+		// the image was built before we recorded provenance - we cannot tell its age or base image
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if r.MaxAge > 0 && !prov.BuildFinishedOn.IsZero() && time.Since(prov.BuildFinishedOn) > r.MaxAge {
+		return fmt.Sprintf("image is older than %s", r.MaxAge), nil
+	}
+	if !r.RebuildOnBaseChange {
+		return "", nil
+	}
+	for _, m := range prov.Materials {
+		ref, ok := m.ImageRef()
+		if !ok || m.Digest == "" {
+			continue
+		}
+		moved, err := r.imageMoved(ctx, ref, m.Digest, r.AuthResolver.ResolveRequestAuth(ctx, c.Request.GetAuth()))
+		if err != nil {
+			return "", xerrors.Errorf("cannot resolve base image %s: %w", ref, err)
+		}
+		if moved {
+			return fmt.Sprintf("base image %s has changed", ref), nil
+		}
+	}
+	return "", nil
+}
+
+// imageMoved returns true if an image ref no longer points to the image with the given digest
+func (r *imageRefresher) imageMoved(ctx context.Context, ref string, dgst digest.Digest, allowedAuth auth.AllowedAuthFor) (bool, error) {
+	pref, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return false, err
+	}
+	ref = pref.String()
+
+	resolver, err := r.Resolver(ctx, ref, allowedAuth)
+	if err != nil {
+		return false, err
+	}
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return false, err
+	}
+	if desc.Digest == dgst {
+		return false, nil
+	}
+	switch desc.MediaType {
+	case images.MediaTypeDockerSchema2ManifestList, ociv1.MediaTypeImageIndex:
+	default:
+		return true, nil
+	}
+
+	// buildkit may have recorded the digest of the platform's manifest rather than the index
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	var idx ociv1.Index
+	err = json.NewDecoder(io.LimitReader(rc, maxIndexSize)).Decode(&idx)
+	if err != nil {
+		return false, xerrors.Errorf("cannot unmarshal index: %w", err)
+	}
+	for _, mf := range idx.Manifests {
+		if mf.Digest == dgst {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// rebuild starts the rebuild of a stale image unless it's already being rebuilt, possibly by another replica.
+// It returns false if too many rebuilds are running already.
+//
+// A candidate whose rebuild fails is forgotten: without the user's credentials we cannot clone private
+// repositories or pull private base images, and would fail again on every refresh. The next use of the
+// image records it anew.
+func (r *imageRefresher) rebuild(ctx context.Context, c refreshCandidate) bool {
+	if time.Since(c.RebuildStarted) < maxBuildRuntime {
+		return true
+	}
+
+	r.mu.Lock()
+	if _, ok := r.rebuilding[c.Ref]; ok {
+		r.mu.Unlock()
+		return true
+	}
+	if len(r.rebuilding) >= maxConcurrentRefreshBuilds {
+		r.mu.Unlock()
+		return false
+	}
+	r.rebuilding[c.Ref] = struct{}{}
+	bgctx := r.ctx
+	r.mu.Unlock()
+
+	// let the other replicas know we're rebuilding the image
+	c.RebuildStarted = time.Now()
+	dgst, err := r.store(ctx, c)
+	if err != nil {
+		log.WithError(err).WithField("ref", c.Ref).Warn("cannot record the rebuild of a stale workspace image")
+	} else {
+		c.Digest = dgst
+	}
+
+	req := proto.Clone(c.Request).(*protocol.BuildRequest)
+	req.ForceRebuild = true
+	req.BaseImageNameResolved = ""
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.mu.Lock()
+			delete(r.rebuilding, c.Ref)
+			r.mu.Unlock()
+		}()
+
+		log.WithField("ref", c.Ref).Info("rebuilding stale workspace image")
+		err := r.Rebuild(bgctx, req)
+		if err == nil || bgctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithField("ref", c.Ref).Warn("cannot rebuild stale workspace image")
+		if !errors.Is(err, errBuildFailed) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(bgctx, refreshStoreTimeout)
+		defer cancel()
+		r.forget(ctx, c)
+
+		r.mu.Lock()
+		delete(r.registered, c.Ref)
+		r.mu.Unlock()
+	}()
+	return true
+}
+
+// registerRefreshCandidate remembers a workspace image for a later refresh.
+// Builds started by the image refresher itself don't count as use of the image.
+func (o *Orchestrator) registerRefreshCandidate(resp protocol.ImageBuilder_BuildServer, ref, baseref string, req *protocol.BuildRequest) {
+	if o.refresher == nil {
+		return
+	}
+	if _, ok := resp.(*refreshBuildServer); ok {
+		return
+	}
+	o.refresher.Register(ref, baseref, req)
+}
+
+// registryResolver produces a registry resolver for a ref which authenticates as allowed
+func (o *Orchestrator) registryResolver(ctx context.Context, ref string, allowedAuth auth.AllowedAuthFor) (remotes.Resolver, error) {
+	ath, err := allowedAuth.GetAuthFor(ctx, o.Auth, ref)
+	if err != nil {
+		return nil, err
+	}
+	return resolve.NewRegistryResolver(resolve.WithAuthentication(ath), o.withRetryIfEnabled(true)), nil
+}
+
+// errBuildFailed is returned by rebuildImage if the build itself failed, e.g. because we couldn't clone the repository
+var errBuildFailed = xerrors.Errorf("build failed")
+
+// rebuildImage runs a build on behalf of the image refresher
+func (o *Orchestrator) rebuildImage(ctx context.Context, req *protocol.BuildRequest) error {
+	srv := &refreshBuildServer{ctx: ctx}
+	err := o.Build(req, srv)
+	if err != nil {
+		return err
+	}
+	if srv.failure != "" {
+		return xerrors.Errorf("%w: %s", errBuildFailed, srv.failure)
+	}
+	return nil
+}
+
+// refreshBuildServer receives the updates of builds started by the image refresher
+type refreshBuildServer struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	failure string
+}
+
+func (s *refreshBuildServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *refreshBuildServer) Send(resp *protocol.BuildResponse) error {
+	if resp.Status == protocol.BuildStatus_done_failure {
+		s.failure = resp.Message
+	}
+	return nil
+}
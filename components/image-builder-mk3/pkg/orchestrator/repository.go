@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/distribution/reference"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+)
+
+// pushContent pushes a blob or manifest unless the registry has it already
+func pushContent(ctx context.Context, pusher remotes.Pusher, desc ociv1.Descriptor, content io.Reader) error {
+	w, err := pusher.Push(ctx, desc)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	_, err = io.Copy(w, io.LimitReader(content, desc.Size))
+	if err != nil {
+		return err
+	}
+	err = w.Commit(ctx, desc.Size, desc.Digest)
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// imageRepository talks to the registry API of a single repository, e.g. the workspace image repository.
+// Unlike a containerd resolver it also lists and deletes tags.
+type imageRepository struct {
+	Name  string
+	Hosts dockerremote.RegistryHosts
+}
+
+func newImageRepository(name string, authenticator auth.RegistryAuthenticator) *imageRepository {
+	creds := func(host string) (username, password string, err error) {
+		if authenticator == nil {
+			return
+		}
+		ath, err := authenticator.Authenticate(context.Background(), host)
+		if err != nil || ath == nil {
+			return "", "", err
+		}
+		return ath.Username, ath.Password, nil
+	}
+	return &imageRepository{
+		Name: name,
+		Hosts: dockerremote.ConfigureDefaultRegistries(
+			dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(creds))),
+		),
+	}
+}
+
+// resolver produces a containerd resolver which uses the same registry hosts as the repository
+func (r *imageRepository) resolver() remotes.Resolver {
+	return dockerremote.NewResolver(dockerremote.ResolverOptions{Hosts: r.Hosts})
+}
+
+// ref returns the ref of a tag in the repository
+func (r *imageRepository) ref(tag string) string {
+	return r.Name + ":" + tag
+}
+
+func (r *imageRepository) listTags(ctx context.Context) ([]string, error) {
+	var (
+		res  []string
+		last string
+	)
+	for {
+		query := url.Values{"n": []string{strconv.Itoa(maxTagsPageSize)}}
+		if last != "" {
+			query.Set("last", last)
+		}
+		resp, err := r.do(ctx, http.MethodGet, "/tags/list", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(io.LimitReader(resp.Body, maxTagListSize)).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, xerrors.Errorf("cannot decode tag list: %w", err)
+		}
+		res = append(res, page.Tags...)
+
+		// registries announce further pages using a Link header
+		if resp.Header.Get("Link") == "" || len(page.Tags) == 0 {
+			return res, nil
+		}
+		last = page.Tags[len(page.Tags)-1]
+	}
+}
+
+// manifestDigest returns the digest of the manifest a tag points to, or an empty string if the tag does not exist
+func (r *imageRepository) manifestDigest(ctx context.Context, tag string) (string, error) {
+	header := http.Header{}
+	for _, mt := range []string{ociv1.MediaTypeImageIndex, ociv1.MediaTypeImageManifest, images.MediaTypeDockerSchema2ManifestList, images.MediaTypeDockerSchema2Manifest} {
+		header.Add("Accept", mt)
+	}
+	resp, err := r.do(ctx, http.MethodHead, "/manifests/"+tag, nil, header)
+	if errors.Is(err, errRegistryNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	dgst := resp.Header.Get("Docker-Content-Digest")
+	if dgst == "" {
+		return "", xerrors.Errorf("registry did not return the digest of %s", tag)
+	}
+	return dgst, nil
+}
+
+// deleteManifest deletes a manifest and all tags which point to it. It returns false if the manifest does not exist.
+func (r *imageRepository) deleteManifest(ctx context.Context, dgst string) (bool, error) {
+	resp, err := r.do(ctx, http.MethodDelete, "/manifests/"+dgst, nil, nil)
+	if errors.Is(err, errRegistryNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+var errRegistryNotFound = xerrors.Errorf("not found")
+
+// do sends a request to the registry API of the repository and authenticates if the registry asks us to
+func (r *imageRepository) do(ctx context.Context, method, path string, query url.Values, header http.Header) (*http.Response, error) {
+	ref, err := reference.ParseNormalizedNamed(r.Name)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse workspace image repository: %w", err)
+	}
+	hosts, err := r.Hosts(reference.Domain(ref))
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts) == 0 {
+		return nil, xerrors.Errorf("no registry host for %s", reference.Domain(ref))
+	}
+	host := hosts[0]
+	u := url.URL{
+		Scheme:   host.Scheme,
+		Host:     host.Host,
+		Path:     host.Path + "/" + reference.Path(ref) + path,
+		RawQuery: query.Encode(),
+	}
+	client := host.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		if host.Authorizer != nil {
+			err = host.Authorizer.Authorize(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 && host.Authorizer != nil {
+			err = host.Authorizer.AddResponses(ctx, []*http.Response{resp})
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil, errRegistryNotFound
+		}
+		if resp.StatusCode >= 300 {
+			resp.Body.Close()
+			return nil, xerrors.Errorf("%s %s: registry returned %s", method, u.Path, resp.Status)
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/distribution/reference"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/resolve"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/sbom"
+)
+
+// unverifiedImageTagSuffix marks the tags of workspace images which still have to pass the vulnerability policy
+const unverifiedImageTagSuffix = "-unverified"
+
+// sbomPolicy evaluates the SBOM of workspace images against a vulnerability database
+type sbomPolicy struct {
+	DB          *sbom.VulnerabilityDB
+	Action      config.SBOMPolicyAction
+	MinSeverity sbom.Severity
+}
+
+func newSBOMPolicy(cfg *config.SBOMPolicyConfig) (*sbomPolicy, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	action := cfg.Action
+	switch action {
+	case "":
+		action = config.SBOMPolicyActionWarn
+	case config.SBOMPolicyActionWarn, config.SBOMPolicyActionFail:
+	default:
+		return nil, xerrors.Errorf("unknown SBOM policy action %q", cfg.Action)
+	}
+	minSeverity, err := sbom.ParseSeverity(cfg.MinSeverity)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sbom.LoadVulnerabilityDB(cfg.VulnerabilityDB)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot load vulnerability database: %w", err)
+	}
+
+	return &sbomPolicy{
+		DB:          db,
+		Action:      action,
+		MinSeverity: minSeverity,
+	}, nil
+}
+
+// evaluate produces the build log lines reporting the findings and an error if the build must fail
+func (p *sbomPolicy) evaluate(doc *sbom.Document) (report string, err error) {
+	findings := p.DB.Match(doc, p.MinSeverity)
+	if len(findings) == 0 {
+		return fmt.Sprintf("vulnerability policy: none of the %d packages of the workspace image are vulnerable\n", len(doc.Packages)), nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "vulnerability policy: found %d vulnerabilities in the workspace image\n", len(findings))
+	for _, f := range findings {
+		fmt.Fprintf(&b, "  %s\n", f)
+	}
+	if p.Action == config.SBOMPolicyActionFail {
+		return b.String(), xerrors.Errorf("workspace image violates the vulnerability policy: found %d vulnerabilities", len(findings))
+	}
+	return b.String(), nil
+}
+
+// check fetches the SBOM of an image and evaluates it. If the policy fails builds, so do images we cannot evaluate.
+func (p *sbomPolicy) check(ctx context.Context, resolver remotes.Resolver, ref string) (report string, err error) {
+	doc, err := sbom.Fetch(ctx, resolver, ref)
+	if errors.Is(err, sbom.ErrNoSBOM) {
+		if p.Action == config.SBOMPolicyActionFail {
+			return "vulnerability policy: workspace image has no SBOM\n", xerrors.Errorf("workspace image violates the vulnerability policy: it has no SBOM")
+		}
+		return "vulnerability policy: workspace image has no SBOM - skipping\n", nil
+	}
+	if err != nil {
+		log.WithError(err).WithField("ref", ref).Warn("cannot download workspace image SBOM")
+		if p.Action == config.SBOMPolicyActionFail {
+			return fmt.Sprintf("vulnerability policy: cannot download workspace image SBOM: %v\n", err), xerrors.Errorf("cannot evaluate the workspace image against the vulnerability policy: %w", err)
+		}
+		return fmt.Sprintf("vulnerability policy: cannot download workspace image SBOM - skipping: %v\n", err), nil
+	}
+
+	return p.evaluate(doc)
+}
+
+// failsBuilds returns true if workspace images must pass the policy before anyone can use them
+func (p *sbomPolicy) failsBuilds() bool {
+	return p != nil && p.Action == config.SBOMPolicyActionFail
+}
+
+// unverifiedImageRef returns the ref bob pushes a workspace image to while it still has to pass the vulnerability policy.
+// The ref is the same for all builds of a workspace image, so that failed builds don't pile up tags.
+func unverifiedImageRef(ref string) (string, error) {
+	pref, err := reference.ParseNamed(ref)
+	if err != nil {
+		return "", err
+	}
+	tagged, ok := pref.(reference.Tagged)
+	if !ok {
+		return "", xerrors.Errorf("workspace image ref %s has no tag", ref)
+	}
+	res, err := reference.WithTag(reference.TrimNamed(pref), tagged.Tag()+unverifiedImageTagSuffix)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// verifyWorkspaceImage evaluates the workspace image a build pushed to builtRef against the vulnerability policy
+// and streams the result to the build log. It returns an error if the image violates the policy and the policy
+// fails builds. If the build pushed to an unverified ref, the image is promoted to ref once it passed, so that
+// neither workspaces nor later builds ever use an image which violates the policy.
+func (o *Orchestrator) verifyWorkspaceImage(ctx context.Context, buildID, builtRef, ref string, refAuth *auth.Authentication, useRetryClient bool) error {
+	if o.sbomPolicy == nil {
+		return nil
+	}
+	resolver := resolve.NewRegistryResolver(resolve.WithAuthentication(refAuth), o.withRetryIfEnabled(useRetryClient))
+
+	o.PublishLog(buildID, "evaluating workspace image SBOM against the vulnerability policy ...\n")
+	if builtRef == ref {
+		report, err := o.sbomPolicy.check(ctx, resolver, ref)
+		o.PublishLog(buildID, report)
+		return err
+	}
+
+	// We evaluate and promote the image by digest, so that a concurrent build of the same
+	// workspace image cannot swap the image in between.
+	name, desc, err := resolver.Resolve(ctx, builtRef)
+	if err != nil {
+		return xerrors.Errorf("cannot resolve workspace image: %w", err)
+	}
+	pinned, err := pinnedImageRef(name, desc)
+	if err != nil {
+		return xerrors.Errorf("cannot resolve workspace image: %w", err)
+	}
+	report, err := o.sbomPolicy.check(ctx, resolver, pinned)
+	o.PublishLog(buildID, report)
+	if err != nil {
+		return err
+	}
+
+	err = promoteImage(ctx, resolver, pinned, desc, ref)
+	if err != nil {
+		log.WithError(err).WithField("buildID", buildID).WithField("ref", ref).Warn("cannot promote workspace image")
+		return xerrors.Errorf("cannot promote workspace image: %w", err)
+	}
+	return nil
+}
+
+func pinnedImageRef(name string, desc ociv1.Descriptor) (string, error) {
+	pref, err := reference.ParseNamed(name)
+	if err != nil {
+		return "", err
+	}
+	res, err := reference.WithDigest(reference.TrimNamed(pref), desc.Digest)
+	if err != nil {
+		return "", err
+	}
+	return res.String(), nil
+}
+
+// promoteImage tags the manifest desc describes with ref. The manifest lives in the repository of ref already,
+// hence pushing the manifest itself suffices.
+func promoteImage(ctx context.Context, resolver remotes.Resolver, src string, desc ociv1.Descriptor, ref string) error {
+	fetcher, err := resolver.Fetcher(ctx, src)
+	if err != nil {
+		return err
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	pusher, err := resolver.Pusher(ctx, ref)
+	if err != nil {
+		return err
+	}
+	return pushContent(ctx, pusher, desc, rc)
+}
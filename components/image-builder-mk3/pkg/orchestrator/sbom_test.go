@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/sbom"
+)
+
+func TestSBOMPolicyEvaluate(t *testing.T) {
+	doc := &sbom.Document{Packages: []sbom.Package{
+		{Name: "openssl", Version: "3.0.2"},
+		{Name: "curl", Version: "7.81.0"},
+	}}
+	db := &sbom.VulnerabilityDB{Vulnerabilities: []sbom.Vulnerability{
+		{ID: "CVE-2023-0286", Package: "openssl", Versions: []string{"3.0.2"}, Severity: "high"},
+		{ID: "CVE-2023-38546", Package: "curl", Versions: []string{"7.81.0"}, Severity: "low"},
+	}}
+
+	type Expectation struct {
+		Report string
+		Error  bool
+	}
+	tests := []struct {
+		Name        string
+		Policy      sbomPolicy
+		Expectation Expectation
+	}{
+		{
+			Name:   "warn",
+			Policy: sbomPolicy{DB: db, Action: config.SBOMPolicyActionWarn},
+			Expectation: Expectation{
+				Report: "vulnerability policy: found 2 vulnerabilities in the workspace image\n  CVE-2023-0286 (high): openssl 3.0.2\n  CVE-2023-38546 (low): curl 7.81.0\n",
+			},
+		},
+		{
+			Name:   "fail",
+			Policy: sbomPolicy{DB: db, Action: config.SBOMPolicyActionFail, MinSeverity: sbom.SeverityHigh},
+			Expectation: Expectation{
+				Report: "vulnerability policy: found 1 vulnerabilities in the workspace image\n  CVE-2023-0286 (high): openssl 3.0.2\n",
+				Error:  true,
+			},
+		},
+		{
+			Name:   "no findings",
+			Policy: sbomPolicy{DB: db, Action: config.SBOMPolicyActionFail, MinSeverity: sbom.SeverityCritical},
+			Expectation: Expectation{
+				Report: "vulnerability policy: none of the 2 packages of the workspace image are vulnerable\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			report, err := test.Policy.evaluate(doc)
+			act := Expectation{Report: report, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("evaluate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSBOMPolicyCheck(t *testing.T) {
+	type Expectation struct {
+		Report string
+		Error  bool
+	}
+	tests := []struct {
+		Name        string
+		Action      config.SBOMPolicyAction
+		Resolver    remotes.Resolver
+		Expectation Expectation
+	}{
+		{
+			Name:        "warn without SBOM",
+			Action:      config.SBOMPolicyActionWarn,
+			Resolver:    &fakeResolver{},
+			Expectation: Expectation{Report: "vulnerability policy: workspace image has no SBOM - skipping\n"},
+		},
+		{
+			Name:        "fail without SBOM",
+			Action:      config.SBOMPolicyActionFail,
+			Resolver:    &fakeResolver{},
+			Expectation: Expectation{Report: "vulnerability policy: workspace image has no SBOM\n", Error: true},
+		},
+		{
+			Name:        "warn on download error",
+			Action:      config.SBOMPolicyActionWarn,
+			Resolver:    &fakeResolver{Err: xerrors.Errorf("unavailable")},
+			Expectation: Expectation{Report: "vulnerability policy: cannot download workspace image SBOM - skipping: cannot resolve workspace-image: unavailable\n"},
+		},
+		{
+			Name:        "fail on download error",
+			Action:      config.SBOMPolicyActionFail,
+			Resolver:    &fakeResolver{Err: xerrors.Errorf("unavailable")},
+			Expectation: Expectation{Report: "vulnerability policy: cannot download workspace image SBOM: cannot resolve workspace-image: unavailable\n", Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			p := &sbomPolicy{DB: &sbom.VulnerabilityDB{}, Action: test.Action}
+			report, err := p.check(context.Background(), test.Resolver, "workspace-image")
+			act := Expectation{Report: report, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("check() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnverifiedImageRef(t *testing.T) {
+	act, err := unverifiedImageRef("registry.example.com/workspace-images:3f8a0c2d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "registry.example.com/workspace-images:3f8a0c2d-unverified"; act != exp {
+		t.Errorf("unexpected ref: %s, expected %s", act, exp)
+	}
+
+	_, err = unverifiedImageRef("registry.example.com/workspace-images")
+	if err == nil {
+		t.Errorf("expected an error for a ref without a tag")
+	}
+}
+
+func TestPromoteImage(t *testing.T) {
+	reg := newFakeOCIRegistry()
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := testImageRepository(srv, u.Host+"/workspace-images")
+
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`)
+	desc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageIndex,
+		Digest:    digest.FromBytes(manifest),
+		Size:      int64(len(manifest)),
+	}
+	reg.Manifests[desc.Digest] = manifest
+	reg.Tags["latest-unverified"] = desc.Digest
+
+	err = promoteImage(context.Background(), repo.resolver(), repo.Name+"@"+desc.Digest.String(), desc, repo.ref("latest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act := reg.Tags["latest"]; act != desc.Digest {
+		t.Errorf("promoted tag points to %s, expected %s", act, desc.Digest)
+	}
+}
+
+// fakeResolver resolves every ref to a single-platform image manifest, which never carries an SBOM
+type fakeResolver struct {
+	Err error
+}
+
+func (r *fakeResolver) Resolve(ctx context.Context, ref string) (name string, desc ociv1.Descriptor, err error) {
+	if r.Err != nil {
+		return "", ociv1.Descriptor{}, r.Err
+	}
+	return ref, ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest}, nil
+}
+
+func (r *fakeResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	// image manifests are never fetched when looking for attestations
+	return nil, nil
+}
+
+func (r *fakeResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return nil, xerrors.Errorf("not implemented")
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
 	dockerremote "github.com/containerd/containerd/remotes/docker"
 	"github.com/distribution/reference"
@@ -27,6 +28,14 @@ import (
 	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
+const (
+	// defaultPlatform is the platform images are resolved for unless asked otherwise
+	defaultPlatform = "linux/amd64"
+
+	// maxConfigSize limits how much we read when downloading an image config
+	maxConfigSize = 4 << 20
+)
+
 var (
 	// ErrNotFound is returned when the reference was not found
 	ErrNotFound = xerrors.Errorf("not found")
@@ -63,25 +72,7 @@ func (sr *StandaloneRefResolver) Resolve(ctx context.Context, ref string, opts .
 
 	var r remotes.Resolver
 	if sr.ResolverFactory == nil {
-		registryOpts := []dockerremote.RegistryOpt{
-			dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(func(host string) (username, password string, err error) {
-				if options.Auth == nil {
-					return
-				}
-
-				return options.Auth.Username, options.Auth.Password, nil
-			}))),
-		}
-
-		if options.Client != nil {
-			registryOpts = append(registryOpts, dockerremote.WithClient(options.Client))
-		}
-
-		r = dockerremote.NewResolver(dockerremote.ResolverOptions{
-			Hosts: dockerremote.ConfigureDefaultRegistries(
-				registryOpts...,
-			),
-		})
+		r = NewRegistryResolver(opts...)
 	} else {
 		r = sr.ResolverFactory()
 	}
@@ -127,7 +118,26 @@ func (sr *StandaloneRefResolver) Resolve(ctx context.Context, ref string, opts .
 		return "", fmt.Errorf("cannot unmarshal manifest: %w", err)
 	}
 
+	pltfs := []string{defaultPlatform}
+	if len(options.Platforms) > 0 {
+		pltfs = options.Platforms
+	}
+
 	if mf.Config.Size != 0 {
+		if len(options.Platforms) > 0 {
+			// a single-platform image is only good for the platform it was built for
+			pltf, err := fetchImagePlatform(ctx, fetcher, mf.Config)
+			if err != nil {
+				return "", err
+			}
+			available, _, err := availablePlatforms(pltfs, []ociv1.Descriptor{{Digest: desc.Digest, Platform: pltf}})
+			if err != nil {
+				return "", err
+			}
+			if options.ResolvedPlatforms != nil {
+				*options.ResolvedPlatforms = available
+			}
+		}
 		pref, err = reference.WithDigest(pref, desc.Digest)
 		if err != nil {
 			return
@@ -141,18 +151,16 @@ func (sr *StandaloneRefResolver) Resolve(ctx context.Context, ref string, opts .
 		return
 	}
 
-	var dgst digest.Digest
-	for _, mf := range mfl.Manifests {
-		if mf.Platform == nil {
-			continue
-		}
-		if fmt.Sprintf("%s-%s", mf.Platform.OS, mf.Platform.Architecture) == "linux-amd64" {
-			dgst = mf.Digest
-			break
-		}
+	available, dgst, err := availablePlatforms(pltfs, mfl.Manifests)
+	if err != nil {
+		return "", err
+	}
+	if len(available) > 1 {
+		// a multi-platform image is referenced by its index, so that each node can pick its own platform
+		dgst = desc.Digest
 	}
-	if dgst == "" {
-		return "", fmt.Errorf("no manifest for platform linux-amd64 found")
+	if options.ResolvedPlatforms != nil && len(options.Platforms) > 0 {
+		*options.ResolvedPlatforms = available
 	}
 
 	pref, err = reference.WithDigest(pref, dgst)
@@ -162,9 +170,103 @@ func (sr *StandaloneRefResolver) Resolve(ctx context.Context, ref string, opts .
 	return pref.String(), nil
 }
 
+// NewRegistryResolver produces a containerd resolver which talks to Docker registries using the authentication
+// and HTTP client of the options.
+func NewRegistryResolver(opts ...DockerRefResolverOption) remotes.Resolver {
+	options := getOptions(opts)
+
+	registryOpts := []dockerremote.RegistryOpt{
+		dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(func(host string) (username, password string, err error) {
+			if options.Auth == nil {
+				return
+			}
+
+			return options.Auth.Username, options.Auth.Password, nil
+		}))),
+	}
+
+	if options.Client != nil {
+		registryOpts = append(registryOpts, dockerremote.WithClient(options.Client))
+	}
+
+	return dockerremote.NewResolver(dockerremote.ResolverOptions{
+		Hosts: dockerremote.ConfigureDefaultRegistries(
+			registryOpts...,
+		),
+	})
+}
+
+// availablePlatforms returns the platforms for which one of the manifests exists, together with the digest
+// of the manifest of the first available platform. Images often don't exist for all platforms we'd like
+// to build for, hence only if none of the platforms is available, we fail.
+func availablePlatforms(pltfs []string, manifests []ociv1.Descriptor) (available []string, dgst digest.Digest, err error) {
+	for _, p := range pltfs {
+		pltf, err := platforms.Parse(p)
+		if err != nil {
+			return nil, "", xerrors.Errorf("invalid platform %s: %w", p, err)
+		}
+		for _, mf := range manifests {
+			if !matchesPlatform(mf, pltf) {
+				continue
+			}
+			available = append(available, p)
+			if dgst == "" {
+				dgst = mf.Digest
+			}
+			break
+		}
+	}
+	if len(available) == 0 {
+		names := make([]string, 0, len(pltfs))
+		for _, p := range pltfs {
+			names = append(names, strings.ReplaceAll(p, "/", "-"))
+		}
+		return nil, "", fmt.Errorf("no manifest for platform %s found", strings.Join(names, ", "))
+	}
+	return available, dgst, nil
+}
+
+// fetchImagePlatform returns the platform an image was built for according to its config.
+// Images which don't say are assumed to be built for the default platform.
+func fetchImagePlatform(ctx context.Context, fetcher remotes.Fetcher, config ociv1.Descriptor) (*ociv1.Platform, error) {
+	in, err := fetcher.Fetch(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	var cfg ociv1.Image
+	err = json.NewDecoder(io.LimitReader(in, maxConfigSize)).Decode(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal image config: %w", err)
+	}
+	if cfg.OS == "" && cfg.Architecture == "" {
+		pltf, err := platforms.Parse(defaultPlatform)
+		if err != nil {
+			return nil, err
+		}
+		return &pltf, nil
+	}
+	return &ociv1.Platform{OS: cfg.OS, Architecture: cfg.Architecture, Variant: cfg.Variant}, nil
+}
+
+// matchesPlatform returns true if the manifest is for the given platform. Variants are only compared if the platform has one.
+func matchesPlatform(mf ociv1.Descriptor, pltf ociv1.Platform) bool {
+	if mf.Platform == nil {
+		return false
+	}
+	mfp := platforms.Normalize(*mf.Platform)
+	if mfp.OS != pltf.OS || mfp.Architecture != pltf.Architecture {
+		return false
+	}
+	return pltf.Variant == "" || mfp.Variant == pltf.Variant
+}
+
 type opts struct {
-	Auth   *auth.Authentication
-	Client *http.Client
+	Auth              *auth.Authentication
+	Client            *http.Client
+	Platforms         []string
+	ResolvedPlatforms *[]string
 }
 
 // DockerRefResolverOption configures reference resolution
@@ -191,6 +293,23 @@ func WithHttpClient(client *http.Client) DockerRefResolverOption {
 	}
 }
 
+// WithPlatforms sets the platforms we'd like the image to support. The image must support at least one of them.
+// A single available platform resolves to the manifest of that platform, multiple available platforms resolve
+// to the image index. Defaults to linux/amd64.
+func WithPlatforms(pltfs []string) DockerRefResolverOption {
+	return func(o *opts) {
+		o.Platforms = pltfs
+	}
+}
+
+// WithResolvedPlatforms stores the platforms set using WithPlatforms which the resolved image supports in pltfs.
+// Without any platforms set, pltfs is left untouched.
+func WithResolvedPlatforms(pltfs *[]string) DockerRefResolverOption {
+	return func(o *opts) {
+		o.ResolvedPlatforms = pltfs
+	}
+}
+
 func getOptions(o []DockerRefResolverOption) *opts {
 	var res opts
 	for _, opt := range o {
@@ -28,6 +28,7 @@ import (
 func TestStandaloneRefResolverResolve(t *testing.T) {
 	type Expectation struct {
 		Ref          string
+		Platforms    []string
 		Error        string
 		ErrorMatcher func(error) bool
 	}
@@ -36,6 +37,7 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 		ResolvedRef   string
 		NormalisedRef string
 		MF            *ociv1.Manifest
+		Config        *ociv1.Image
 		Index         *ociv1.Index
 	}
 	tests := []struct {
@@ -43,6 +45,7 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 		ResolveResponse ResolveResponse
 		Expectation     Expectation
 		Ref             string
+		Platforms       []string
 	}{
 		{
 			Name: "basic resolve",
@@ -98,6 +101,136 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 			},
 			Expectation: Expectation{Ref: "docker.io/library/alpine@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
 		},
+		{
+			Name:      "single platform from index",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				Index: &ociv1.Index{
+					Manifests: []ociv1.Descriptor{
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString(""),
+							Platform: &ociv1.Platform{
+								Architecture: "amd64",
+								OS:           "linux",
+							},
+						},
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString("arm64"),
+							Platform: &ociv1.Platform{
+								Architecture: "arm64",
+								OS:           "linux",
+								Variant:      "v8",
+							},
+						},
+					},
+				},
+			},
+			Expectation: Expectation{
+				Ref:       "docker.io/library/alpine@sha256:f69162950f235e3cdbbad33f1f912d1a504be90d8a37d002c735d6f3e3882265",
+				Platforms: []string{"linux/arm64"},
+			},
+		},
+		{
+			Name:      "multiple platforms",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				Index: &ociv1.Index{
+					Manifests: []ociv1.Descriptor{
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString(""),
+							Platform: &ociv1.Platform{
+								Architecture: "amd64",
+								OS:           "linux",
+							},
+						},
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString("arm64"),
+							Platform: &ociv1.Platform{
+								Architecture: "arm64",
+								OS:           "linux",
+								Variant:      "v8",
+							},
+						},
+					},
+				},
+			},
+			Expectation: Expectation{
+				Ref:       "docker.io/library/alpine@sha256:cf8a6667dfe10b07f1a8a92e8c5be31ff80834b998a575229445d5c2e7c966b7",
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+		},
+		{
+			Name:      "multiple platforms with missing platform",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				Index: &ociv1.Index{
+					Manifests: []ociv1.Descriptor{
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString(""),
+							Platform: &ociv1.Platform{
+								Architecture: "amd64",
+								OS:           "linux",
+							},
+						},
+					},
+				},
+			},
+			Expectation: Expectation{
+				Ref:       "docker.io/library/alpine@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				Platforms: []string{"linux/amd64"},
+			},
+		},
+		{
+			Name:      "multiple platforms with no available platform",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				Index: &ociv1.Index{
+					Manifests: []ociv1.Descriptor{
+						{
+							MediaType: ociv1.MediaTypeImageManifest,
+							Digest:    digest.FromString(""),
+							Platform: &ociv1.Platform{
+								Architecture: "s390x",
+								OS:           "linux",
+							},
+						},
+					},
+				},
+			},
+			Expectation: Expectation{Error: "no manifest for platform linux-amd64, linux-arm64 found"},
+		},
+		{
+			Name:      "multiple platforms with single-platform image",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				MF:     &ociv1.Manifest{Config: ociv1.Descriptor{MediaType: ociv1.MediaTypeImageConfig, Size: 1}},
+				Config: &ociv1.Image{Platform: ociv1.Platform{OS: "linux", Architecture: "arm64"}},
+			},
+			Expectation: Expectation{
+				Ref:       "docker.io/library/alpine@sha256:5ed5fcbde4264b6de200e0e370a05437da6f6298e6ab8b5e269637ef5ca22bda",
+				Platforms: []string{"linux/arm64"},
+			},
+		},
+		{
+			Name:      "single-platform image of another platform",
+			Ref:       "docker.io/library/alpine:latest",
+			Platforms: []string{"linux/arm64"},
+			ResolveResponse: ResolveResponse{
+				MF:     &ociv1.Manifest{Config: ociv1.Descriptor{MediaType: ociv1.MediaTypeImageConfig, Size: 1}},
+				Config: &ociv1.Image{Platform: ociv1.Platform{OS: "linux", Architecture: "amd64"}},
+			},
+			Expectation: Expectation{Error: "no manifest for platform linux-arm64 found"},
+		},
 		{
 			Name: "not authorized",
 			Ref:  "registry-1.testing.gitpod-self-hosted.com:5000/gitpod/gitpod/workspace-full:latest",
@@ -167,11 +300,21 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 				if normalizedRef == "" {
 					normalizedRef = test.Ref
 				}
-				var respBytes []byte
+				var respBytes, configBytes []byte
 				switch {
 				case test.ResolveResponse.MF != nil:
+					mf := *test.ResolveResponse.MF
+					if test.ResolveResponse.Config != nil {
+						var err error
+						configBytes, err = json.Marshal(test.ResolveResponse.Config)
+						if err != nil {
+							t.Fatal(err)
+						}
+						mf.Config.Digest = digest.FromBytes(configBytes)
+						mf.Config.Size = int64(len(configBytes))
+					}
 					var err error
-					respBytes, err = json.Marshal(test.ResolveResponse.MF)
+					respBytes, err = json.Marshal(mf)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -193,7 +336,12 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 						Size:      int64(len(respBytes)),
 					}, nil)
 					fetcher := NewMockFetcher(ctrl)
-					fetcher.EXPECT().Fetch(gomock.Any(), gomock.Any()).AnyTimes().Return(io.NopCloser(bytes.NewReader(respBytes)), nil).AnyTimes()
+					fetcher.EXPECT().Fetch(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error) {
+						if configBytes != nil && desc.Digest == digest.FromBytes(configBytes) {
+							return io.NopCloser(bytes.NewReader(configBytes)), nil
+						}
+						return io.NopCloser(bytes.NewReader(respBytes)), nil
+					})
 					resolver.EXPECT().Fetcher(gomock.Any(), gomock.Any()).AnyTimes().Return(fetcher, nil)
 				}
 
@@ -201,8 +349,9 @@ func TestStandaloneRefResolverResolve(t *testing.T) {
 			}
 
 			sr := &resolve.StandaloneRefResolver{ResolverFactory: factory}
-			ref, err := sr.Resolve(context.Background(), test.Ref)
-			act := Expectation{Ref: ref}
+			var pltfs []string
+			ref, err := sr.Resolve(context.Background(), test.Ref, resolve.WithPlatforms(test.Platforms), resolve.WithResolvedPlatforms(&pltfs))
+			act := Expectation{Ref: ref, Platforms: pltfs}
 			if err != nil {
 				act.Error = err.Error()
 			}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+// ErrNoProvenance is returned when an image carries no provenance attestation
+var ErrNoProvenance = xerrors.Errorf("image has no provenance")
+
+// Provenance is the part of a SLSA provenance we're interested in
+type Provenance struct {
+	// Materials are the images and other sources the image was built from
+	Materials []Material
+	// BuildFinishedOn is the time the image was built
+	BuildFinishedOn time.Time
+}
+
+// Material is a source an image was built from
+type Material struct {
+	// URI is the package URL of the material, e.g. pkg:docker/gitpod/workspace-full@latest?platform=linux%2Famd64
+	URI    string
+	Digest digest.Digest
+}
+
+// ImageRef returns the tagged image ref of a material, e.g. gitpod/workspace-full:latest.
+// ok is false if the material is no image or if it was referenced by digest and hence cannot change.
+func (m Material) ImageRef() (ref string, ok bool) {
+	if !strings.HasPrefix(m.URI, "pkg:docker/") {
+		return "", false
+	}
+	purl, _, _ := strings.Cut(strings.TrimPrefix(m.URI, "pkg:docker/"), "?")
+	idx := strings.LastIndex(purl, "@")
+	if idx < 0 {
+		return "", false
+	}
+	name, err := url.PathUnescape(purl[:idx])
+	if err != nil {
+		return "", false
+	}
+	version, err := url.PathUnescape(purl[idx+1:])
+	if err != nil || version == "" {
+		return "", false
+	}
+	if _, err := digest.Parse(version); err == nil {
+		return "", false
+	}
+
+	return name + ":" + version, true
+}
+
+// FetchProvenance downloads the SLSA provenance buildkit attached to an image as attestation.
+// Multi-platform images carry one provenance per platform, in which case the materials of all platforms
+// and the earliest build time are returned.
+func FetchProvenance(ctx context.Context, resolver remotes.Resolver, ref string) (*Provenance, error) {
+	var res Provenance
+	found, err := fetchAttestations(ctx, resolver, ref, predicateTypeProvenance, func(fetcher remotes.Fetcher, layer ociv1.Descriptor) error {
+		var stmt provenanceStatement
+		err := fetchJSON(ctx, fetcher, layer, &stmt)
+		if err != nil {
+			return xerrors.Errorf("cannot download provenance: %w", err)
+		}
+
+		for _, m := range stmt.Predicate.Materials {
+			mat := Material{URI: m.URI}
+			if dgst, ok := m.Digest[string(digest.SHA256)]; ok {
+				mat.Digest = digest.NewDigestFromEncoded(digest.SHA256, dgst)
+			}
+			res.Materials = append(res.Materials, mat)
+		}
+		if t := stmt.Predicate.Metadata.BuildFinishedOn; t != nil && (res.BuildFinishedOn.IsZero() || t.Before(res.BuildFinishedOn)) {
+			res.BuildFinishedOn = *t
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNoProvenance
+	}
+
+	return &res, nil
+}
+
+// provenanceStatement is an in-toto statement with a SLSA v0.2 provenance as predicate
+type provenanceStatement struct {
+	Predicate struct {
+		Materials []struct {
+			URI    string            `json:"uri"`
+			Digest map[string]string `json:"digest"`
+		} `json:"materials"`
+		Metadata struct {
+			BuildFinishedOn *time.Time `json:"buildFinishedOn"`
+		} `json:"metadata"`
+	} `json:"predicate"`
+}
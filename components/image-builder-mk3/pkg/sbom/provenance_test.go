@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+const provenanceStatementFixture = `{
+	"_type": "https://in-toto.io/Statement/v0.1",
+	"predicateType": "https://slsa.dev/provenance/v0.2",
+	"predicate": {
+		"builder": {"id": ""},
+		"buildType": "https://mobyproject.org/buildkit@v1",
+		"materials": [
+			{
+				"uri": "pkg:docker/gitpod/workspace-full@latest?platform=linux%2Famd64",
+				"digest": {"sha256": "9f8d4bc6b3e1d0a4a3f9e3e0c7e2fbb2d1f0b1b4a7c1e1b2d3c4e5f60718293a"}
+			}
+		],
+		"metadata": {
+			"buildStartedOn": "2026-09-01T10:00:00Z",
+			"buildFinishedOn": "2026-09-01T10:05:00Z"
+		}
+	}
+}`
+
+func TestFetchProvenance(t *testing.T) {
+	var (
+		imageMF    = []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json"}`)
+		imageDgst  = digest.FromBytes(imageMF)
+		stmt       = []byte(provenanceStatementFixture)
+		stmtDgst   = digest.FromBytes(stmt)
+		attestMF   = mustMarshal(t, ociv1.Manifest{Layers: []ociv1.Descriptor{{MediaType: "application/vnd.in-toto+json", Digest: stmtDgst, Size: int64(len(stmt)), Annotations: map[string]string{annotationPredicateType: predicateTypeProvenance}}}})
+		attestDgst = digest.FromBytes(attestMF)
+		imageDesc  = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: imageDgst, Size: int64(len(imageMF)), Platform: &ociv1.Platform{OS: "linux", Architecture: "amd64"}}
+		attestDesc = ociv1.Descriptor{
+			MediaType: ociv1.MediaTypeImageManifest,
+			Digest:    attestDgst,
+			Size:      int64(len(attestMF)),
+			Platform:  &ociv1.Platform{OS: "unknown", Architecture: "unknown"},
+			Annotations: map[string]string{
+				annotationReferenceType:   referenceTypeAttestation,
+				annotationReferenceDigest: imageDgst.String(),
+			},
+		}
+	)
+
+	tests := []struct {
+		Name        string
+		Index       *ociv1.Index
+		Expectation *Provenance
+		Error       error
+	}{
+		{
+			Name:  "index with provenance",
+			Index: &ociv1.Index{Manifests: []ociv1.Descriptor{imageDesc, attestDesc}},
+			Expectation: &Provenance{
+				Materials: []Material{
+					{
+						URI:    "pkg:docker/gitpod/workspace-full@latest?platform=linux%2Famd64",
+						Digest: digest.Digest("sha256:9f8d4bc6b3e1d0a4a3f9e3e0c7e2fbb2d1f0b1b4a7c1e1b2d3c4e5f60718293a"),
+					},
+				},
+				BuildFinishedOn: time.Date(2026, 9, 1, 10, 5, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name:  "index without provenance",
+			Index: &ociv1.Index{Manifests: []ociv1.Descriptor{imageDesc}},
+			Error: ErrNoProvenance,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			idx := mustMarshal(t, test.Index)
+			desc := ociv1.Descriptor{MediaType: ociv1.MediaTypeImageIndex, Digest: digest.FromBytes(idx), Size: int64(len(idx))}
+			content := map[string][]byte{
+				imageDgst.Encoded():      imageMF,
+				attestDgst.Encoded():     attestMF,
+				stmtDgst.Encoded():       stmt,
+				desc.Digest.Encoded():    idx,
+				"workspace-image:latest": mustMarshal(t, desc),
+			}
+
+			act, err := FetchProvenance(context.Background(), &fakeResolver{Content: content}, "workspace-image:latest")
+			if !xerrors.Is(err, test.Error) {
+				t.Fatalf("unexpected error: expected %v, got %v", test.Error, err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("FetchProvenance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMaterialImageRef(t *testing.T) {
+	tests := []struct {
+		URI string
+		Ref string
+		OK  bool
+	}{
+		{URI: "pkg:docker/gitpod/workspace-full@latest?platform=linux%2Famd64", Ref: "gitpod/workspace-full:latest", OK: true},
+		{URI: "pkg:docker/ubuntu@22.04", Ref: "ubuntu:22.04", OK: true},
+		{URI: "pkg:docker/eu.gcr.io/gitpod-core-dev/build/base@commit-1234?platform=linux%2Farm64", Ref: "eu.gcr.io/gitpod-core-dev/build/base:commit-1234", OK: true},
+		{URI: "pkg:docker/ubuntu@sha256%3A9f8d4bc6b3e1d0a4a3f9e3e0c7e2fbb2d1f0b1b4a7c1e1b2d3c4e5f60718293a"},
+		{URI: "https://github.com/gitpod-io/gitpod.git#main"},
+		{URI: "pkg:docker/ubuntu"},
+	}
+	for _, test := range tests {
+		t.Run(test.URI, func(t *testing.T) {
+			ref, ok := Material{URI: test.URI}.ImageRef()
+			if ref != test.Ref || ok != test.OK {
+				t.Errorf("ImageRef() = %q, %v; expected %q, %v", ref, ok, test.Ref, test.OK)
+			}
+		})
+	}
+}
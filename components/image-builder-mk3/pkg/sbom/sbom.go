@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// buildkit marks the manifests of an index which carry attestations with these annotations
+	annotationReferenceType   = "vnd.docker.reference.type"
+	annotationReferenceDigest = "vnd.docker.reference.digest"
+	referenceTypeAttestation  = "attestation-manifest"
+
+	// annotationPredicateType is the predicate type of the in-toto statement stored in an attestation layer
+	annotationPredicateType = "in-toto.io/predicate-type"
+	predicateTypeSPDX       = "https://spdx.dev/Document"
+	predicateTypeProvenance = "https://slsa.dev/provenance/v0.2"
+
+	// maxAttestationSize limits how much we read from a registry blob
+	maxAttestationSize = 64 << 20
+)
+
+// ErrNoSBOM is returned when an image carries no SBOM attestation
+var ErrNoSBOM = xerrors.Errorf("image has no SBOM")
+
+// Document is the part of an SBOM we're interested in
+type Document struct {
+	Packages []Package
+}
+
+// Package is a piece of software listed in an SBOM
+type Package struct {
+	Name    string
+	Version string
+	// PURL is the package URL, e.g. pkg:deb/ubuntu/openssl@3.0.2-0ubuntu1.10?arch=amd64
+	PURL string
+}
+
+// Type returns the type of the package URL, e.g. deb or npm
+func (p Package) Type() string {
+	if !strings.HasPrefix(p.PURL, "pkg:") {
+		return ""
+	}
+	tpe, _, _ := strings.Cut(strings.TrimPrefix(p.PURL, "pkg:"), "/")
+	return tpe
+}
+
+// Fetch downloads the SPDX SBOM buildkit attached to an image as attestation.
+// Multi-platform images carry one SBOM per platform, in which case the packages of all platforms are returned.
+func Fetch(ctx context.Context, resolver remotes.Resolver, ref string) (*Document, error) {
+	var res Document
+	found, err := fetchAttestations(ctx, resolver, ref, predicateTypeSPDX, func(fetcher remotes.Fetcher, layer ociv1.Descriptor) error {
+		var stmt spdxStatement
+		err := fetchJSON(ctx, fetcher, layer, &stmt)
+		if err != nil {
+			return xerrors.Errorf("cannot download SBOM: %w", err)
+		}
+		res.Packages = append(res.Packages, stmt.packages()...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNoSBOM
+	}
+
+	return &res, nil
+}
+
+// fetchAttestations calls fn for every in-toto statement of the given predicate type buildkit attached to an image.
+// found is false if the image carries no such statement.
+func fetchAttestations(ctx context.Context, resolver remotes.Resolver, ref, predicateType string, fn func(fetcher remotes.Fetcher, layer ociv1.Descriptor) error) (found bool, err error) {
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return false, xerrors.Errorf("cannot resolve %s: %w", ref, err)
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	switch desc.MediaType {
+	case images.MediaTypeDockerSchema2ManifestList, ociv1.MediaTypeImageIndex:
+	default:
+		// buildkit stores attestations next to the image manifest in an index
+		return false, nil
+	}
+
+	var idx ociv1.Index
+	err = fetchJSON(ctx, fetcher, desc, &idx)
+	if err != nil {
+		return false, xerrors.Errorf("cannot download index: %w", err)
+	}
+
+	for _, mfdesc := range idx.Manifests {
+		if mfdesc.Annotations[annotationReferenceType] != referenceTypeAttestation {
+			continue
+		}
+
+		var mf ociv1.Manifest
+		err = fetchJSON(ctx, fetcher, mfdesc, &mf)
+		if err != nil {
+			return false, xerrors.Errorf("cannot download attestation manifest for %s: %w", mfdesc.Annotations[annotationReferenceDigest], err)
+		}
+		for _, layer := range mf.Layers {
+			if layer.Annotations[annotationPredicateType] != predicateType {
+				continue
+			}
+
+			err = fn(fetcher, layer)
+			if err != nil {
+				return false, err
+			}
+			found = true
+		}
+	}
+	return found, nil
+}
+
+func fetchJSON(ctx context.Context, fetcher remotes.Fetcher, desc ociv1.Descriptor, dst interface{}) error {
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return json.NewDecoder(io.LimitReader(rc, maxAttestationSize)).Decode(dst)
+}
+
+// spdxStatement is an in-toto statement with an SPDX document as predicate
+type spdxStatement struct {
+	Predicate struct {
+		Packages []struct {
+			Name         string `json:"name"`
+			VersionInfo  string `json:"versionInfo"`
+			ExternalRefs []struct {
+				ReferenceType    string `json:"referenceType"`
+				ReferenceLocator string `json:"referenceLocator"`
+			} `json:"externalRefs"`
+		} `json:"packages"`
+	} `json:"predicate"`
+}
+
+func (s spdxStatement) packages() []Package {
+	res := make([]Package, 0, len(s.Predicate.Packages))
+	for _, p := range s.Predicate.Packages {
+		pkg := Package{
+			Name:    p.Name,
+			Version: p.VersionInfo,
+		}
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				pkg.PURL = ref.ReferenceLocator
+				break
+			}
+		}
+		res = append(res, pkg)
+	}
+	return res
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/remotes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+const spdxStatementFixture = `{
+	"_type": "https://in-toto.io/Statement/v0.1",
+	"predicateType": "https://spdx.dev/Document",
+	"predicate": {
+		"spdxVersion": "SPDX-2.3",
+		"packages": [
+			{
+				"name": "openssl",
+				"versionInfo": "3.0.2-0ubuntu1.10",
+				"externalRefs": [
+					{"referenceCategory": "SECURITY", "referenceType": "cpe23Type", "referenceLocator": "cpe:2.3:a:openssl:openssl:3.0.2:*:*:*:*:*:*:*"},
+					{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:deb/ubuntu/openssl@3.0.2-0ubuntu1.10?arch=amd64"}
+				]
+			},
+			{
+				"name": "lodash",
+				"versionInfo": "4.17.20",
+				"externalRefs": [
+					{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/lodash@4.17.20"}
+				]
+			}
+		]
+	}
+}`
+
+func TestFetch(t *testing.T) {
+	var (
+		imageMF    = []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json"}`)
+		imageDgst  = digest.FromBytes(imageMF)
+		stmt       = []byte(spdxStatementFixture)
+		stmtDgst   = digest.FromBytes(stmt)
+		attestMF   = mustMarshal(t, ociv1.Manifest{Layers: []ociv1.Descriptor{{MediaType: "application/vnd.in-toto+json", Digest: stmtDgst, Size: int64(len(stmt)), Annotations: map[string]string{annotationPredicateType: predicateTypeSPDX}}}})
+		attestDgst = digest.FromBytes(attestMF)
+		imageDesc  = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: imageDgst, Size: int64(len(imageMF)), Platform: &ociv1.Platform{OS: "linux", Architecture: "amd64"}}
+		attestDesc = ociv1.Descriptor{
+			MediaType: ociv1.MediaTypeImageManifest,
+			Digest:    attestDgst,
+			Size:      int64(len(attestMF)),
+			Platform:  &ociv1.Platform{OS: "unknown", Architecture: "unknown"},
+			Annotations: map[string]string{
+				annotationReferenceType:   referenceTypeAttestation,
+				annotationReferenceDigest: imageDgst.String(),
+			},
+		}
+	)
+
+	tests := []struct {
+		Name        string
+		Desc        ociv1.Descriptor
+		Index       *ociv1.Index
+		Expectation *Document
+		Error       error
+	}{
+		{
+			Name:  "index with SBOM",
+			Index: &ociv1.Index{Manifests: []ociv1.Descriptor{imageDesc, attestDesc}},
+			Expectation: &Document{Packages: []Package{
+				{Name: "openssl", Version: "3.0.2-0ubuntu1.10", PURL: "pkg:deb/ubuntu/openssl@3.0.2-0ubuntu1.10?arch=amd64"},
+				{Name: "lodash", Version: "4.17.20", PURL: "pkg:npm/lodash@4.17.20"},
+			}},
+		},
+		{
+			Name:  "index without SBOM",
+			Index: &ociv1.Index{Manifests: []ociv1.Descriptor{imageDesc}},
+			Error: ErrNoSBOM,
+		},
+		{
+			Name:  "single manifest",
+			Desc:  imageDesc,
+			Error: ErrNoSBOM,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			content := map[string][]byte{
+				imageDgst.Encoded():  imageMF,
+				attestDgst.Encoded(): attestMF,
+				stmtDgst.Encoded():   stmt,
+			}
+			desc := test.Desc
+			if test.Index != nil {
+				idx := mustMarshal(t, test.Index)
+				desc = ociv1.Descriptor{MediaType: ociv1.MediaTypeImageIndex, Digest: digest.FromBytes(idx), Size: int64(len(idx))}
+				content[desc.Digest.Encoded()] = idx
+			}
+			content["workspace-image:latest"] = mustMarshal(t, desc)
+
+			act, err := Fetch(context.Background(), &fakeResolver{Content: content}, "workspace-image:latest")
+			if !xerrors.Is(err, test.Error) {
+				t.Fatalf("unexpected error: expected %v, got %v", test.Error, err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("Fetch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	doc := &Document{Packages: []Package{
+		{Name: "openssl", Version: "3.0.2-0ubuntu1.10", PURL: "pkg:deb/ubuntu/openssl@3.0.2-0ubuntu1.10?arch=amd64"},
+		{Name: "lodash", Version: "4.17.20", PURL: "pkg:npm/lodash@4.17.20"},
+		{Name: "minimist", Version: "1.2.5", PURL: "pkg:npm/minimist@1.2.5"},
+	}}
+	db := &VulnerabilityDB{Vulnerabilities: []Vulnerability{
+		{ID: "CVE-2023-0286", Package: "openssl", Type: "deb", Versions: []string{"3.0.2-0ubuntu1.10"}, Severity: "high"},
+		{ID: "CVE-2021-23337", Package: "lodash", Type: "npm", Versions: []string{"4.17.20"}, Severity: "critical"},
+		{ID: "CVE-2021-44906", Package: "minimist", Versions: []string{"1.2.5"}, Severity: "low"},
+		{ID: "CVE-2024-0001", Package: "lodash", Type: "deb", Versions: []string{"4.17.20"}, Severity: "critical"},
+		{ID: "CVE-2024-0002", Package: "openssl", Versions: []string{"3.0.2-0ubuntu1.11"}, Severity: "critical"},
+	}}
+
+	tests := []struct {
+		Name        string
+		MinSeverity Severity
+		Expectation []string
+	}{
+		{Name: "all", MinSeverity: SeverityUnknown, Expectation: []string{"CVE-2021-23337", "CVE-2023-0286", "CVE-2021-44906"}},
+		{Name: "high", MinSeverity: SeverityHigh, Expectation: []string{"CVE-2021-23337", "CVE-2023-0286"}},
+		{Name: "critical", MinSeverity: SeverityCritical, Expectation: []string{"CVE-2021-23337"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act []string
+			for _, f := range db.Match(doc, test.MinSeverity) {
+				act = append(act, f.Vulnerability.ID)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("Match() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadVulnerabilityDB(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Content string
+		Error   bool
+	}{
+		{Name: "valid", Content: `{"vulnerabilities":[{"id":"CVE-2023-0286","package":"openssl","versions":["3.0.2"],"severity":"High"}]}`},
+		{Name: "invalid severity", Content: `{"vulnerabilities":[{"id":"CVE-2023-0286","package":"openssl","versions":["3.0.2"],"severity":"urgent"}]}`, Error: true},
+		{Name: "invalid JSON", Content: `[`, Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fn := filepath.Join(t.TempDir(), "vulnerabilities.json")
+			err := os.WriteFile(fn, []byte(test.Content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = LoadVulnerabilityDB(fn)
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	res, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+type fakeResolver struct {
+	Content map[string][]byte
+}
+
+func (f *fakeResolver) Resolve(ctx context.Context, ref string) (name string, desc ociv1.Descriptor, err error) {
+	name = ref
+	c, ok := f.Content[ref]
+	if !ok {
+		err = xerrors.Errorf("not found")
+		return
+	}
+
+	err = json.Unmarshal(c, &desc)
+	return
+}
+
+func (f *fakeResolver) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return nil, xerrors.Errorf("not implemented")
+}
+
+func (f *fakeResolver) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return f, nil
+}
+
+func (f *fakeResolver) Fetch(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error) {
+	c, ok := f.Content[desc.Digest.Encoded()]
+	if !ok {
+		return nil, xerrors.Errorf("not found")
+	}
+	return io.NopCloser(bytes.NewReader(c)), nil
+}
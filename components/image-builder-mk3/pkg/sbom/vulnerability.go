@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Severity ranks vulnerabilities
+type Severity int
+
+const (
+	SeverityUnknown Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+var severities = map[string]Severity{
+	"low":      SeverityLow,
+	"medium":   SeverityMedium,
+	"high":     SeverityHigh,
+	"critical": SeverityCritical,
+}
+
+// ParseSeverity parses a severity name, e.g. high. An empty name parses to SeverityUnknown.
+func ParseSeverity(s string) (Severity, error) {
+	if s == "" {
+		return SeverityUnknown, nil
+	}
+	res, ok := severities[strings.ToLower(s)]
+	if !ok {
+		return SeverityUnknown, xerrors.Errorf("unknown severity %q", s)
+	}
+	return res, nil
+}
+
+// Vulnerability is an entry of the vulnerability database
+type Vulnerability struct {
+	ID string `json:"id"`
+	// Package is the name of the affected package
+	Package string `json:"package"`
+	// Type optionally restricts the entry to packages of a package URL type, e.g. deb or npm
+	Type string `json:"type,omitempty"`
+	// Versions lists the affected versions of the package
+	Versions []string `json:"versions"`
+	Severity string   `json:"severity"`
+}
+
+// VulnerabilityDB is an offline vulnerability database
+type VulnerabilityDB struct {
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// LoadVulnerabilityDB reads a vulnerability database from a JSON file
+func LoadVulnerabilityDB(fn string) (*VulnerabilityDB, error) {
+	fc, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	var res VulnerabilityDB
+	err = json.Unmarshal(fc, &res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal vulnerability database: %w", err)
+	}
+	for _, v := range res.Vulnerabilities {
+		_, err = ParseSeverity(v.Severity)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid vulnerability %s: %w", v.ID, err)
+		}
+	}
+	return &res, nil
+}
+
+// Finding is a vulnerable package of an SBOM
+type Finding struct {
+	Vulnerability Vulnerability
+	Package       Package
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s (%s): %s %s", f.Vulnerability.ID, f.Vulnerability.Severity, f.Package.Name, f.Package.Version)
+}
+
+// Match returns the findings of the SBOM with at least the given severity, ordered by descending severity
+func (db *VulnerabilityDB) Match(doc *Document, minSeverity Severity) []Finding {
+	idx := make(map[string][]Vulnerability, len(db.Vulnerabilities))
+	for _, v := range db.Vulnerabilities {
+		sev, _ := ParseSeverity(v.Severity)
+		if sev < minSeverity {
+			continue
+		}
+		for _, version := range v.Versions {
+			key := v.Package + "@" + version
+			idx[key] = append(idx[key], v)
+		}
+	}
+
+	var res []Finding
+	for _, pkg := range doc.Packages {
+		for _, v := range idx[pkg.Name+"@"+pkg.Version] {
+			if v.Type != "" && v.Type != pkg.Type() {
+				continue
+			}
+			res = append(res, Finding{Vulnerability: v, Package: pkg})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		si, _ := ParseSeverity(res[i].Vulnerability.Severity)
+		sj, _ := ParseSeverity(res[j].Vulnerability.Severity)
+		return si > sj
+	})
+	return res
+}
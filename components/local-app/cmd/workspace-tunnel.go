@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	"github.com/gitpod-io/local-app/pkg/tunnel"
+	"github.com/spf13/cobra"
+)
+
+var workspaceTunnelOpts struct {
+	LocalPort    int
+	TunnelPort   int
+	SessionToken string
+}
+
+// workspaceTunnelCmd forwards a local port to a workspace port exposed as tcp or udp
+var workspaceTunnelCmd = &cobra.Command{
+	Use:   "tunnel <workspace-id> <port>",
+	Short: "Forwards a local port to a workspace port exposed with the tcp or udp protocol",
+	Args:  cobra.ExactArgs(2),
+	Example: `  # connect to a database running in the workspace
+  $ gitpod workspace tunnel <workspace-id> 5432
+  $ psql -h localhost -p 5432
+
+  # forward the workspace port to another local port
+  $ gitpod workspace tunnel <workspace-id> 5432 --local-port 15432
+
+  # connect to a port shared with your organization in a workspace of another member
+  $ gitpod workspace tunnel <workspace-id> 5432 --session-token <workspace-session-token>`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		workspaceID := args[0]
+		port, err := strconv.ParseUint(args[1], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port %s: %w", args[1], err)
+		}
+
+		gitpod, err := getGitpodClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+		defer cancel()
+
+		ws, err := gitpod.Workspaces.GetWorkspace(ctx, connect.NewRequest(&v1.GetWorkspaceRequest{WorkspaceId: workspaceID}))
+		if err != nil {
+			return err
+		}
+		status := ws.Msg.GetResult().GetStatus().GetInstance().GetStatus()
+		if status.GetPhase() != v1.WorkspaceInstanceStatus_PHASE_RUNNING {
+			return fmt.Errorf("workspace is not running")
+		}
+
+		var exposed *v1.Port
+		for _, p := range status.GetPorts() {
+			if p.GetPort() == port {
+				exposed = p
+				break
+			}
+		}
+		if exposed == nil {
+			return fmt.Errorf("port %d is not exposed", port)
+		}
+		if exposed.GetProtocol() != v1.PortProtocol_PORT_PROTOCOL_TCP && exposed.GetProtocol() != v1.PortProtocol_PORT_PROTOCOL_UDP {
+			return fmt.Errorf("port %d is not exposed with the tcp or udp protocol, use its URL instead: %s", port, exposed.GetUrl())
+		}
+		portURL, err := url.Parse(exposed.GetUrl())
+		if err != nil {
+			return fmt.Errorf("invalid port URL %s: %w", exposed.GetUrl(), err)
+		}
+
+		token := workspaceTunnelOpts.SessionToken
+		if token == "" {
+			ownerToken, err := gitpod.Workspaces.GetOwnerToken(ctx, connect.NewRequest(&v1.GetOwnerTokenRequest{WorkspaceId: workspaceID}))
+			if err != nil {
+				return err
+			}
+			token = ownerToken.Msg.Token
+		}
+
+		t := &tunnel.Tunnel{
+			Host:  portURL.Hostname(),
+			Addr:  net.JoinHostPort(portURL.Hostname(), strconv.Itoa(workspaceTunnelOpts.TunnelPort)),
+			Token: token,
+		}
+
+		localPort := workspaceTunnelOpts.LocalPort
+		if localPort == 0 {
+			localPort = int(port)
+		}
+		localAddr := net.JoinHostPort("localhost", strconv.Itoa(localPort))
+
+		if exposed.GetProtocol() == v1.PortProtocol_PORT_PROTOCOL_UDP {
+			pc, err := net.ListenPacket("udp", localAddr)
+			if err != nil {
+				return err
+			}
+			context.AfterFunc(cmd.Context(), func() { pc.Close() })
+			slog.Info("forwarding udp port", "local", pc.LocalAddr().String(), "workspace", portURL.Hostname())
+			return t.ServeUDP(cmd.Context(), pc)
+		}
+
+		l, err := net.Listen("tcp", localAddr)
+		if err != nil {
+			return err
+		}
+		context.AfterFunc(cmd.Context(), func() { l.Close() })
+		slog.Info("forwarding tcp port", "local", l.Addr().String(), "workspace", portURL.Hostname())
+		return t.ServeTCP(cmd.Context(), l)
+	},
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceTunnelCmd)
+	workspaceTunnelCmd.Flags().IntVarP(&workspaceTunnelOpts.LocalPort, "local-port", "l", 0, "Local port to listen on, defaults to the workspace port")
+	workspaceTunnelCmd.Flags().IntVar(&workspaceTunnelOpts.TunnelPort, "tunnel-port", tunnel.DefaultPort, "Port of the port tunnel of the Gitpod installation")
+	workspaceTunnelCmd.Flags().StringVar(&workspaceTunnelOpts.SessionToken, "session-token", "", "Workspace session token to access a port shared with your organization instead of using the owner token")
+}
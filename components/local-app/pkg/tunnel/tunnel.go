@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package tunnel is a client for the port tunnel of ws-proxy, which forwards workspace ports
+// that are exposed with the tcp or udp protocol.
+package tunnel
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+)
+
+const (
+	// DefaultPort is the port the port tunnel of ws-proxy listens on
+	DefaultPort = 9443
+
+	maxDatagramSize = 65507
+)
+
+// Tunnel forwards local connections to a workspace port.
+//
+// Every tunnel connection is a TLS connection which carries the host name of the workspace port as
+// server name. Right after the handshake the client sends its token, prefixed with its length
+// as 16 bit big-endian integer. UDP datagrams are prefixed with their length the same way.
+type Tunnel struct {
+	// Host is the host name of the workspace port, e.g. 5432-coral-dragon-ilr0r6eq.ws-eu10.gitpod.io
+	Host string
+	// Addr is the address of the port tunnel, usually the host name of the workspace port and DefaultPort
+	Addr string
+	// Token is the owner token, which grants access to private ports, or the workspace session token
+	// of an organization member, which grants access to ports shared with the organization
+	Token string
+
+	tlsConfig *tls.Config
+}
+
+// Dial opens a tunnel connection to the workspace port.
+func (t *Tunnel) Dial(ctx context.Context) (net.Conn, error) {
+	cfg := t.tlsConfig
+	if cfg == nil {
+		cfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	cfg = cfg.Clone()
+	cfg.ServerName = t.Host
+
+	dialer := tls.Dialer{Config: cfg}
+	conn, err := dialer.DialContext(ctx, "tcp", t.Addr)
+	if err != nil {
+		return nil, err
+	}
+	err = writeFrame(conn, []byte(t.Token))
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// ServeTCP forwards every connection accepted on l through its own tunnel connection until l is closed.
+func (t *Tunnel) ServeTCP(ctx context.Context, l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+
+			upstream, err := t.Dial(ctx)
+			if err != nil {
+				slog.Error("cannot connect to the port tunnel", "err", err)
+				return
+			}
+			defer upstream.Close()
+
+			var wg sync.WaitGroup
+			pipe := func(dst, src net.Conn) {
+				defer wg.Done()
+				_, _ = io.Copy(dst, src)
+				// propagate the end of the stream so that half-closed connections keep working
+				if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+					_ = cw.CloseWrite()
+				}
+			}
+			wg.Add(2)
+			go pipe(upstream, conn)
+			go pipe(conn, upstream)
+			wg.Wait()
+		}()
+	}
+}
+
+// ServeUDP forwards the datagrams received on pc through the tunnel until pc is closed.
+// Every local peer gets its own tunnel connection so that responses reach the right peer.
+func (t *Tunnel) ServeUDP(ctx context.Context, pc net.PacketConn) error {
+	var (
+		mu       sync.Mutex
+		sessions = make(map[string]net.Conn)
+	)
+	defer func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range sessions {
+			conn.Close()
+		}
+	}()
+
+	session := func(addr net.Addr) (net.Conn, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if conn, ok := sessions[addr.String()]; ok {
+			return conn, nil
+		}
+
+		conn, err := t.Dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sessions[addr.String()] = conn
+		go func() {
+			defer func() {
+				mu.Lock()
+				delete(sessions, addr.String())
+				mu.Unlock()
+				conn.Close()
+			}()
+
+			buf := make([]byte, maxDatagramSize)
+			for {
+				datagram, err := readFrame(conn, buf)
+				if err != nil {
+					return
+				}
+				_, err = pc.WriteTo(datagram, addr)
+				if err != nil {
+					return
+				}
+			}
+		}()
+		return conn, nil
+	}
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		conn, err := session(addr)
+		if err != nil {
+			slog.Error("cannot connect to the port tunnel", "err", err)
+			continue
+		}
+		err = writeFrame(conn, buf[:n])
+		if err != nil {
+			slog.Debug("cannot forward datagram", "err", err)
+			conn.Close()
+		}
+	}
+}
+
+// readFrame reads a length-prefixed frame from r into buf.
+func readFrame(r io.Reader, buf []byte) ([]byte, error) {
+	var size [2]byte
+	_, err := io.ReadFull(r, size[:])
+	if err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(size[:]))
+	if n > len(buf) {
+		return nil, fmt.Errorf("frame of %d bytes exceeds the maximum size", n)
+	}
+	_, err = io.ReadFull(r, buf[:n])
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// writeFrame writes a length-prefixed frame to w.
+func writeFrame(w io.Writer, frame []byte) error {
+	msg := make([]byte, 2+len(frame))
+	binary.BigEndian.PutUint16(msg, uint16(len(frame)))
+	copy(msg[2:], frame)
+	_, err := w.Write(msg)
+	return err
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package tunnel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+const testHost = "5432-amaranth-smelt-9ba20cc1.ws.test-domain.com"
+
+// startEchoTunnel starts a fake port tunnel which echoes frames (udp) or the stream (tcp)
+// once the client presented the expected owner token and server name.
+func startEchoTunnel(t *testing.T, udp bool) *Tunnel {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{testCertificate(t)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				tlsConn := conn.(*tls.Conn)
+				token, err := readFrame(tlsConn, make([]byte, 1024))
+				if err != nil || string(token) != "owner-token" || tlsConn.ConnectionState().ServerName != testHost {
+					return
+				}
+				if !udp {
+					_, _ = io.Copy(conn, conn)
+					return
+				}
+				buf := make([]byte, maxDatagramSize)
+				for {
+					datagram, err := readFrame(conn, buf)
+					if err != nil {
+						return
+					}
+					if writeFrame(conn, datagram) != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return &Tunnel{
+		Host:      testHost,
+		Addr:      l.Addr().String(),
+		Token:     "owner-token",
+		tlsConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+}
+
+func TestServeTCP(t *testing.T) {
+	tunnel := startEchoTunnel(t, false)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() { _ = tunnel.ServeTCP(context.Background(), l) }()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	_, err = conn.Write([]byte("hello tcp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	act := make([]byte, len("hello tcp"))
+	_, err = io.ReadFull(conn, act)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(act) != "hello tcp" {
+		t.Errorf("unexpected response: %q", act)
+	}
+}
+
+func TestServeUDP(t *testing.T) {
+	tunnel := startEchoTunnel(t, true)
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	go func() { _ = tunnel.ServeUDP(context.Background(), pc) }()
+
+	conn, err := net.Dial("udp", pc.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	for _, msg := range []string{"hello", "udp"} {
+		_, err = conn.Write([]byte(msg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, maxDatagramSize)
+		n, err := conn.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != msg {
+			t.Errorf("unexpected response: %q", buf[:n])
+		}
+	}
+}
+
+func TestDialWithWrongToken(t *testing.T) {
+	tunnel := startEchoTunnel(t, false)
+	tunnel.Token = "not-the-owner-token"
+
+	conn, err := tunnel.Dial(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	_, err = conn.Read(make([]byte, 1))
+	if err != io.EOF {
+		t.Errorf("expected the tunnel to close the connection, got %v", err)
+	}
+}
+
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: testHost},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
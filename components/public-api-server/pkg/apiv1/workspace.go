@@ -7,6 +7,8 @@ package apiv1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"time"
 
 	"path/filepath"
 
@@ -16,20 +18,35 @@ import (
 	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
 	"github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1/v1connect"
 	protocol "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/public-api-server/pkg/auth"
+	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/proxy"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client) *WorkspaceService {
+// maxPortShareTTL is the longest time a port share link can be valid for
+const maxPortShareTTL = 7 * 24 * time.Hour
+
+// portShareQueryParam is the query parameter ws-proxy reads share tokens from
+const portShareQueryParam = "gitpod_share"
+
+// NewWorkspaceService creates a new workspace service. Port share links are signed with portShareSigner,
+// if it is nil, sharing ports is not supported.
+func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client, portShareSigner jws.Signer, portShareIssuer string) *WorkspaceService {
 	return &WorkspaceService{
-		connectionPool: serverConnPool,
-		expClient:      expClient,
+		connectionPool:  serverConnPool,
+		expClient:       expClient,
+		portShareSigner: portShareSigner,
+		portShareIssuer: portShareIssuer,
 	}
 }
 
 type WorkspaceService struct {
-	connectionPool proxy.ServerConnectionPool
-	expClient      experiments.Client
+	connectionPool  proxy.ServerConnectionPool
+	expClient       experiments.Client
+	portShareSigner jws.Signer
+	portShareIssuer string
 
 	v1connect.UnimplementedWorkspacesServiceHandler
 }
@@ -211,6 +228,25 @@ func (s *WorkspaceService) UpdatePort(ctx context.Context, req *connect.Request[
 		return nil, err
 	}
 
+	share := req.Msg.GetShare()
+	var exposed *protocol.WorkspaceInstancePort
+	if share != nil {
+		if s.portShareSigner == nil {
+			return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Sharing ports is not enabled."))
+		}
+		if !share.GetRevoke() {
+			ttl := share.GetTtl().AsDuration()
+			if ttl <= 0 || ttl > maxPortShareTTL {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Share TTL must be positive and at most %s.", maxPortShareTTL))
+			}
+		}
+
+		exposed, err = getExposedPort(ctx, conn, workspaceID, req.Msg.GetPort().GetPort())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var portVisibility string
 	var portProtocol string
 
@@ -219,32 +255,113 @@ func (s *WorkspaceService) UpdatePort(ctx context.Context, req *connect.Request[
 		portVisibility = protocol.PortVisibilityPrivate
 	case v1.PortPolicy_PORT_POLICY_PUBLIC:
 		portVisibility = protocol.PortVisibilityPublic
+	case v1.PortPolicy_PORT_POLICY_ORGANIZATION:
+		portVisibility = protocol.PortVisibilityOrganization
+	case v1.PortPolicy_PORT_POLICY_UNSPECIFIED:
+		if exposed != nil {
+			// sharing a port keeps its current visibility
+			portVisibility = exposed.Visibility
+			break
+		}
+		fallthrough
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unknown port policy specified."))
 	}
 	switch req.Msg.GetPort().GetProtocol() {
-	case v1.PortProtocol_PORT_PROTOCOL_HTTP, v1.PortProtocol_PORT_PROTOCOL_UNSPECIFIED:
+	case v1.PortProtocol_PORT_PROTOCOL_HTTP:
+		portProtocol = protocol.PortProtocolHTTP
+	case v1.PortProtocol_PORT_PROTOCOL_UNSPECIFIED:
 		portProtocol = protocol.PortProtocolHTTP
+		if exposed != nil && exposed.Protocol != "" {
+			portProtocol = exposed.Protocol
+		}
 	case v1.PortProtocol_PORT_PROTOCOL_HTTPS:
 		portProtocol = protocol.PortProtocolHTTPS
+	case v1.PortProtocol_PORT_PROTOCOL_TCP:
+		portProtocol = protocol.PortProtocolTCP
+	case v1.PortProtocol_PORT_PROTOCOL_UDP:
+		portProtocol = protocol.PortProtocolUDP
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Unknown port protocol specified."))
 	}
+
+	var shareID string
+	if exposed != nil {
+		shareID = exposed.ShareID
+		if shareID == "" || share.GetRevoke() {
+			// a new share ID invalidates all links issued for the previous one
+			shareID = uuid.New().String()
+		}
+	}
+
 	_, err = conn.OpenPort(ctx, workspaceID, &protocol.WorkspaceInstancePort{
 		Port:       float64(req.Msg.Port.Port),
 		Visibility: portVisibility,
 		Protocol:   portProtocol,
+		ShareID:    shareID,
 	})
 	if err != nil {
 		log.Extract(ctx).Error("Failed to update port")
 		return nil, proxy.ConvertError(err)
 	}
 
+	if share == nil || share.GetRevoke() {
+		return connect.NewResponse(
+			&v1.UpdatePortResponse{},
+		), nil
+	}
+
+	shareURL, expiry, err := s.issuePortShare(exposed, workspaceID, shareID, share.GetTtl().AsDuration())
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to issue port share link")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to issue share link."))
+	}
+
 	return connect.NewResponse(
-		&v1.UpdatePortResponse{},
+		&v1.UpdatePortResponse{
+			ShareUrl:    shareURL,
+			ShareExpiry: timestamppb.New(expiry),
+		},
 	), nil
 }
 
+// getExposedPort returns the port of the running instance of a workspace
+func getExposedPort(ctx context.Context, conn protocol.APIInterface, workspaceID string, port uint64) (*protocol.WorkspaceInstancePort, error) {
+	ws, err := conn.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to get workspace.")
+		return nil, proxy.ConvertError(err)
+	}
+	if ws.LatestInstance != nil && ws.LatestInstance.Status != nil {
+		for _, p := range ws.LatestInstance.Status.ExposedPorts {
+			if p != nil && uint64(p.Port) == port && p.URL != "" {
+				return p, nil
+			}
+		}
+	}
+	return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("Port %d is not exposed.", port))
+}
+
+// issuePortShare signs a share token for the port and returns the share link which carries it
+func (s *WorkspaceService) issuePortShare(port *protocol.WorkspaceInstancePort, workspaceID, shareID string, ttl time.Duration) (string, time.Time, error) {
+	u, err := url.Parse(port.URL)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid port URL: %w", err)
+	}
+
+	now := time.Now()
+	expiry := now.Add(ttl)
+	token, err := s.portShareSigner.Sign(auth.NewPortShareJWT(workspaceID, uint32(port.Port), shareID, s.portShareIssuer, now, expiry))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	query := u.Query()
+	query.Set(portShareQueryParam, token)
+	u.RawQuery = query.Encode()
+	return u.String(), expiry, nil
+}
+
 func (s *WorkspaceService) StartWorkspace(ctx context.Context, req *connect.Request[v1.StartWorkspaceRequest]) (*connect.Response[v1.StartWorkspaceResponse], error) {
 	workspaceID, err := validateWorkspaceID(ctx, req.Msg.GetWorkspaceId())
 	if err != nil {
@@ -505,14 +622,22 @@ func convertWorkspaceInstance(wsi *protocol.WorkspaceInstance, wsCtx *protocol.W
 			Port: uint64(p.Port),
 			Url:  p.URL,
 		}
-		if p.Visibility == protocol.PortVisibilityPublic {
+		switch p.Visibility {
+		case protocol.PortVisibilityPublic:
 			port.Policy = v1.PortPolicy_PORT_POLICY_PUBLIC
-		} else {
+		case protocol.PortVisibilityOrganization:
+			port.Policy = v1.PortPolicy_PORT_POLICY_ORGANIZATION
+		default:
 			port.Policy = v1.PortPolicy_PORT_POLICY_PRIVATE
 		}
-		if p.Protocol == protocol.PortProtocolHTTPS {
+		switch p.Protocol {
+		case protocol.PortProtocolHTTPS:
 			port.Protocol = v1.PortProtocol_PORT_PROTOCOL_HTTPS
-		} else {
+		case protocol.PortProtocolTCP:
+			port.Protocol = v1.PortProtocol_PORT_PROTOCOL_TCP
+		case protocol.PortProtocolUDP:
+			port.Protocol = v1.PortProtocol_PORT_PROTOCOL_UDP
+		default:
 			port.Protocol = v1.PortProtocol_PORT_PROTOCOL_HTTP
 		}
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -22,11 +23,13 @@ import (
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/auth"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws"
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws/jwstest"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/jsonrpc2"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -531,6 +534,119 @@ func TestWorkspaceService_StreamWorkspaceStatus(t *testing.T) {
 	})
 }
 
+func TestWorkspaceService_UpdatePort(t *testing.T) {
+
+	workspaceID := workspaceTestData[0].Protocol.Workspace.ID
+
+	// withShareID returns the test workspace with port 9001 carrying the given share ID
+	withShareID := func(shareID string) *protocol.WorkspaceInfo {
+		wsInfo := workspaceTestData[0].Protocol
+		instance := *wsInfo.LatestInstance
+		status := *instance.Status
+		port := *status.ExposedPorts[1]
+		port.ShareID = shareID
+		status.ExposedPorts = []*protocol.WorkspaceInstancePort{status.ExposedPorts[0], &port}
+		instance.Status = &status
+		wsInfo.LatestInstance = &instance
+		return &wsInfo
+	}
+
+	t.Run("invalid argument when share TTL is missing", func(t *testing.T) {
+		_, client := setupWorkspacesService(t)
+
+		_, err := client.UpdatePort(context.Background(), connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: workspaceID,
+			Port:        &v1.PortSpec{Port: 9001},
+			Share:       &v1.PortShare{},
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("failed precondition when port is not exposed", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(&workspaceTestData[0].Protocol, nil)
+
+		_, err := client.UpdatePort(context.Background(), connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: workspaceID,
+			Port:        &v1.PortSpec{Port: 3000},
+			Share:       &v1.PortShare{Ttl: durationpb.New(time.Hour)},
+		}))
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("issues share link and keeps port settings", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		var shareID string
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(&workspaceTestData[0].Protocol, nil)
+		serverMock.EXPECT().OpenPort(gomock.Any(), workspaceID, gomock.Any()).DoAndReturn(func(ctx context.Context, workspaceID string, port *protocol.WorkspaceInstancePort) (*protocol.WorkspaceInstancePort, error) {
+			require.Equal(t, protocol.PortVisibilityPrivate, port.Visibility)
+			require.Equal(t, protocol.PortProtocolHTTPS, port.Protocol)
+			require.NotEmpty(t, port.ShareID)
+			shareID = port.ShareID
+			return port, nil
+		})
+
+		resp, err := client.UpdatePort(context.Background(), connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: workspaceID,
+			Port:        &v1.PortSpec{Port: 9001},
+			Share:       &v1.PortShare{Ttl: durationpb.New(time.Hour)},
+		}))
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(time.Hour), resp.Msg.GetShareExpiry().AsTime(), time.Minute)
+
+		shareURL, err := url.Parse(resp.Msg.GetShareUrl())
+		require.NoError(t, err)
+		require.Equal(t, "9001-gitpodio-gitpod-isq6xj458lj.ws-eu53.protocol.io", shareURL.Host)
+
+		var claims auth.PortShareClaims
+		_, _, err = jwt.NewParser().ParseUnverified(shareURL.Query().Get("gitpod_share"), &claims)
+		require.NoError(t, err)
+		require.Equal(t, workspaceID, claims.Subject)
+		require.Equal(t, shareID, claims.ID)
+		require.Equal(t, uint32(9001), claims.Port)
+		require.Equal(t, jwt.ClaimStrings{auth.PortShareAudience}, claims.Audience)
+	})
+
+	t.Run("reuses share ID of port", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(withShareID("share-1"), nil)
+		serverMock.EXPECT().OpenPort(gomock.Any(), workspaceID, gomock.Any()).DoAndReturn(func(ctx context.Context, workspaceID string, port *protocol.WorkspaceInstancePort) (*protocol.WorkspaceInstancePort, error) {
+			require.Equal(t, "share-1", port.ShareID)
+			return port, nil
+		})
+
+		resp, err := client.UpdatePort(context.Background(), connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: workspaceID,
+			Port:        &v1.PortSpec{Port: 9001},
+			Share:       &v1.PortShare{Ttl: durationpb.New(time.Hour)},
+		}))
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Msg.GetShareUrl())
+	})
+
+	t.Run("revoking rotates share ID", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		serverMock.EXPECT().GetWorkspace(gomock.Any(), workspaceID).Return(withShareID("share-1"), nil)
+		serverMock.EXPECT().OpenPort(gomock.Any(), workspaceID, gomock.Any()).DoAndReturn(func(ctx context.Context, workspaceID string, port *protocol.WorkspaceInstancePort) (*protocol.WorkspaceInstancePort, error) {
+			require.NotEmpty(t, port.ShareID)
+			require.NotEqual(t, "share-1", port.ShareID)
+			return port, nil
+		})
+
+		resp, err := client.UpdatePort(context.Background(), connect.NewRequest(&v1.UpdatePortRequest{
+			WorkspaceId: workspaceID,
+			Port:        &v1.PortSpec{Port: 9001},
+			Share:       &v1.PortShare{Revoke: true},
+		}))
+		require.NoError(t, err)
+		require.Empty(t, resp.Msg.GetShareUrl())
+	})
+}
+
 func TestClientServerStreamInterceptor(t *testing.T) {
 	testInterceptor := &TestInterceptor{
 		expectedToken: "auth-token",
@@ -544,7 +660,7 @@ func TestClientServerStreamInterceptor(t *testing.T) {
 
 	svc := NewWorkspaceService(&FakeServerConnPool{
 		api: serverMock,
-	}, nil)
+	}, nil, nil, "")
 
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
@@ -691,6 +807,12 @@ var workspaceTestData = []workspaceTestDataEntry{
 							Visibility: protocol.PortVisibilityPrivate,
 							Protocol:   protocol.PortProtocolHTTPS,
 						},
+						{
+							Port:       9002,
+							URL:        "https://9002-gitpodio-gitpod-isq6xj458lj.ws-eu53.protocol.io",
+							Visibility: protocol.PortVisibilityOrganization,
+							Protocol:   protocol.PortProtocolHTTP,
+						},
 					},
 				},
 			},
@@ -739,6 +861,12 @@ var workspaceTestData = []workspaceTestDataEntry{
 								Url:      "https://9001-gitpodio-gitpod-isq6xj458lj.ws-eu53.protocol.io",
 								Protocol: v1.PortProtocol_PORT_PROTOCOL_HTTPS,
 							},
+							{
+								Port:     9002,
+								Policy:   v1.PortPolicy_PORT_POLICY_ORGANIZATION,
+								Url:      "https://9002-gitpodio-gitpod-isq6xj458lj.ws-eu53.protocol.io",
+								Protocol: v1.PortProtocol_PORT_PROTOCOL_HTTP,
+							},
 						},
 						RecentFolders: []string{"/workspace/gitpod"},
 					},
@@ -811,14 +939,14 @@ func setupWorkspacesService(t *testing.T) (*protocol.MockAPIInterface, v1connect
 
 	serverMock := protocol.NewMockAPIInterface(ctrl)
 
-	svc := NewWorkspaceService(&FakeServerConnPool{
-		api: serverMock,
-	}, nil)
-
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
 	require.NoError(t, err)
 
+	svc := NewWorkspaceService(&FakeServerConnPool{
+		api: serverMock,
+	}, nil, rsa256, "unitetest.com")
+
 	_, handler := v1connect.NewWorkspacesServiceHandler(svc, connect.WithInterceptors(auth.NewServerInterceptor(config.SessionConfig{
 		Issuer: "unitetest.com",
 		Cookie: config.CookieConfig{
@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package auth
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// PortShareAudience is the audience ws-proxy expects port share tokens to have
+const PortShareAudience = "workspace-port-share"
+
+type PortShareClaims struct {
+	jwt.RegisteredClaims
+
+	// Port is the workspace port the token grants access to
+	Port uint32 `json:"port"`
+}
+
+// NewPortShareJWT creates a token which grants access to a single workspace port.
+// ws-proxy only accepts the token while shareID is the share ID of the port, which allows revoking it.
+func NewPortShareJWT(workspaceID string, port uint32, shareID, issuer string, issuedAt, expiry time.Time) *jwt.Token {
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, &PortShareClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   workspaceID,
+			Audience:  jwt.ClaimStrings{PortShareAudience},
+			ID:        shareID,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiry),
+		},
+		Port: port,
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws"
+	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws/jwstest"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPortShareJWT(t *testing.T) {
+	var (
+		workspaceID = "gitpodio-gitpod-2cx8z8e643x"
+		issuer      = "some-issuer"
+		issuedAt    = time.Now()
+		expiry      = issuedAt.Add(2 * time.Hour)
+	)
+	token := NewPortShareJWT(workspaceID, 3000, "share-id", issuer, issuedAt, expiry)
+
+	keyset := jwstest.GenerateKeySet(t)
+	rsa256, err := jws.NewRSA256(keyset)
+	require.NoError(t, err)
+
+	signed, err := rsa256.Sign(token)
+	require.NoError(t, err)
+
+	parsed, err := rsa256.Verify(signed, &PortShareClaims{}, jwt.WithIssuer(issuer), jwt.WithAudience(PortShareAudience))
+	require.NoError(t, err)
+	require.Equal(t, keyset.Signing.ID, parsed.Header[jws.KeyIDName])
+	require.Equal(t, &PortShareClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   workspaceID,
+			Audience:  jwt.ClaimStrings{PortShareAudience},
+			ID:        "share-id",
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiry),
+		},
+		Port: 3000,
+	}, parsed.Claims)
+}
@@ -103,6 +103,13 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 	}
 	hs256 := jws.NewHS256FromKeySet(keyset)
 
+	var portShareSigner jws.Signer
+	if cfg.PortShareLinks {
+		portShareSigner = rsa256
+	} else {
+		log.Info("Port share links are not enabled, sharing ports will be disabled.")
+	}
+
 	var stripeWebhookHandler http.Handler = webhooks.NewNoopWebhookHandler()
 	if cfg.StripeWebhookSigningSecretPath != "" {
 		stripeWebhookSecret, err := readSecretFromFile(cfg.StripeWebhookSigningSecretPath)
@@ -148,6 +155,7 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 		idpService:      idpService,
 		authCfg:         cfg.Auth,
 		sessionVerifier: rsa256,
+		portShareSigner: portShareSigner,
 	}); registerErr != nil {
 		return fmt.Errorf("failed to register services: %w", registerErr)
 	}
@@ -169,6 +177,7 @@ type registerDependencies struct {
 	idpService  *identityprovider.Service
 
 	sessionVerifier jws.SignerVerifier
+	portShareSigner jws.Signer
 	authCfg         config.AuthConfiguration
 }
 
@@ -195,7 +204,7 @@ func register(srv *baseserver.Server, deps *registerDependencies) error {
 		),
 	}
 
-	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient), handlerOptions...))
+	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient, deps.portShareSigner, deps.authCfg.Session.Issuer), handlerOptions...))
 	rootHandler.Mount(v1connect.NewTeamsServiceHandler(apiv1.NewTeamsService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewUserServiceHandler(apiv1.NewUserService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewSCMServiceHandler(apiv1.NewSCMService(deps.connPool), handlerOptions...))
@@ -34,6 +34,9 @@ type Configuration struct {
 	// Authentication configuration
 	Auth AuthConfiguration `json:"auth"`
 
+	// PortShareLinks enables issuing share links for workspace ports, which are signed with the auth PKI
+	PortShareLinks bool `json:"portShareLinks,omitempty"`
+
 	Server *baseserver.Configuration `json:"server,omitempty"`
 }
 
@@ -10,7 +10,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { FieldMask, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { Duration, FieldMask, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { Pagination } from "./pagination_pb.js";
 
 /**
@@ -37,12 +37,20 @@ export enum PortPolicy {
    * @generated from enum value: PORT_POLICY_PUBLIC = 2;
    */
   PUBLIC = 2,
+
+  /**
+   * Organization means the port is accessible by all members of the workspace's organization using the workspace port URL
+   *
+   * @generated from enum value: PORT_POLICY_ORGANIZATION = 3;
+   */
+  ORGANIZATION = 3,
 }
 // Retrieve enum metadata with: proto3.getEnumType(PortPolicy)
 proto3.util.setEnumType(PortPolicy, "gitpod.experimental.v1.PortPolicy", [
   { no: 0, name: "PORT_POLICY_UNSPECIFIED" },
   { no: 1, name: "PORT_POLICY_PRIVATE" },
   { no: 2, name: "PORT_POLICY_PUBLIC" },
+  { no: 3, name: "PORT_POLICY_ORGANIZATION" },
 ]);
 
 /**
@@ -69,12 +77,28 @@ export enum PortProtocol {
    * @generated from enum value: PORT_PROTOCOL_HTTPS = 2;
    */
   HTTPS = 2,
+
+  /**
+   * Tcp means the port is forwarded as raw TCP stream through the port tunnel
+   *
+   * @generated from enum value: PORT_PROTOCOL_TCP = 3;
+   */
+  TCP = 3,
+
+  /**
+   * Udp means the port is forwarded as UDP datagrams through the port tunnel
+   *
+   * @generated from enum value: PORT_PROTOCOL_UDP = 4;
+   */
+  UDP = 4,
 }
 // Retrieve enum metadata with: proto3.getEnumType(PortProtocol)
 proto3.util.setEnumType(PortProtocol, "gitpod.experimental.v1.PortProtocol", [
   { no: 0, name: "PORT_PROTOCOL_UNSPECIFIED" },
   { no: 1, name: "PORT_PROTOCOL_HTTP" },
   { no: 2, name: "PORT_PROTOCOL_HTTPS" },
+  { no: 3, name: "PORT_PROTOCOL_TCP" },
+  { no: 4, name: "PORT_PROTOCOL_UDP" },
 ]);
 
 /**
@@ -1875,6 +1899,56 @@ export class PortSpec extends Message<PortSpec> {
   }
 }
 
+/**
+ * PortShare issues or revokes share links for a port. Share links grant access to a single port
+ * regardless of its policy until they expire or are revoked.
+ *
+ * @generated from message gitpod.experimental.v1.PortShare
+ */
+export class PortShare extends Message<PortShare> {
+  /**
+   * ttl is how long the share link is valid for
+   *
+   * @generated from field: google.protobuf.Duration ttl = 1;
+   */
+  ttl?: Duration;
+
+  /**
+   * revoke invalidates all share links issued for the port instead of issuing a new one
+   *
+   * @generated from field: bool revoke = 2;
+   */
+  revoke = false;
+
+  constructor(data?: PartialMessage<PortShare>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.experimental.v1.PortShare";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ttl", kind: "message", T: Duration },
+    { no: 2, name: "revoke", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PortShare {
+    return new PortShare().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PortShare {
+    return new PortShare().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PortShare {
+    return new PortShare().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PortShare | PlainMessage<PortShare> | undefined, b: PortShare | PlainMessage<PortShare> | undefined): boolean {
+    return proto3.util.equals(PortShare, a, b);
+  }
+}
+
 /**
  * @generated from message gitpod.experimental.v1.UpdatePortRequest
  */
@@ -1889,6 +1963,13 @@ export class UpdatePortRequest extends Message<UpdatePortRequest> {
    */
   port?: PortSpec;
 
+  /**
+   * share optionally issues or revokes share links for the port
+   *
+   * @generated from field: gitpod.experimental.v1.PortShare share = 3;
+   */
+  share?: PortShare;
+
   constructor(data?: PartialMessage<UpdatePortRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1899,6 +1980,7 @@ export class UpdatePortRequest extends Message<UpdatePortRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "port", kind: "message", T: PortSpec },
+    { no: 3, name: "share", kind: "message", T: PortShare },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePortRequest {
@@ -1922,6 +2004,20 @@ export class UpdatePortRequest extends Message<UpdatePortRequest> {
  * @generated from message gitpod.experimental.v1.UpdatePortResponse
  */
 export class UpdatePortResponse extends Message<UpdatePortResponse> {
+  /**
+   * share_url is the share link, if one was issued
+   *
+   * @generated from field: string share_url = 1;
+   */
+  shareUrl = "";
+
+  /**
+   * share_expiry is the time the share link expires at
+   *
+   * @generated from field: google.protobuf.Timestamp share_expiry = 2;
+   */
+  shareExpiry?: Timestamp;
+
   constructor(data?: PartialMessage<UpdatePortResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1930,6 +2026,8 @@ export class UpdatePortResponse extends Message<UpdatePortResponse> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "gitpod.experimental.v1.UpdatePortResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "share_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "share_expiry", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePortResponse {
@@ -19,6 +19,7 @@ import (
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
 	distv2 "github.com/docker/distribution/registry/api/v2"
 	"github.com/gorilla/handlers"
@@ -409,8 +410,37 @@ func AsFetcherFunc(f remotes.Fetcher) FetcherFunc {
 	return func() (remotes.Fetcher, error) { return f, nil }
 }
 
+// selectPlatformManifest picks the manifest of an index which best matches the platform.
+// Indices whose manifests carry no platform information are assumed to be single-platform, in which case
+// we use the first manifest.
+func selectPlatformManifest(list ociv1.Index, pltf ociv1.Platform) (ociv1.Descriptor, error) {
+	var (
+		platform = platforms.Only(pltf)
+		res      ociv1.Descriptor
+		found    bool
+	)
+	for _, mf := range list.Manifests {
+		if mf.Platform == nil || !platform.Match(*mf.Platform) {
+			continue
+		}
+		if !found || platform.Less(*mf.Platform, *res.Platform) {
+			res, found = mf, true
+		}
+	}
+	if found {
+		return res, nil
+	}
+
+	for _, mf := range list.Manifests {
+		if mf.Platform != nil {
+			return ociv1.Descriptor{}, xerrors.Errorf("no manifest for platform %s found", platforms.Format(pltf))
+		}
+	}
+	return list.Manifests[0], nil
+}
+
 // DownloadManifest downloads and unmarshals the manifest of the given desc. If the desc points to manifest list
-// we choose the first manifest in that list.
+// we choose the manifest which matches the platform of this node.
 func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descriptor, options ...ManifestDownloadOption) (cfg *ociv1.Manifest, rdesc *ociv1.Descriptor, err error) {
 	log := log.WithField("desc", desc)
 
@@ -500,7 +530,7 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 		log := log.WithField("desc", rdesc)
 		log.Debug("resolving image index")
 
-		// we received a manifest list which means we'll pick the default platform
+		// we received a manifest list which means we'll pick the platform of this node
 		// and fetch that manifest
 		var list ociv1.Index
 		err = json.Unmarshal(inpt, &list)
@@ -512,6 +542,12 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 			err = xerrors.Errorf("empty manifest")
 			return
 		}
+		var md ociv1.Descriptor
+		md, err = selectPlatformManifest(list, platforms.DefaultSpec())
+		if err != nil {
+			return
+		}
+		log.WithField("manifest", md).Debug("selected platform manifest")
 
 		err = wait.ExponentialBackoffWithContext(ctx, fetcherBackoffParams, func(ctx context.Context) (done bool, err error) {
 			var fetcher remotes.Fetcher
@@ -521,9 +557,7 @@ func DownloadManifest(ctx context.Context, fetch FetcherFunc, desc ociv1.Descrip
 				return false, nil // retry
 			}
 
-			// TODO(cw): choose by platform, not just the first manifest
 			var rc io.ReadCloser
-			md := list.Manifests[0]
 			rc, err = fetcher.Fetch(ctx, md)
 			if err != nil {
 				log.WithError(err).Warn("cannot download config")
@@ -17,6 +17,7 @@ import (
 
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/remotes"
 	"github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/specs-go"
@@ -114,6 +115,46 @@ func TestDownloadManifest(t *testing.T) {
 	}
 }
 
+func TestSelectPlatformManifest(t *testing.T) {
+	var (
+		amd64   = ociv1.Descriptor{Digest: digest.FromString("amd64"), Platform: &ociv1.Platform{OS: "linux", Architecture: "amd64"}}
+		arm64   = ociv1.Descriptor{Digest: digest.FromString("arm64"), Platform: &ociv1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}}
+		armv7   = ociv1.Descriptor{Digest: digest.FromString("armv7"), Platform: &ociv1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}}
+		unknown = ociv1.Descriptor{Digest: digest.FromString("unknown"), Platform: &ociv1.Platform{OS: "unknown", Architecture: "unknown"}}
+		noPltf  = ociv1.Descriptor{Digest: digest.FromString("no-platform")}
+	)
+
+	tests := []struct {
+		Name        string
+		Manifests   []ociv1.Descriptor
+		Platform    string
+		Expectation ociv1.Descriptor
+		Error       bool
+	}{
+		{Name: "first manifest", Manifests: []ociv1.Descriptor{amd64, arm64}, Platform: "linux/amd64", Expectation: amd64},
+		{Name: "second manifest", Manifests: []ociv1.Descriptor{amd64, arm64}, Platform: "linux/arm64", Expectation: arm64},
+		{Name: "ignores attestations", Manifests: []ociv1.Descriptor{unknown, arm64}, Platform: "linux/arm64", Expectation: arm64},
+		{Name: "prefers exact match", Manifests: []ociv1.Descriptor{armv7, arm64}, Platform: "linux/arm64", Expectation: arm64},
+		{Name: "no platforms", Manifests: []ociv1.Descriptor{noPltf}, Platform: "linux/arm64", Expectation: noPltf},
+		{Name: "missing platform", Manifests: []ociv1.Descriptor{amd64}, Platform: "linux/arm64", Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pltf, err := platforms.Parse(test.Platform)
+			require.NoError(t, err)
+
+			act, err := selectPlatformManifest(ociv1.Index{Manifests: test.Manifests}, pltf)
+			if test.Error {
+				require.ErrorContains(t, err, "no manifest for platform "+test.Platform+" found")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.Expectation.Digest, act.Digest)
+		})
+	}
+}
+
 type alwaysNotFoundStore struct{}
 
 func (fbs *alwaysNotFoundStore) ReaderAt(ctx context.Context, desc ociv1.Descriptor) (content.ReaderAt, error) {
@@ -5,7 +5,7 @@
  */
 
 import { suite, test } from "@testdeck/mocha";
-import { AuthJWT, sign, verify } from "./jwt";
+import { AuthJWT, sign, verify, WorkspaceSessionJWT, workspaceSessionJWTAudience } from "./jwt";
 import { Container } from "inversify";
 import { Config } from "../config";
 import * as chai from "chai";
@@ -25,6 +25,7 @@ class TestAuthJWT {
         this.container = new Container();
         this.container.bind(Config).toConstantValue(this.config);
         this.container.bind(AuthJWT).toSelf().inSingletonScope();
+        this.container.bind(WorkspaceSessionJWT).toSelf().inSingletonScope();
     }
 
     @test
@@ -75,6 +76,38 @@ class TestAuthJWT {
         expect(decoded["sub"]).to.equal(subject);
         expect(decoded["iss"]).to.equal("https://mp-server-d7650ec945.preview.gitpod-dev.com");
     }
+
+    @test
+    async test_workspace_session_sign() {
+        const sut = this.container.get<WorkspaceSessionJWT>(WorkspaceSessionJWT);
+
+        const encoded = await sut.sign("user-id", ["org-1", "org-2"]);
+
+        const decoded = await verify(encoded, this.config.auth.pki.signing.publicKey, {
+            algorithms: ["RS256"],
+            audience: workspaceSessionJWTAudience,
+        });
+
+        expect(decoded["sub"]).to.equal("user-id");
+        expect(decoded["orgs"]).to.deep.equal(["org-1", "org-2"]);
+        expect(decoded["iss"]).to.equal("https://mp-server-d7650ec945.preview.gitpod-dev.com");
+    }
+
+    @test
+    async test_workspace_session_is_no_session() {
+        const authJWT = this.container.get<AuthJWT>(AuthJWT);
+        const sut = this.container.get<WorkspaceSessionJWT>(WorkspaceSessionJWT);
+
+        const encoded = await sut.sign("user-id", ["org-1"]);
+
+        let err: Error | undefined;
+        try {
+            await authJWT.verify(encoded);
+        } catch (e) {
+            err = e;
+        }
+        expect(err).to.not.be.undefined;
+    }
 }
 
 module.exports = new TestAuthJWT();
@@ -64,6 +64,32 @@ export class AuthJWT {
     }
 }
 
+// Workspace session tokens are verified by ws-proxy. They use a different algorithm and an audience,
+// so that they can never be mistaken for a session token by AuthJWT.verify, nor vice versa.
+const workspaceSessionJWTAlgorithm: jsonwebtoken.Algorithm = "RS256";
+export const workspaceSessionJWTAudience = "workspace-ports";
+
+@injectable()
+export class WorkspaceSessionJWT {
+    @inject(Config) protected config: Config;
+
+    /**
+     * Signs a token which grants access to ports with organization visibility of the given organizations' workspaces.
+     */
+    async sign(userId: string, organizationIds: string[], expirySeconds: number = 60 * 60): Promise<string> {
+        const opts: jsonwebtoken.SignOptions = {
+            algorithm: workspaceSessionJWTAlgorithm,
+            expiresIn: expirySeconds,
+            issuer: this.config.auth.session.issuer,
+            audience: workspaceSessionJWTAudience,
+            subject: userId,
+            keyid: this.config.auth.pki.signing.id,
+        };
+
+        return sign({ orgs: organizationIds }, this.config.auth.pki.signing.privateKey, opts);
+    }
+}
+
 const signinJWTAlgorithm: jsonwebtoken.Algorithm = "HS256";
 
 @injectable()
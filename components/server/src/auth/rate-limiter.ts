@@ -82,6 +82,7 @@ const defaultFunctions: FunctionsConfig = {
     getHeadlessLog: { group: "default", points: 1 },
     setWorkspaceTimeout: { group: "default", points: 1 },
     getWorkspaceTimeout: { group: "default", points: 1 },
+    resizeWorkspaceDisk: { group: "default", points: 1 },
     getOpenPorts: { group: "default", points: 1 },
     openPort: { group: "default", points: 1 },
     closePort: { group: "default", points: 1 },
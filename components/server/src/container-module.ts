@@ -9,6 +9,7 @@ import { ContainerModule } from "inversify";
 import { RedisPublisher, newRedisClient } from "@gitpod/gitpod-db/lib";
 import { IAnalyticsWriter } from "@gitpod/gitpod-protocol/lib/analytics";
 import { GitpodFileParser } from "@gitpod/gitpod-protocol/lib/gitpod-file-parser";
+import { DevcontainerParser } from "@gitpod/gitpod-protocol/lib/devcontainer-parser";
 import { PrometheusClientCallMetrics } from "@gitpod/gitpod-protocol/lib/messaging/client-call-metrics";
 import { newAnalyticsWriterFromEnv } from "@gitpod/gitpod-protocol/lib/util/analytics";
 import { DebugApp } from "@gitpod/gitpod-protocol/lib/util/debug-app";
@@ -44,7 +45,7 @@ import { BearerAuth } from "./auth/bearer-authenticator";
 import { HostContainerMapping } from "./auth/host-container-mapping";
 import { HostContextProvider, HostContextProviderFactory } from "./auth/host-context-provider";
 import { HostContextProviderImpl } from "./auth/host-context-provider-impl";
-import { AuthJWT, SignInJWT } from "./auth/jwt";
+import { AuthJWT, SignInJWT, WorkspaceSessionJWT } from "./auth/jwt";
 import { NonceService } from "./auth/nonce-service";
 import { LoginCompletionHandler } from "./auth/login-completion-handler";
 import { VerificationService } from "./auth/verification-service";
@@ -168,6 +169,7 @@ export const productionContainerModule = new ContainerModule(
         bind(DebugApp).toSelf().inSingletonScope();
 
         bind(GitpodFileParser).toSelf().inSingletonScope();
+        bind(DevcontainerParser).toSelf().inSingletonScope();
 
         bind(ConfigProvider).toSelf().inSingletonScope();
         bind(ConfigurationService).toSelf().inSingletonScope();
@@ -367,6 +369,7 @@ export const productionContainerModule = new ContainerModule(
 
         bind(AuthJWT).toSelf().inSingletonScope();
         bind(SignInJWT).toSelf().inSingletonScope();
+        bind(WorkspaceSessionJWT).toSelf().inSingletonScope();
         bind(NonceService).toSelf().inSingletonScope();
 
         bind(PrebuildManager).toSelf().inSingletonScope();
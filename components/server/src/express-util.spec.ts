@@ -5,7 +5,8 @@
  */
 
 import * as chai from "chai";
-import { isAllowedWebsocketDomain } from "./express-util";
+import { isAllowedWebsocketDomain, validateWorkspaceReturnToUrl } from "./express-util";
+import { GitpodHostUrl } from "@gitpod/gitpod-protocol/lib/util/gitpod-host-url";
 const expect = chai.expect;
 
 describe("express-util", function () {
@@ -47,4 +48,31 @@ describe("express-util", function () {
             expect(result).to.be.false;
         });
     });
+    describe("validateWorkspaceReturnToUrl", function () {
+        const hostUrl = new GitpodHostUrl("https://gitpod.io");
+        it("should return true for workspace-port locations", function () {
+            const result = validateWorkspaceReturnToUrl(
+                "https://8000-black-capybara-dy6e3fgz.ws-eu08.gitpod.io/path?q=1",
+                hostUrl,
+            );
+            expect(result).to.be.true;
+        });
+
+        it("should return false for the dashboard", function () {
+            expect(validateWorkspaceReturnToUrl("https://gitpod.io/workspaces", hostUrl)).to.be.false;
+        });
+
+        it("should return false for other domains", function () {
+            const result = validateWorkspaceReturnToUrl("https://8000-black-capybara-dy6e3fgz.evilgitpod.io", hostUrl);
+            expect(result).to.be.false;
+        });
+
+        it("should return false for insecure locations", function () {
+            const result = validateWorkspaceReturnToUrl(
+                "http://8000-black-capybara-dy6e3fgz.ws-eu08.gitpod.io",
+                hostUrl,
+            );
+            expect(result).to.be.false;
+        });
+    });
 });
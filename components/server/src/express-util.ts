@@ -242,6 +242,19 @@ export function validateAuthorizeReturnToUrl(returnTo: string, hostUrl: GitpodHo
     return validateReturnToUrlWithPatterns(returnTo, hostUrl, allowedPatterns);
 }
 
+/**
+ * Validates returnTo URLs pointing to a workspace, e.g. a workspace port, which are served from subdomains of the Gitpod host.
+ */
+export function validateWorkspaceReturnToUrl(returnTo: string, hostUrl: GitpodHostUrl): boolean {
+    try {
+        const url = new URL(returnTo);
+        return url.protocol === "https:" && url.hostname.endsWith("." + hostUrl.url.hostname);
+    } catch (error) {
+        // Invalid URL
+        return false;
+    }
+}
+
 export function getSafeReturnToParam(req: express.Request, validator?: (url: string) => boolean): string | undefined {
     // @ts-ignore Type 'ParsedQs' is not assignable
     const returnToURL: string | undefined = req.query.redirect || req.query.returnTo;
@@ -44,8 +44,8 @@ export class GithubAppRules {
         isPR: boolean,
         isFork: boolean,
     ): boolean {
-        if (!config || !config._origin || config._origin !== "repo") {
-            // we demand an explicit gitpod config
+        if (!config || (config._origin !== "repo" && config._origin !== "devcontainer")) {
+            // we demand an explicit gitpod or dev container config
             return false;
         }
 
@@ -92,6 +92,31 @@ describe("PrebuildManager", () => {
             context,
             project,
         },
+        {
+            title: "derived-config",
+            shouldRun: false,
+            reason: "no-gitpod-config-in-repo",
+            config: clone(config, (c) => (c._origin = "derived")),
+            context,
+            project,
+        },
+        {
+            title: "devcontainer-config",
+            shouldRun: true,
+            reason: "all-branches-selected",
+            config: clone(config, (c) => (c._origin = "devcontainer")),
+            context,
+            project: clone(
+                project,
+                (p) =>
+                    (p.settings = {
+                        prebuilds: {
+                            enable: true,
+                            branchStrategy: "all-branches",
+                        },
+                    }),
+            ),
+        },
         {
             title: "no-tasks",
             shouldRun: false,
@@ -532,8 +532,8 @@ export class PrebuildManager {
         reason: string;
     } {
         const { config, project, context } = params;
-        if (!config || !config._origin || config._origin !== "repo") {
-            // we demand an explicit gitpod config
+        if (!config || (config._origin !== "repo" && config._origin !== "devcontainer")) {
+            // we demand an explicit gitpod or dev container config
             return { shouldRun: false, reason: "no-gitpod-config-in-repo" };
         }
 
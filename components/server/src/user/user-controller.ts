@@ -21,6 +21,7 @@ import {
     getRequestingClientInfo,
     validateAuthorizeReturnToUrl,
     validateLoginReturnToUrl,
+    validateWorkspaceReturnToUrl,
     safeFragmentRedirect,
     getSafeReturnToParam,
 } from "../express-util";
@@ -44,6 +45,7 @@ import { WorkspaceService } from "../workspace/workspace-service";
 import { runWithSubjectId } from "../util/request-context";
 import { SubjectId } from "../auth/subject-id";
 import { isUserLoginBlockedBySunset } from "../util/featureflags";
+import { WorkspaceSessionJWT } from "../auth/jwt";
 
 export const ServerFactory = Symbol("ServerFactory");
 export type ServerFactory = () => GitpodServerImpl;
@@ -63,6 +65,7 @@ export class UserController {
     @inject(OneTimeSecretDB) protected readonly otsDb: OneTimeSecretDB;
     @inject(WorkspaceService) protected readonly workspaceService: WorkspaceService;
     @inject(ServerFactory) private readonly serverFactory: ServerFactory;
+    @inject(WorkspaceSessionJWT) private readonly workspaceSessionJWT: WorkspaceSessionJWT;
 
     get apiRouter(): express.Router {
         const router = express.Router();
@@ -351,10 +354,7 @@ export class UserController {
                     return;
                 }
 
-                let cookiePrefix: string = this.config.hostUrl.url.host;
-                cookiePrefix = cookiePrefix.replace(/^https?/, "");
-                [" ", "-", "."].forEach((c) => (cookiePrefix = cookiePrefix.split(c).join("_")));
-                const name = `_${cookiePrefix}_ws_${instanceID}_owner_`;
+                const name = `${this.workspaceCookiePrefix()}${instanceID}_owner_`;
 
                 if (!!req.cookies[name]) {
                     // cookie is already set - do nothing. This prevents server from drowning in load
@@ -420,6 +420,44 @@ export class UserController {
             },
         );
 
+        // ws-proxy redirects browsers here if they access a port with organization visibility without a session
+        router.get("/auth/workspace-session", async (req: express.Request, res: express.Response) => {
+            const returnTo = getSafeReturnToParam(req, (url) => validateWorkspaceReturnToUrl(url, this.config.hostUrl));
+            if (!returnTo) {
+                res.sendStatus(400);
+                return;
+            }
+
+            if (!req.isAuthenticated() || !User.is(req.user)) {
+                // come back here once the user is signed in
+                const search = `returnTo=${encodeURIComponent(returnTo)}`;
+                const sessionUrl = this.config.hostUrl.with({ pathname: "/api/auth/workspace-session", search });
+                const loginSearch = `returnTo=${encodeURIComponent(sessionUrl.toString())}`;
+                safeFragmentRedirect(res, this.config.hostUrl.asLogin().with({ search: loginSearch }).toString());
+                return;
+            }
+
+            const user = req.user as User;
+            if (user.blocked) {
+                res.sendStatus(403);
+                log.warn({ userId: user.id }, "blocked user attempted to fetch workspace session");
+                return;
+            }
+
+            const lifetimeSeconds = 60 * 60;
+            const orgs = await this.teamDb.findTeamsByUser(user.id);
+            const token = await this.workspaceSessionJWT.sign(user.id, orgs.map((org) => org.id), lifetimeSeconds);
+            res.cookie(`${this.workspaceCookiePrefix()}session_`, token, {
+                path: "/",
+                httpOnly: true,
+                secure: true,
+                maxAge: lifetimeSeconds * 1000,
+                sameSite: "lax", // "Lax" needed for cookie to work in the workspace domain.
+                domain: `.${this.config.hostUrl.url.host}`,
+            });
+            safeFragmentRedirect(res, returnTo);
+        });
+
         router.post(
             "/auth/workspacePageClose/:instanceID",
             async (req: express.Request, res: express.Response, next: express.NextFunction) => {
@@ -638,6 +676,16 @@ export class UserController {
         }
     }
 
+    /**
+     * Returns the prefix of the cookies ws-proxy uses to authenticate workspace requests.
+     */
+    protected workspaceCookiePrefix(): string {
+        let cookiePrefix: string = this.config.hostUrl.url.host;
+        cookiePrefix = cookiePrefix.replace(/^https?/, "");
+        [" ", "-", "."].forEach((c) => (cookiePrefix = cookiePrefix.split(c).join("_")));
+        return `_${cookiePrefix}_ws_`;
+    }
+
     protected ensureSafeReturnToParam(req: express.Request): string | undefined {
         const returnTo = getSafeReturnToParam(req, (url) => validateLoginReturnToUrl(url, this.config.hostUrl));
         req.query.returnTo = returnTo;
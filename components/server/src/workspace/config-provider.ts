@@ -24,6 +24,7 @@ import {
     ProjectConfig,
 } from "@gitpod/gitpod-protocol";
 import { GitpodFileParser } from "@gitpod/gitpod-protocol/lib/gitpod-file-parser";
+import { DEVCONTAINER_LOCATIONS, DevcontainerParser } from "@gitpod/gitpod-protocol/lib/devcontainer-parser";
 
 import { ConfigurationService } from "../config/configuration-service";
 import { HostContextProvider } from "../auth/host-context-provider";
@@ -33,13 +34,14 @@ import { Config } from "../config";
 import { EntitlementService } from "../billing/entitlement-service";
 import { TeamDB } from "@gitpod/gitpod-db/lib";
 import { InvalidGitpodYMLError } from "@gitpod/public-api-common/lib/public-api-errors";
-import { ImageFileRevisionMissing, RevisionNotFoundError } from "../repohost";
+import { FileProvider, ImageFileRevisionMissing, RevisionNotFoundError } from "../repohost";
 
 const POD_PATH_WORKSPACE_BASE = "/workspace";
 
 @injectable()
 export class ConfigProvider {
     @inject(GitpodFileParser) protected readonly gitpodParser: GitpodFileParser;
+    @inject(DevcontainerParser) protected readonly devcontainerParser: DevcontainerParser;
     @inject(HostContextProvider) protected readonly hostContextProvider: HostContextProvider;
     @inject(AuthorizationService) protected readonly authService: AuthorizationService;
     @inject(Config) protected readonly config: Config;
@@ -176,6 +178,10 @@ export class ConfigProvider {
                 let origin: WorkspaceConfig["_origin"] = "repo";
 
                 if (!customConfigString) {
+                    customConfig = await this.fetchDevcontainerConfig({ span }, user, commit, services.fileProvider);
+                }
+
+                if (!customConfigString && !customConfig) {
                     const inferredConfig = this.configurationService.guessRepositoryConfiguration(
                         { span },
                         user,
@@ -218,6 +224,52 @@ export class ConfigProvider {
         }
     }
 
+    /**
+     * fetchDevcontainerConfig maps the dev container config of a repository onto a workspace config.
+     * We only look for a dev container config if the repository has no .gitpod.yml.
+     */
+    private async fetchDevcontainerConfig(
+        ctx: TraceContext,
+        user: User,
+        commit: CommitContext,
+        fileProvider: FileProvider,
+    ): Promise<WorkspaceConfig | undefined> {
+        const logContext: LogContext = { userId: user.id };
+        for (const location of DEVCONTAINER_LOCATIONS) {
+            const content = await fileProvider.getFileContent(commit, user, location);
+            if (!content) {
+                continue;
+            }
+
+            const parseResult = this.devcontainerParser.parse(content, location);
+            if (parseResult.validationErrors) {
+                const err = new InvalidGitpodYMLError({
+                    violations: parseResult.validationErrors,
+                });
+                // this is not a system error but a user misconfiguration
+                log.info(logContext, err.message, {
+                    repoCloneUrl: commit.repository.cloneUrl,
+                    revision: commit.revision,
+                    location,
+                });
+                throw err;
+            }
+            if (parseResult.warnings.length > 0) {
+                log.info(logContext, "Dev container config contains unsupported properties", {
+                    repoCloneUrl: commit.repository.cloneUrl,
+                    revision: commit.revision,
+                    location,
+                    warnings: parseResult.warnings,
+                });
+            }
+
+            const config = parseResult.config;
+            config._origin = "devcontainer";
+            return config;
+        }
+        return undefined;
+    }
+
     public async defaultConfig(organizationId?: string): Promise<WorkspaceConfig> {
         return {
             ports: [],
@@ -171,6 +171,9 @@ export class ContextParser {
                     subRepoCommits.push({
                         ...subContext,
                         checkoutLocation: subRepo.checkoutLocation || subContext.repository.name,
+                        sparseCheckout: subRepo.sparseCheckout,
+                        cloneFilter: subRepo.cloneFilter,
+                        gitLfs: subRepo.gitLfs,
                         upstreamRemoteURI: this.buildUpstreamCloneUrl(subContext),
                         // we want to create a local branch on all repos, in case it's a multi-repo change. If it's not there are no drawbacks anyway.
                         ref: context.ref,
@@ -186,6 +189,9 @@ export class ContextParser {
                 context.revision = mainRepoContext.revision;
             }
             context.checkoutLocation = config.config.checkoutLocation || context.repository.name;
+            context.sparseCheckout = config.config.sparseCheckout;
+            context.cloneFilter = config.config.cloneFilter;
+            context.gitLfs = config.config.gitLfs;
             context.upstreamRemoteURI = this.buildUpstreamCloneUrl(context);
             if (!context.warnings) {
                 context.warnings = [];
@@ -1015,6 +1015,17 @@ export class GitpodServerImpl implements GitpodServerWithTracing, Disposable {
         );
     }
 
+    public async resizeWorkspaceDisk(ctx: TraceContext, workspaceId: string, size: string): Promise<void> {
+        traceAPIParams(ctx, { workspaceId, size });
+        traceWI(ctx, { workspaceId });
+
+        const user = await this.checkUser("resizeWorkspaceDisk");
+
+        return this.workspaceService.resizeWorkspaceDisk(user.id, workspaceId, size, (instance, workspace) =>
+            this.guardAccess({ kind: "workspaceInstance", subject: instance, workspace }, "update"),
+        );
+    }
+
     public async getOpenPorts(ctx: TraceContext, workspaceId: string): Promise<WorkspaceInstancePort[]> {
         traceAPIParams(ctx, { workspaceId });
         traceWI(ctx, { workspaceId });
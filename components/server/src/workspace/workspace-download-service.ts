@@ -6,6 +6,7 @@
 
 import { injectable, inject } from "inversify";
 import express from "express";
+import { status } from "@grpc/grpc-js";
 import { TracedWorkspaceDB, DBWithTracing, WorkspaceDB } from "@gitpod/gitpod-db/lib";
 import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
 import { Permission, User } from "@gitpod/gitpod-protocol";
@@ -54,6 +55,14 @@ export class WorkspaceDownloadService {
                 log.info({ workspaceId, userId }, "user is downloading workspace content");
                 res.send(signedUrl);
             } catch (err) {
+                if (err.code === status.UNAVAILABLE) {
+                    // chunked backups are assembled in the background before they can be downloaded
+                    res.setHeader("Retry-After", "30");
+                    res.status(503).send(
+                        "The workspace content is being prepared for download, please try again in a minute.",
+                    );
+                    return;
+                }
                 log.error({ workspaceId }, "cannot prepare workspace download", err);
                 res.sendStatus(500);
             }
@@ -44,6 +44,7 @@ import {
     PortSpec,
     ControlPortRequest,
     SetTimeoutRequest,
+    ResizeWorkspaceDiskRequest,
     MarkActiveRequest,
     AdmissionLevel,
     ControlAdmissionRequest,
@@ -736,6 +737,11 @@ export class WorkspaceService {
         if (port.protocol) {
             spec.setProtocol(this.portProtocolToProto(port.protocol));
         }
+        // changing the visibility or protocol of a port must not revoke its share links
+        const shareId = port.shareId ?? instance.status.exposedPorts?.find((p) => p.port === port.port)?.shareId;
+        if (shareId) {
+            spec.setShareId(shareId);
+        }
         req.setSpec(spec);
         req.setExpose(true);
 
@@ -776,6 +782,8 @@ export class WorkspaceService {
                 return "private";
             case ProtoPortVisibility.PORT_VISIBILITY_PUBLIC:
                 return "public";
+            case ProtoPortVisibility.PORT_VISIBILITY_ORGANIZATION:
+                return "organization";
         }
     }
 
@@ -786,6 +794,8 @@ export class WorkspaceService {
                 return ProtoPortVisibility.PORT_VISIBILITY_PRIVATE;
             case "public":
                 return ProtoPortVisibility.PORT_VISIBILITY_PUBLIC;
+            case "organization":
+                return ProtoPortVisibility.PORT_VISIBILITY_ORGANIZATION;
         }
     }
 
@@ -796,6 +806,10 @@ export class WorkspaceService {
                 return "http";
             case ProtoPortProtocol.PORT_PROTOCOL_HTTPS:
                 return "https";
+            case ProtoPortProtocol.PORT_PROTOCOL_TCP:
+                return "tcp";
+            case ProtoPortProtocol.PORT_PROTOCOL_UDP:
+                return "udp";
         }
     }
 
@@ -806,6 +820,10 @@ export class WorkspaceService {
                 return ProtoPortProtocol.PORT_PROTOCOL_HTTP;
             case "https":
                 return ProtoPortProtocol.PORT_PROTOCOL_HTTPS;
+            case "tcp":
+                return ProtoPortProtocol.PORT_PROTOCOL_TCP;
+            case "udp":
+                return ProtoPortProtocol.PORT_PROTOCOL_UDP;
         }
     }
 
@@ -1094,6 +1112,44 @@ export class WorkspaceService {
         };
     }
 
+    public async resizeWorkspaceDisk(
+        userId: string,
+        workspaceId: string,
+        size: string,
+        check: (instance: WorkspaceInstance, workspace: Workspace) => Promise<void> = async () => {},
+    ): Promise<void> {
+        await this.auth.checkPermissionOnWorkspace(userId, "access", workspaceId);
+
+        const workspace = await this.doGetWorkspace(userId, workspaceId);
+        const instance = await this.getCurrentInstance(userId, workspaceId);
+        if (instance.status.phase !== "running") {
+            throw new ApplicationError(
+                ErrorCodes.PRECONDITION_FAILED,
+                "Can only resize the disk of running workspaces",
+            );
+        }
+        await check(instance, workspace);
+
+        const req = new ResizeWorkspaceDiskRequest();
+        req.setId(instance.id);
+        req.setSize(size);
+        try {
+            const client = await this.clientProvider.get(instance.region);
+            await client.resizeWorkspaceDisk({}, req);
+        } catch (e) {
+            if (isGrpcError(e)) {
+                switch (e.code) {
+                    case grpc.status.INVALID_ARGUMENT:
+                    case grpc.status.OUT_OF_RANGE:
+                        throw new ApplicationError(ErrorCodes.BAD_REQUEST, e.details);
+                    case grpc.status.FAILED_PRECONDITION:
+                        throw new ApplicationError(ErrorCodes.PRECONDITION_FAILED, e.details);
+                }
+            }
+            throw mapGrpcError(e);
+        }
+    }
+
     // TODO(gpl) We probably want to change this method to take a workspaceId instead once we migrate the API
     public async getHeadlessLog(
         userId: string,
@@ -44,6 +44,7 @@ import {
     NamedWorkspaceFeatureFlag,
     Permission,
     Project,
+    PullRequestContext,
     RefType,
     SnapshotContext,
     StartWorkspaceResult,
@@ -52,6 +53,7 @@ import {
     WithPrebuild,
     WithReferrerContext,
     Workspace,
+    WorkspaceConfig,
     WorkspaceContext,
     WorkspaceImageSource,
     WorkspaceImageSourceDocker,
@@ -78,6 +80,7 @@ import {
     BuildResponse,
     BuildSource,
     BuildSourceDockerfile,
+    BuildSourceFeature,
     BuildSourceReference,
     BuildStatus,
     ImageBuilderClientProvider,
@@ -1199,6 +1202,7 @@ export class WorkspaceStarter {
 
                 const src = new BuildSource();
                 src.setFile(file);
+                src.setFeaturesList(this.createBuildFeatures(workspace.config));
                 return { src, auth, disposable: disp };
             }
             if (WorkspaceImageSourceReference.is(imgsrc)) {
@@ -1207,6 +1211,7 @@ export class WorkspaceStarter {
 
                 const src = new BuildSource();
                 src.setRef(ref);
+                src.setFeaturesList(this.createBuildFeatures(workspace.config));
                 return { src, auth };
             }
 
@@ -1219,6 +1224,23 @@ export class WorkspaceStarter {
         }
     }
 
+    /**
+     * createBuildFeatures turns the dev container features of a workspace config into build features, which the
+     * image builder installs on top of the workspace image. They're sorted by their ref because they're part of the image ref.
+     */
+    private createBuildFeatures(config: WorkspaceConfig): BuildSourceFeature[] {
+        return Object.keys(config.features || {})
+            .sort()
+            .map((ref) => {
+                const feature = new BuildSourceFeature();
+                feature.setRef(ref);
+                for (const [option, value] of Object.entries(config.features![ref] || {})) {
+                    feature.getOptionsMap().set(option, String(value));
+                }
+                return feature;
+            });
+    }
+
     private async buildWorkspaceImage(
         ctx: TraceContext,
         user: User,
@@ -1255,6 +1277,12 @@ export class WorkspaceStarter {
             req.setForceRebuild(forceRebuild);
             req.setTriggeredBy(user.id);
             req.setUseRetryClient(useRetryClient);
+            req.setPlatformsList(await this.getImageBuildPlatforms(user));
+            if (workspace.projectId) {
+                // all image builds of a project share their build cache
+                req.setCacheScope(workspace.projectId);
+                req.setCacheImportOnly(!(await this.isTrustedImageBuild(workspace)));
+            }
             if (!ignoreBaseImageresolvedAndRebuildBase && !forceRebuild && workspace.baseImageNameResolved) {
                 req.setBaseImageNameResolved(workspace.baseImageNameResolved);
             }
@@ -1359,6 +1387,10 @@ export class WorkspaceStarter {
             if (result.actuallyNeedsBuild) {
                 increaseImageBuildsCompletedTotal("succeeded");
             }
+            const cache = buildResult.getInfo()?.getCache();
+            if (cache) {
+                span.log({ buildCacheSteps: cache.getSteps(), buildCacheCached: cache.getCached() });
+            }
 
             // We have just found out how our base image is called - remember that.
             // Note: it's intentional that we overwrite existing baseImageNameResolved values here so that one by one the refs here become absolute (i.e. digested form).
@@ -1549,14 +1581,8 @@ export class WorkspaceStarter {
 
                 const spec = new PortSpec();
                 spec.setPort(p.port);
-                spec.setVisibility(
-                    p.visibility == "public"
-                        ? PortVisibility.PORT_VISIBILITY_PUBLIC
-                        : PortVisibility.PORT_VISIBILITY_PRIVATE,
-                );
-                spec.setProtocol(
-                    p.protocol == "https" ? PortProtocol.PORT_PROTOCOL_HTTPS : PortProtocol.PORT_PROTOCOL_HTTP,
-                );
+                spec.setVisibility(portVisibilityToProto(p.visibility));
+                spec.setProtocol(portProtocolToProto(p.protocol));
                 return spec;
             })
             .filter((spec) => !!spec) as PortSpec[];
@@ -1711,6 +1737,7 @@ export class WorkspaceStarter {
             "function:controlAdmission",
             "function:setWorkspaceTimeout",
             "function:getWorkspaceTimeout",
+            "function:resizeWorkspaceDisk",
             "function:sendHeartBeat",
             "function:getOpenPorts",
             "function:openPort",
@@ -2031,6 +2058,17 @@ export class WorkspaceStarter {
         }
         result.setConfig(gitConfig);
         result.setCheckoutLocation(context.checkoutLocation || context.repository.name);
+        if (context.sparseCheckout && context.sparseCheckout.length > 0) {
+            result.setSparseCheckoutPatternsList(context.sparseCheckout);
+        }
+        if (!!context.cloneFilter) {
+            result.setFilter(context.cloneFilter);
+        }
+        if (!!context.gitLfs) {
+            result.setLfsIncludeList(context.gitLfs.include || []);
+            result.setLfsExcludeList(context.gitLfs.exclude || []);
+            result.setLfsSkipFetch(!!context.gitLfs.skipFetch);
+        }
         if (!!cloneTarget) {
             result.setCloneTaget(cloneTarget);
         }
@@ -2096,6 +2134,7 @@ export class WorkspaceStarter {
         const req = new ResolveBaseImageRequest();
         req.setRef(imageRef);
         req.setUseRetryClient(useRetryClient);
+        req.setPlatformsList(await this.getImageBuildPlatforms(user));
         const allowAll = new BuildRegistryAuthTotal();
         allowAll.setAllowAll(true);
         const auth = new BuildRegistryAuth();
@@ -2114,6 +2153,32 @@ export class WorkspaceStarter {
         return client.resolveBaseImage({ span: ctx.span }, req);
     }
 
+    /**
+     * Returns the platforms workspace images are built for, e.g. ["linux/amd64", "linux/arm64"].
+     * An empty list makes image-builder build for its default platform only.
+     */
+    private async getImageBuildPlatforms(user: User): Promise<string[]> {
+        const platforms = await getExperimentsClientForBackend().getValueAsync("imagebuilder_platforms", "", { user });
+        return platforms
+            .split(",")
+            .map((p) => p.trim())
+            .filter((p) => !!p);
+    }
+
+    /**
+     * Returns true if the image build of the workspace may add to the build cache of its project. Only builds of the
+     * project's default branch are trusted: anyone who can open a pull request or push a branch could otherwise
+     * poison the cache all other builds of the project use.
+     */
+    private async isTrustedImageBuild(workspace: Workspace): Promise<boolean> {
+        const context = workspace.context;
+        if (!workspace.projectId || !CommitContext.is(context) || PullRequestContext.is(context)) {
+            return false;
+        }
+        const project = await this.projectDB.findProjectById(workspace.projectId);
+        return project?.cloneUrl === context.repository.cloneUrl && CommitContext.isDefaultBranch(context);
+    }
+
     private async existsWithWsManager(ctx: TraceContext, instance: WorkspaceInstance): Promise<boolean> {
         try {
             const req = new DescribeWorkspaceRequest();
@@ -2158,6 +2223,30 @@ export class ScmStartError extends Error {
     }
 }
 
+function portVisibilityToProto(visibility: string | undefined): PortVisibility {
+    switch (visibility) {
+        case "public":
+            return PortVisibility.PORT_VISIBILITY_PUBLIC;
+        case "organization":
+            return PortVisibility.PORT_VISIBILITY_ORGANIZATION;
+        default:
+            return PortVisibility.PORT_VISIBILITY_PRIVATE;
+    }
+}
+
+function portProtocolToProto(protocol: string | undefined): PortProtocol {
+    switch (protocol) {
+        case "https":
+            return PortProtocol.PORT_PROTOCOL_HTTPS;
+        case "tcp":
+            return PortProtocol.PORT_PROTOCOL_TCP;
+        case "udp":
+            return PortProtocol.PORT_PROTOCOL_UDP;
+        default:
+            return PortProtocol.PORT_PROTOCOL_HTTP;
+    }
+}
+
 function newEnvVar(key: string, value: string): EnvironmentVariable {
     const env = new EnvironmentVariable();
     env.setName(key);
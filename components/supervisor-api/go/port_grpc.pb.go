@@ -37,6 +37,19 @@ type PortServiceClient interface {
 	AutoTunnel(ctx context.Context, in *AutoTunnelRequest, opts ...grpc.CallOption) (*AutoTunnelResponse, error)
 	// RetryAutoExpose retries auto exposing the give port
 	RetryAutoExpose(ctx context.Context, in *RetryAutoExposeRequest, opts ...grpc.CallOption) (*RetryAutoExposeResponse, error)
+	// SetInspection enables or disables capturing the HTTP requests ws-proxy forwards to a port
+	SetInspection(ctx context.Context, in *SetPortInspectionRequest, opts ...grpc.CallOption) (*SetPortInspectionResponse, error)
+	// ListInspectedPorts returns the ports whose HTTP requests are captured
+	ListInspectedPorts(ctx context.Context, in *ListInspectedPortsRequest, opts ...grpc.CallOption) (*ListInspectedPortsResponse, error)
+	// RecordRequest adds an HTTP request and its response to the captures of an inspected port.
+	// ws-proxy calls this for the requests it forwards to inspected ports.
+	RecordRequest(ctx context.Context, in *RecordPortRequestRequest, opts ...grpc.CallOption) (*RecordPortRequestResponse, error)
+	// ListRequests returns the captured requests of a port, the most recent one first
+	ListRequests(ctx context.Context, in *ListPortRequestsRequest, opts ...grpc.CallOption) (*ListPortRequestsResponse, error)
+	// ReplayRequest sends a captured request to the port again. The replayed request is captured as well.
+	// The request is replayed as it was captured, i.e. with scrubbed credentials, so that requests which are
+	// authenticated or signed, e.g. webhooks, are likely rejected. Requests whose body was truncated are not replayed.
+	ReplayRequest(ctx context.Context, in *ReplayPortRequestRequest, opts ...grpc.CallOption) (*ReplayPortRequestResponse, error)
 }
 
 type portServiceClient struct {
@@ -114,6 +127,51 @@ func (c *portServiceClient) RetryAutoExpose(ctx context.Context, in *RetryAutoEx
 	return out, nil
 }
 
+func (c *portServiceClient) SetInspection(ctx context.Context, in *SetPortInspectionRequest, opts ...grpc.CallOption) (*SetPortInspectionResponse, error) {
+	out := new(SetPortInspectionResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/SetInspection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ListInspectedPorts(ctx context.Context, in *ListInspectedPortsRequest, opts ...grpc.CallOption) (*ListInspectedPortsResponse, error) {
+	out := new(ListInspectedPortsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ListInspectedPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) RecordRequest(ctx context.Context, in *RecordPortRequestRequest, opts ...grpc.CallOption) (*RecordPortRequestResponse, error) {
+	out := new(RecordPortRequestResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/RecordRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ListRequests(ctx context.Context, in *ListPortRequestsRequest, opts ...grpc.CallOption) (*ListPortRequestsResponse, error) {
+	out := new(ListPortRequestsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ListRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portServiceClient) ReplayRequest(ctx context.Context, in *ReplayPortRequestRequest, opts ...grpc.CallOption) (*ReplayPortRequestResponse, error) {
+	out := new(ReplayPortRequestResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.PortService/ReplayRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PortServiceServer is the server API for PortService service.
 // All implementations must embed UnimplementedPortServiceServer
 // for forward compatibility
@@ -129,6 +187,19 @@ type PortServiceServer interface {
 	AutoTunnel(context.Context, *AutoTunnelRequest) (*AutoTunnelResponse, error)
 	// RetryAutoExpose retries auto exposing the give port
 	RetryAutoExpose(context.Context, *RetryAutoExposeRequest) (*RetryAutoExposeResponse, error)
+	// SetInspection enables or disables capturing the HTTP requests ws-proxy forwards to a port
+	SetInspection(context.Context, *SetPortInspectionRequest) (*SetPortInspectionResponse, error)
+	// ListInspectedPorts returns the ports whose HTTP requests are captured
+	ListInspectedPorts(context.Context, *ListInspectedPortsRequest) (*ListInspectedPortsResponse, error)
+	// RecordRequest adds an HTTP request and its response to the captures of an inspected port.
+	// ws-proxy calls this for the requests it forwards to inspected ports.
+	RecordRequest(context.Context, *RecordPortRequestRequest) (*RecordPortRequestResponse, error)
+	// ListRequests returns the captured requests of a port, the most recent one first
+	ListRequests(context.Context, *ListPortRequestsRequest) (*ListPortRequestsResponse, error)
+	// ReplayRequest sends a captured request to the port again. The replayed request is captured as well.
+	// The request is replayed as it was captured, i.e. with scrubbed credentials, so that requests which are
+	// authenticated or signed, e.g. webhooks, are likely rejected. Requests whose body was truncated are not replayed.
+	ReplayRequest(context.Context, *ReplayPortRequestRequest) (*ReplayPortRequestResponse, error)
 	mustEmbedUnimplementedPortServiceServer()
 }
 
@@ -151,6 +222,21 @@ func (UnimplementedPortServiceServer) AutoTunnel(context.Context, *AutoTunnelReq
 func (UnimplementedPortServiceServer) RetryAutoExpose(context.Context, *RetryAutoExposeRequest) (*RetryAutoExposeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryAutoExpose not implemented")
 }
+func (UnimplementedPortServiceServer) SetInspection(context.Context, *SetPortInspectionRequest) (*SetPortInspectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInspection not implemented")
+}
+func (UnimplementedPortServiceServer) ListInspectedPorts(context.Context, *ListInspectedPortsRequest) (*ListInspectedPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInspectedPorts not implemented")
+}
+func (UnimplementedPortServiceServer) RecordRequest(context.Context, *RecordPortRequestRequest) (*RecordPortRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRequest not implemented")
+}
+func (UnimplementedPortServiceServer) ListRequests(context.Context, *ListPortRequestsRequest) (*ListPortRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRequests not implemented")
+}
+func (UnimplementedPortServiceServer) ReplayRequest(context.Context, *ReplayPortRequestRequest) (*ReplayPortRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayRequest not implemented")
+}
 func (UnimplementedPortServiceServer) mustEmbedUnimplementedPortServiceServer() {}
 
 // UnsafePortServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -262,6 +348,96 @@ func _PortService_RetryAutoExpose_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortService_SetInspection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPortInspectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).SetInspection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/SetInspection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).SetInspection(ctx, req.(*SetPortInspectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ListInspectedPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInspectedPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ListInspectedPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ListInspectedPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ListInspectedPorts(ctx, req.(*ListInspectedPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_RecordRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPortRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).RecordRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/RecordRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).RecordRequest(ctx, req.(*RecordPortRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ListRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ListRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ListRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ListRequests(ctx, req.(*ListPortRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortService_ReplayRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayPortRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortServiceServer).ReplayRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.PortService/ReplayRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortServiceServer).ReplayRequest(ctx, req.(*ReplayPortRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PortService_ServiceDesc is the grpc.ServiceDesc for PortService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +461,26 @@ var PortService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetryAutoExpose",
 			Handler:    _PortService_RetryAutoExpose_Handler,
 		},
+		{
+			MethodName: "SetInspection",
+			Handler:    _PortService_SetInspection_Handler,
+		},
+		{
+			MethodName: "ListInspectedPorts",
+			Handler:    _PortService_ListInspectedPorts_Handler,
+		},
+		{
+			MethodName: "RecordRequest",
+			Handler:    _PortService_RecordRequest_Handler,
+		},
+		{
+			MethodName: "ListRequests",
+			Handler:    _PortService_ListRequests_Handler,
+		},
+		{
+			MethodName: "ReplayRequest",
+			Handler:    _PortService_ReplayRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -45,6 +45,10 @@ type TerminalServiceClient interface {
 	SetTitle(ctx context.Context, in *SetTerminalTitleRequest, opts ...grpc.CallOption) (*SetTerminalTitleResponse, error)
 	// UpdateAnnotations updates the terminal's annotations
 	UpdateAnnotations(ctx context.Context, in *UpdateTerminalAnnotationsRequest, opts ...grpc.CallOption) (*UpdateTerminalAnnotationsResponse, error)
+	// StartRecording starts recording a terminal in the asciicast v2 format
+	StartRecording(ctx context.Context, in *StartTerminalRecordingRequest, opts ...grpc.CallOption) (*StartTerminalRecordingResponse, error)
+	// StopRecording stops recording a terminal
+	StopRecording(ctx context.Context, in *StopTerminalRecordingRequest, opts ...grpc.CallOption) (*StopTerminalRecordingResponse, error)
 }
 
 type terminalServiceClient struct {
@@ -159,6 +163,24 @@ func (c *terminalServiceClient) UpdateAnnotations(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *terminalServiceClient) StartRecording(ctx context.Context, in *StartTerminalRecordingRequest, opts ...grpc.CallOption) (*StartTerminalRecordingResponse, error) {
+	out := new(StartTerminalRecordingResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TerminalService/StartRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) StopRecording(ctx context.Context, in *StopTerminalRecordingRequest, opts ...grpc.CallOption) (*StopTerminalRecordingResponse, error) {
+	out := new(StopTerminalRecordingResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TerminalService/StopRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TerminalServiceServer is the server API for TerminalService service.
 // All implementations must embed UnimplementedTerminalServiceServer
 // for forward compatibility
@@ -182,6 +204,10 @@ type TerminalServiceServer interface {
 	SetTitle(context.Context, *SetTerminalTitleRequest) (*SetTerminalTitleResponse, error)
 	// UpdateAnnotations updates the terminal's annotations
 	UpdateAnnotations(context.Context, *UpdateTerminalAnnotationsRequest) (*UpdateTerminalAnnotationsResponse, error)
+	// StartRecording starts recording a terminal in the asciicast v2 format
+	StartRecording(context.Context, *StartTerminalRecordingRequest) (*StartTerminalRecordingResponse, error)
+	// StopRecording stops recording a terminal
+	StopRecording(context.Context, *StopTerminalRecordingRequest) (*StopTerminalRecordingResponse, error)
 	mustEmbedUnimplementedTerminalServiceServer()
 }
 
@@ -216,6 +242,12 @@ func (UnimplementedTerminalServiceServer) SetTitle(context.Context, *SetTerminal
 func (UnimplementedTerminalServiceServer) UpdateAnnotations(context.Context, *UpdateTerminalAnnotationsRequest) (*UpdateTerminalAnnotationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnotations not implemented")
 }
+func (UnimplementedTerminalServiceServer) StartRecording(context.Context, *StartTerminalRecordingRequest) (*StartTerminalRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedTerminalServiceServer) StopRecording(context.Context, *StopTerminalRecordingRequest) (*StopTerminalRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
 func (UnimplementedTerminalServiceServer) mustEmbedUnimplementedTerminalServiceServer() {}
 
 // UnsafeTerminalServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +426,42 @@ func _TerminalService_UpdateAnnotations_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTerminalRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TerminalService/StartRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).StartRecording(ctx, req.(*StartTerminalRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTerminalRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TerminalService/StopRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).StopRecording(ctx, req.(*StopTerminalRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TerminalService_ServiceDesc is the grpc.ServiceDesc for TerminalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -433,6 +501,14 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAnnotations",
 			Handler:    _TerminalService_UpdateAnnotations_Handler,
 		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _TerminalService_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _TerminalService_StopRecording_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gitpod-io/gitpod/supervisor/pkg/supervisor"
+)
+
+var sftpServerCmd = &cobra.Command{
+	Use:    "sftp-server",
+	Short:  "serves SFTP on stdin/stdout, started by sshd as sftp subsystem",
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := supervisor.ServeSFTP(stdio{})
+		if err != nil {
+			// stderr is forwarded to the SSH client, we must not log to the supervisor's termination log
+			fmt.Fprintf(os.Stderr, "sftp-server: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// stdio reads from stdin and writes to stdout
+type stdio struct{}
+
+func (stdio) Read(p []byte) (int, error)  { return os.Stdin.Read(p) }
+func (stdio) Write(p []byte) (int, error) { return os.Stdout.Write(p) }
+func (stdio) Close() error {
+	_ = os.Stdin.Close()
+	return os.Stdout.Close()
+}
+
+func init() {
+	rootCmd.AddCommand(sftpServerCmd)
+}
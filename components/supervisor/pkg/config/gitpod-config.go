@@ -35,23 +35,77 @@ type ConfigService struct {
 	configLocation string
 	configWatcher  *fileWatcher[gitpod.GitpodConfig]
 
+	// devcontainerLocation is the dev container config we read the config from if there is no .gitpod.yml.
+	// It's decided once the location is ready and does not change afterwards.
+	devcontainerLocation string
+
+	// warnings explain which parts of the dev container config the current config was read from are ignored
+	warningsMu sync.Mutex
+	warnings   []string
+
 	imageWatcher *fileWatcher[struct{}]
 }
 
 // NewConfigService creates a new instance of ConfigService.
 func NewConfigService(configLocation string, locationReady <-chan struct{}) *ConfigService {
-	return &ConfigService{
+	service := &ConfigService{
 		locationReady:  locationReady,
 		configLocation: configLocation,
-		configWatcher: newFileWatcher(func(data []byte) (*gitpod.GitpodConfig, error) {
-			var config *gitpod.GitpodConfig
-			err := yaml.Unmarshal(data, &config)
-			return config, err
-		}),
 		imageWatcher: newFileWatcher(func(data []byte) (*struct{}, error) {
 			return &struct{}{}, nil
 		}),
 	}
+	service.configWatcher = newFileWatcher(service.unmarshalConfig)
+	return service
+}
+
+func (service *ConfigService) unmarshalConfig(data []byte) (*gitpod.GitpodConfig, error) {
+	if service.devcontainerLocation == "" {
+		var config *gitpod.GitpodConfig
+		err := yaml.Unmarshal(data, &config)
+		return config, err
+	}
+
+	devcontainer, err := gitpod.ParseDevcontainer(data)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := filepath.Rel(filepath.Dir(service.configLocation), filepath.Dir(service.devcontainerLocation))
+	if err != nil {
+		return nil, err
+	}
+	config, warnings := devcontainer.GitpodConfig(filepath.ToSlash(dir))
+	for _, w := range warnings {
+		log.WithField("location", service.devcontainerLocation).Warn(w)
+	}
+	service.warningsMu.Lock()
+	service.warnings = warnings
+	service.warningsMu.Unlock()
+	return config, nil
+}
+
+// Warnings returns which parts of the dev container config the current config was read from are ignored.
+func (service *ConfigService) Warnings() []string {
+	service.warningsMu.Lock()
+	defer service.warningsMu.Unlock()
+
+	return append([]string(nil), service.warnings...)
+}
+
+// findDevcontainer returns the location of the dev container config to read the config from,
+// or an empty string if there's a .gitpod.yml or no dev container config.
+func (service *ConfigService) findDevcontainer() string {
+	if _, err := os.Stat(service.configLocation); !os.IsNotExist(err) {
+		return ""
+	}
+	root := filepath.Dir(service.configLocation)
+	for _, loc := range gitpod.DevcontainerLocations {
+		fn := filepath.Join(root, loc)
+		if _, err := os.Stat(fn); err == nil {
+			return fn
+		}
+	}
+	return ""
 }
 
 // Observe provides channels triggered whenever the config is changed.
@@ -71,8 +125,14 @@ func (service *ConfigService) Watch(ctx context.Context) {
 	case <-ctx.Done():
 		return
 	}
+	location := service.configLocation
+	if devcontainer := service.findDevcontainer(); devcontainer != "" {
+		log.WithField("location", devcontainer).Info("no .gitpod.yml found, reading config from devcontainer.json")
+		service.devcontainerLocation = devcontainer
+		location = devcontainer
+	}
 	go service.watchImageFile(ctx)
-	service.configWatcher.watch(ctx, service.configLocation)
+	service.configWatcher.watch(ctx, location)
 }
 
 func (service *ConfigService) watchImageFile(ctx context.Context) {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -227,6 +228,49 @@ ports:
 	}
 }
 
+func TestDevcontainerConfig(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "test-gitpod-config-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	err = os.MkdirAll(filepath.Join(tempDir, ".devcontainer"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(tempDir, ".devcontainer", "devcontainer.json"), []byte(`{
+	// the config is JSON with comments
+	"build": { "dockerfile": "Dockerfile" },
+	"forwardPorts": [8080],
+	"mounts": ["source=cache,target=/cache,type=volume"],
+}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationReady := make(chan struct{})
+	configService := NewConfigService(tempDir+"/.gitpod.yml", locationReady)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	close(locationReady)
+
+	go configService.Watch(ctx)
+
+	listener := configService.Observe(ctx)
+
+	config := <-listener
+	if diff := cmp.Diff(&gitpod.GitpodConfig{
+		Image: map[string]interface{}{"file": ".devcontainer/Dockerfile", "context": ".devcontainer"},
+		Ports: []*gitpod.PortsItems{{Port: 8080}},
+	}, config); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{`devcontainer.json: "mounts" is not supported and will be ignored`}, configService.Warnings()); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+	}
+}
+
 func TestWatchImageFile(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "test-gitpod-config-*")
 	if err != nil {
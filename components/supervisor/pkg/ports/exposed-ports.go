@@ -18,10 +18,10 @@ import (
 
 // ExposedPort represents an exposed pprt
 type ExposedPort struct {
-	LocalPort uint32
-	URL       string
-	Public    bool
-	Protocol  string
+	LocalPort  uint32
+	URL        string
+	Visibility string
+	Protocol   string
 }
 
 // ExposedPortsInterface provides access to port exposure
@@ -36,8 +36,8 @@ type ExposedPortsInterface interface {
 	// Run starts listening to expose port requests.
 	Run(ctx context.Context)
 
-	// Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-	Expose(ctx context.Context, port uint32, public bool, protocol string) <-chan error
+	// Expose exposes a port with the given visibility. Upon successful execution any Observer will be updated.
+	Expose(ctx context.Context, port uint32, visibility string, protocol string) <-chan error
 }
 
 // NoopExposedPorts implements ExposedPortsInterface but does nothing
@@ -52,7 +52,7 @@ func (*NoopExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort, <-c
 func (*NoopExposedPorts) Run(ctx context.Context) {}
 
 // Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (*NoopExposedPorts) Expose(ctx context.Context, local uint32, public bool, protocol string) <-chan error {
+func (*NoopExposedPorts) Expose(ctx context.Context, local uint32, visibility string, protocol string) <-chan error {
 	done := make(chan error)
 	close(done)
 	return done
@@ -104,13 +104,22 @@ func (g *GitpodExposedPorts) getPortUrl(port uint32) string {
 
 func (g *GitpodExposedPorts) getPortProtocol(protocol string) string {
 	switch protocol {
-	case gitpod.PortProtocolHTTP, gitpod.PortProtocolHTTPS:
+	case gitpod.PortProtocolHTTP, gitpod.PortProtocolHTTPS, gitpod.PortProtocolTCP, gitpod.PortProtocolUDP:
 		return protocol
 	default:
 		return gitpod.PortProtocolHTTP
 	}
 }
 
+func (g *GitpodExposedPorts) getPortVisibility(visibility string) string {
+	switch visibility {
+	case gitpod.PortVisibilityPublic, gitpod.PortVisibilityPrivate, gitpod.PortVisibilityOrganization:
+		return visibility
+	default:
+		return gitpod.PortVisibilityPrivate
+	}
+}
+
 func (g *GitpodExposedPorts) existInLocalExposed(port uint32) bool {
 	for _, p := range g.localExposedPort {
 		if p == port {
@@ -140,19 +149,19 @@ func (g *GitpodExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 			res := make(map[uint32]ExposedPort)
 			for _, port := range g.localExposedPort {
 				res[port] = ExposedPort{
-					LocalPort: port,
-					Public:    false,
-					URL:       g.getPortUrl(port),
-					Protocol:  gitpod.PortProtocolHTTP,
+					LocalPort:  port,
+					Visibility: gitpod.PortVisibilityPrivate,
+					URL:        g.getPortUrl(port),
+					Protocol:   gitpod.PortProtocolHTTP,
 				}
 			}
 
 			for _, p := range serverExposePort {
 				res[uint32(p.Port)] = ExposedPort{
-					LocalPort: uint32(p.Port),
-					Public:    p.Visibility == "public",
-					URL:       g.getPortUrl(uint32(p.Port)),
-					Protocol:  g.getPortProtocol(p.Protocol),
+					LocalPort:  uint32(p.Port),
+					Visibility: g.getPortVisibility(p.Visibility),
+					URL:        g.getPortUrl(uint32(p.Port)),
+					Protocol:   g.getPortProtocol(p.Protocol),
 				}
 			}
 			exposedPort := make([]ExposedPort, 0, len(res))
@@ -238,11 +247,11 @@ func (g *GitpodExposedPorts) doExpose(req *exposePortRequest) {
 }
 
 // Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, public bool, protocol string) <-chan error {
-	if protocol != gitpod.PortProtocolHTTPS && protocol != gitpod.PortProtocolHTTP {
-		protocol = gitpod.PortProtocolHTTP
-	}
-	if !public && protocol != gitpod.PortProtocolHTTPS {
+func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, visibility string, protocol string) <-chan error {
+	protocol = g.getPortProtocol(protocol)
+	visibility = g.getPortVisibility(visibility)
+	// private tcp and udp ports are registered as well, since their protocol must be known to the port tunnel
+	if visibility == gitpod.PortVisibilityPrivate && protocol == gitpod.PortProtocolHTTP {
 		if !g.existInLocalExposed(local) {
 			g.localExposedPort = append(g.localExposedPort, local)
 			g.localExposedNotice <- struct{}{}
@@ -251,10 +260,6 @@ func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, public bo
 		close(c)
 		return c
 	}
-	visibility := gitpod.PortVisibilityPrivate
-	if public {
-		visibility = gitpod.PortVisibilityPublic
-	}
 	req := &exposePortRequest{
 		port: &gitpod.WorkspaceInstancePort{
 			Port:       float64(local),
@@ -0,0 +1,233 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// MaxCapturedBodySize is the number of bytes of a request or response body which are captured
+const MaxCapturedBodySize = 64 * 1024
+
+var (
+	// ErrPortNotInspected is returned when a port's requests are not captured
+	ErrPortNotInspected = xerrors.New("port is not inspected")
+	// ErrExchangeNotFound is returned when a captured request does not exist (anymore)
+	ErrExchangeNotFound = xerrors.New("captured request not found")
+	// ErrBodyTruncated is returned when replaying a request whose body was not captured entirely
+	ErrBodyTruncated = xerrors.New("request body was truncated and cannot be replayed")
+)
+
+// hopHeaders are not replayed, because they describe the connection to ws-proxy rather than the request
+var hopHeaders = map[string]struct{}{
+	"Connection":          {},
+	"Keep-Alive":          {},
+	"Proxy-Connection":    {},
+	"Te":                  {},
+	"Trailer":             {},
+	"Transfer-Encoding":   {},
+	"Upgrade":             {},
+	"Content-Length":      {},
+	"Host":                {},
+	"Proxy-Authorization": {},
+}
+
+// RequestInspector keeps the last HTTP requests ws-proxy forwarded to inspected ports.
+type RequestInspector struct {
+	capacity int
+	client   *http.Client
+
+	mu    sync.Mutex
+	ports map[uint32][]*api.PortExchange
+	seq   uint64
+}
+
+// NewRequestInspector creates a new inspector which keeps up to capacity requests per port.
+func NewRequestInspector(capacity int) *RequestInspector {
+	return &RequestInspector{
+		capacity: capacity,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				// the redirect is part of the response we want to capture
+				return http.ErrUseLastResponse
+			},
+		},
+		ports: make(map[uint32][]*api.PortExchange),
+	}
+}
+
+// SetEnabled enables or disables capturing the requests of a port. Disabling it drops all captured requests.
+func (i *RequestInspector) SetEnabled(port uint32, enabled bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !enabled {
+		delete(i.ports, port)
+		return
+	}
+	if _, exists := i.ports[port]; !exists {
+		i.ports[port] = make([]*api.PortExchange, 0, i.capacity)
+	}
+}
+
+// Ports returns the inspected ports in ascending order.
+func (i *RequestInspector) Ports() []uint32 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	res := make([]uint32, 0, len(i.ports))
+	for p := range i.ports {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(a, b int) bool { return res[a] < res[b] })
+	return res
+}
+
+// Record adds an exchange to the captures of a port and assigns its ID.
+// If the port holds capacity exchanges already, the oldest one is dropped.
+func (i *RequestInspector) Record(port uint32, exchange *api.PortExchange) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	exchanges, ok := i.ports[port]
+	if !ok {
+		return ErrPortNotInspected
+	}
+
+	i.seq++
+	exchange.Id = strconv.FormatUint(i.seq, 10)
+	if len(exchanges) >= i.capacity {
+		exchanges = append(exchanges[:0], exchanges[len(exchanges)-i.capacity+1:]...)
+	}
+	i.ports[port] = append(exchanges, exchange)
+	return nil
+}
+
+// List returns the captured exchanges of a port, the most recent one first.
+func (i *RequestInspector) List(port uint32) ([]*api.PortExchange, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	exchanges, ok := i.ports[port]
+	if !ok {
+		return nil, ErrPortNotInspected
+	}
+	res := make([]*api.PortExchange, len(exchanges))
+	for idx, e := range exchanges {
+		res[len(exchanges)-1-idx] = e
+	}
+	return res, nil
+}
+
+func (i *RequestInspector) get(port uint32, id string) (*api.PortExchange, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	exchanges, ok := i.ports[port]
+	if !ok {
+		return nil, ErrPortNotInspected
+	}
+	for _, e := range exchanges {
+		if e.Id == id {
+			return e, nil
+		}
+	}
+	return nil, ErrExchangeNotFound
+}
+
+// Replay sends a captured request to the port on localhost using the given scheme, i.e. http or https.
+// The replayed request and its response are captured as a new exchange, which is returned.
+// Headers which were scrubbed when the request was captured are replayed as they were captured.
+func (i *RequestInspector) Replay(ctx context.Context, port uint32, id string, scheme string) (*api.PortExchange, error) {
+	captured, err := i.get(port, id)
+	if err != nil {
+		return nil, err
+	}
+	if captured.Request.GetBodyTruncated() {
+		return nil, ErrBodyTruncated
+	}
+
+	req, err := http.NewRequestWithContext(ctx, captured.Request.GetMethod(), fmt.Sprintf("%s://localhost:%d%s", scheme, port, captured.Request.GetUri()), bytes.NewReader(captured.Request.GetBody()))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create request: %w", err)
+	}
+	for _, h := range captured.Request.GetHeaders() {
+		name := http.CanonicalHeaderKey(h.Name)
+		if name == "Host" && len(h.Values) > 0 {
+			req.Host = h.Values[0]
+		}
+		if _, hop := hopHeaders[name]; hop {
+			continue
+		}
+		req.Header[name] = append([]string(nil), h.Values...)
+	}
+
+	exchange := &api.PortExchange{
+		Time:     timestamppb.Now(),
+		Request:  captured.Request,
+		Replayed: true,
+	}
+	start := time.Now()
+	resp, err := i.client.Do(req)
+	if err != nil {
+		exchange.Duration = durationpb.New(time.Since(start))
+		exchange.Error = err.Error()
+	} else {
+		exchange.Response, err = captureResponse(resp)
+		exchange.Duration = durationpb.New(time.Since(start))
+		if err != nil {
+			exchange.Error = err.Error()
+		}
+	}
+
+	err = i.Record(port, exchange)
+	if err != nil {
+		// inspection was disabled while we replayed the request
+		return nil, err
+	}
+	return exchange, nil
+}
+
+func captureResponse(resp *http.Response) (*api.CapturedResponse, error) {
+	defer resp.Body.Close()
+
+	res := &api.CapturedResponse{
+		StatusCode: uint32(resp.StatusCode),
+	}
+	names := make([]string, 0, len(resp.Header))
+	for name := range resp.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res.Headers = append(res.Headers, &api.HTTPHeader{Name: name, Values: resp.Header[name]})
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxCapturedBodySize+1))
+	if len(body) > MaxCapturedBodySize {
+		body = body[:MaxCapturedBodySize]
+		res.BodyTruncated = true
+	}
+	res.Body = body
+	if err != nil {
+		return res, xerrors.Errorf("cannot read response body: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestRequestInspector(t *testing.T) {
+	inspector := NewRequestInspector(2)
+
+	err := inspector.Record(3000, &api.PortExchange{})
+	if !xerrors.Is(err, ErrPortNotInspected) {
+		t.Fatalf("expected ErrPortNotInspected, got %v", err)
+	}
+
+	inspector.SetEnabled(8080, true)
+	inspector.SetEnabled(3000, true)
+	if diff := cmp.Diff([]uint32{3000, 8080}, inspector.Ports()); diff != "" {
+		t.Errorf("unexpected ports (-want +got):\n%s", diff)
+	}
+
+	for _, uri := range []string{"/a", "/b", "/c"} {
+		err = inspector.Record(3000, &api.PortExchange{Request: &api.CapturedRequest{Method: "GET", Uri: uri}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	exchanges, err := inspector.List(3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uris []string
+	for _, e := range exchanges {
+		uris = append(uris, e.Id+" "+e.Request.Uri)
+	}
+	if diff := cmp.Diff([]string{"3 /c", "2 /b"}, uris); diff != "" {
+		t.Errorf("unexpected exchanges (-want +got):\n%s", diff)
+	}
+
+	inspector.SetEnabled(3000, false)
+	_, err = inspector.List(3000)
+	if !xerrors.Is(err, ErrPortNotInspected) {
+		t.Errorf("expected captures to be dropped, got %v", err)
+	}
+	inspector.SetEnabled(3000, true)
+	exchanges, _ = inspector.List(3000)
+	if len(exchanges) != 0 {
+		t.Errorf("expected no captures after re-enabling inspection, got %d", len(exchanges))
+	}
+}
+
+func TestRequestInspectorReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Host", r.Host)
+		w.Header().Set("X-Token", r.Header.Get("X-Token"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.RequestURI() + " " + string(body)))
+	}))
+	defer srv.Close()
+	_, p, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	port64, _ := strconv.ParseUint(p, 10, 16)
+	port := uint32(port64)
+
+	inspector := NewRequestInspector(10)
+	inspector.SetEnabled(port, true)
+	_ = inspector.Record(port, &api.PortExchange{Request: &api.CapturedRequest{
+		Method: "POST",
+		Uri:    "/api?foo=bar",
+		Headers: []*api.HTTPHeader{
+			{Name: "Host", Values: []string{"3000-foo.ws.gitpod.io"}},
+			{Name: "X-Token", Values: []string{"[redacted]"}},
+		},
+		Body: []byte("hello"),
+	}})
+	_ = inspector.Record(port, &api.PortExchange{Request: &api.CapturedRequest{Method: "POST", Uri: "/", BodyTruncated: true}})
+
+	_, err := inspector.Replay(context.Background(), port, "2", "http")
+	if !xerrors.Is(err, ErrBodyTruncated) {
+		t.Errorf("expected ErrBodyTruncated, got %v", err)
+	}
+	_, err = inspector.Replay(context.Background(), port, "42", "http")
+	if !xerrors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("expected ErrExchangeNotFound, got %v", err)
+	}
+
+	replayed, err := inspector.Replay(context.Background(), port, "1", "http")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !replayed.Replayed || replayed.Id != "3" || replayed.Error != "" {
+		t.Errorf("unexpected exchange: %v", replayed)
+	}
+	resp := replayed.Response
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	if diff := cmp.Diff("POST /api?foo=bar hello", string(resp.Body)); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+	headers := make(map[string]string)
+	for _, h := range resp.Headers {
+		headers[h.Name] = h.Values[0]
+	}
+	if headers["X-Host"] != "3000-foo.ws.gitpod.io" || headers["X-Token"] != "[redacted]" {
+		t.Errorf("unexpected replayed headers: %v", headers)
+	}
+
+	exchanges, _ := inspector.List(port)
+	if len(exchanges) != 3 || exchanges[0] != replayed {
+		t.Errorf("expected replayed exchange to be recorded")
+	}
+}
@@ -7,10 +7,12 @@ package ports
 import (
 	"context"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
 
+	"github.com/gitpod-io/gitpod/common-go/log"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/config"
 )
@@ -25,6 +27,47 @@ type RangeConfig struct {
 	Sort  uint32
 }
 
+// forPort returns the config for a port in the range.
+func (rangeConfig *RangeConfig) forPort(port uint32) *SortConfig {
+	return &SortConfig{
+		PortConfig: gitpod.PortConfig{
+			Port:        float64(port),
+			OnOpen:      rangeConfig.OnOpen,
+			Visibility:  rangeConfig.Visibility,
+			Description: rangeConfig.Description,
+			Protocol:    rangeConfig.Protocol,
+			Name:        rangeConfig.Name,
+		},
+		Sort: rangeConfig.Sort,
+	}
+}
+
+// ProcessConfig is a port or port range config which only applies
+// to ports opened by a matching process.
+type ProcessConfig struct {
+	RangeConfig
+}
+
+// Matches returns true if the config applies to the port opened by the given process.
+func (processConfig *ProcessConfig) Matches(port uint32, process *PortProcess) bool {
+	if process == nil || port < processConfig.Start || processConfig.End < port {
+		return false
+	}
+	if processConfig.Process != "" {
+		matched, _ := path.Match(processConfig.Process, process.Name)
+		if !matched {
+			return false
+		}
+	}
+	if processConfig.CommandLine != "" {
+		matched, _ := regexp.MatchString(processConfig.CommandLine, process.CommandLine)
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
 // SortConfig is a port with a sort field
 type SortConfig struct {
 	gitpod.PortConfig
@@ -33,8 +76,9 @@ type SortConfig struct {
 
 // Configs provides access to port configurations.
 type Configs struct {
-	instancePortConfigs  map[uint32]*SortConfig
-	instanceRangeConfigs []*RangeConfig
+	instancePortConfigs    map[uint32]*SortConfig
+	instanceRangeConfigs   []*RangeConfig
+	instanceProcessConfigs []*ProcessConfig
 }
 
 // ForEach iterates over all configured ports.
@@ -74,22 +118,45 @@ func (configs *Configs) Get(port uint32) (*SortConfig, ConfigKind, bool) {
 	}
 	for _, rangeConfig := range configs.instanceRangeConfigs {
 		if rangeConfig.Start <= port && port <= rangeConfig.End {
-			return &SortConfig{
-				PortConfig: gitpod.PortConfig{
-					Port:        float64(port),
-					OnOpen:      rangeConfig.OnOpen,
-					Visibility:  rangeConfig.Visibility,
-					Description: rangeConfig.Description,
-					Protocol:    rangeConfig.Protocol,
-					Name:        rangeConfig.Name,
-				},
-				Sort: rangeConfig.Sort,
-			}, RangeConfigKind, true
+			return rangeConfig.forPort(port), RangeConfigKind, true
 		}
 	}
 	return nil, PortConfigKind, false
 }
 
+// GetForProcess returns the config for the given port opened by the given process.
+// A config for a single port takes precedence over a config for a port range and
+// within each of them, a config restricted to a process takes precedence over
+// the one which is not. Configs restricted to a process are never auto-exposed
+// ahead of time, hence they are of the RangeConfigKind.
+func (configs *Configs) GetForProcess(port uint32, process *PortProcess) (*SortConfig, ConfigKind, bool) {
+	if configs == nil {
+		return nil, PortConfigKind, false
+	}
+	if config := configs.getProcessConfig(port, process, true); config != nil {
+		return config, RangeConfigKind, true
+	}
+	if config, exists := configs.instancePortConfigs[port]; exists {
+		return config, PortConfigKind, true
+	}
+	if config := configs.getProcessConfig(port, process, false); config != nil {
+		return config, RangeConfigKind, true
+	}
+	return configs.Get(port)
+}
+
+func (configs *Configs) getProcessConfig(port uint32, process *PortProcess, singlePort bool) *SortConfig {
+	for _, processConfig := range configs.instanceProcessConfigs {
+		if (processConfig.Start == processConfig.End) != singlePort {
+			continue
+		}
+		if processConfig.Matches(port, process) {
+			return processConfig.forPort(port)
+		}
+	}
+	return nil
+}
+
 // ConfigInterace allows to watch port configurations.
 type ConfigInterace interface {
 	// Observe provides channels triggered whenever the port configurations are changed.
@@ -136,8 +203,9 @@ func (service *ConfigService) Observe(ctx context.Context) (<-chan *Configs, <-c
 					continue
 				}
 				updatesChan <- &Configs{
-					instancePortConfigs:  current.instancePortConfigs,
-					instanceRangeConfigs: current.instanceRangeConfigs,
+					instancePortConfigs:    current.instancePortConfigs,
+					instanceRangeConfigs:   current.instanceRangeConfigs,
+					instanceProcessConfigs: current.instanceProcessConfigs,
 				}
 			}
 		}
@@ -146,26 +214,35 @@ func (service *ConfigService) Observe(ctx context.Context) (<-chan *Configs, <-c
 }
 
 func (service *ConfigService) update(config *gitpod.GitpodConfig, current *Configs) bool {
-	currentPortConfigs, currentRangeConfigs := current.instancePortConfigs, current.instanceRangeConfigs
+	currentPortConfigs, currentRangeConfigs, currentProcessConfigs := current.instancePortConfigs, current.instanceRangeConfigs, current.instanceProcessConfigs
 	var ports []*gitpod.PortsItems
 	if config != nil {
 		ports = config.Ports
 	}
-	portConfigs, rangeConfigs := parseInstanceConfigs(ports)
+	portConfigs, rangeConfigs, processConfigs := parseInstanceConfigs(ports)
 	current.instancePortConfigs = portConfigs
 	current.instanceRangeConfigs = rangeConfigs
-	return !reflect.DeepEqual(currentPortConfigs, portConfigs) || !reflect.DeepEqual(currentRangeConfigs, rangeConfigs)
+	current.instanceProcessConfigs = processConfigs
+	return !reflect.DeepEqual(currentPortConfigs, portConfigs) || !reflect.DeepEqual(currentRangeConfigs, rangeConfigs) || !reflect.DeepEqual(currentProcessConfigs, processConfigs)
 }
 
 var portRangeRegexp = regexp.MustCompile(`^(\d+)[-:](\d+)$`)
 
-func parseInstanceConfigs(ports []*gitpod.PortsItems) (portConfigs map[uint32]*SortConfig, rangeConfigs []*RangeConfig) {
+func parseInstanceConfigs(ports []*gitpod.PortsItems) (portConfigs map[uint32]*SortConfig, rangeConfigs []*RangeConfig, processConfigs []*ProcessConfig) {
 	for index, config := range ports {
 		if config == nil {
 			continue
 		}
 
 		rawPort := fmt.Sprintf("%v", config.Port)
+		if config.Process != "" || config.CommandLine != "" {
+			processConfig := parseProcessConfig(rawPort, config, uint32(index))
+			if processConfig != nil {
+				processConfigs = append(processConfigs, processConfig)
+			}
+			continue
+		}
+
 		Port, err := strconv.ParseUint(rawPort, 10, 16)
 		if err == nil {
 			if portConfigs == nil {
@@ -207,5 +284,43 @@ func parseInstanceConfigs(ports []*gitpod.PortsItems) (portConfigs map[uint32]*S
 			Sort:       uint32(index),
 		})
 	}
-	return portConfigs, rangeConfigs
+	return portConfigs, rangeConfigs, processConfigs
+}
+
+func parseProcessConfig(rawPort string, config *gitpod.PortsItems, index uint32) *ProcessConfig {
+	if _, err := path.Match(config.Process, ""); err != nil {
+		log.WithError(err).WithField("process", config.Process).Warn("ignoring port config with invalid process pattern")
+		return nil
+	}
+	if _, err := regexp.Compile(config.CommandLine); err != nil {
+		log.WithError(err).WithField("commandLine", config.CommandLine).Warn("ignoring port config with invalid command line pattern")
+		return nil
+	}
+
+	var start, end uint64
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err == nil {
+		start, end = port, port
+	} else {
+		matches := portRangeRegexp.FindStringSubmatch(rawPort)
+		if len(matches) != 3 {
+			return nil
+		}
+		start, err = strconv.ParseUint(matches[1], 10, 16)
+		if err != nil {
+			return nil
+		}
+		end, err = strconv.ParseUint(matches[2], 10, 16)
+		if err != nil || start >= end {
+			return nil
+		}
+	}
+	return &ProcessConfig{
+		RangeConfig: RangeConfig{
+			PortsItems: *config,
+			Start:      uint32(start),
+			End:        uint32(end),
+			Sort:       index,
+		},
+	}
 }
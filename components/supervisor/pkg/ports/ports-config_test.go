@@ -123,6 +123,87 @@ func TestPortsConfig(t *testing.T) {
 	}
 }
 
+func TestGetForProcess(t *testing.T) {
+	var (
+		java = &PortProcess{PID: 1, Name: "java", CommandLine: "/usr/bin/java -jar app.jar"}
+		node = &PortProcess{PID: 2, Name: "node", CommandLine: "node /workspace/server.js --port 3000"}
+	)
+	portConfigs, rangeConfigs, processConfigs := parseInstanceConfigs([]*gitpod.PortsItems{
+		{Port: 8080, Name: "app"},
+		{Port: "1-65535", Process: "java", OnOpen: "ignore-completely"},
+		{Port: 3000, CommandLine: `server\.js`, Name: "server"},
+		{Port: "3000-3999", Name: "frontend"},
+		{Port: "4000-4999", Process: "[", Name: "invalid pattern"},
+	})
+	configs := &Configs{
+		instancePortConfigs:    portConfigs,
+		instanceRangeConfigs:   rangeConfigs,
+		instanceProcessConfigs: processConfigs,
+	}
+
+	type Expectation struct {
+		Name   string
+		OnOpen string
+		Kind   ConfigKind
+		Exists bool
+	}
+	tests := []struct {
+		Desc        string
+		Port        uint32
+		Process     *PortProcess
+		Expectation Expectation
+	}{
+		{
+			Desc:        "port config takes precedence over process range config",
+			Port:        8080,
+			Process:     java,
+			Expectation: Expectation{Name: "app", Kind: PortConfigKind, Exists: true},
+		},
+		{
+			Desc:        "process range config",
+			Port:        9000,
+			Process:     java,
+			Expectation: Expectation{OnOpen: "ignore-completely", Kind: RangeConfigKind, Exists: true},
+		},
+		{
+			Desc:        "process port config takes precedence over range config",
+			Port:        3000,
+			Process:     node,
+			Expectation: Expectation{Name: "server", Kind: RangeConfigKind, Exists: true},
+		},
+		{
+			Desc:        "process port config does not match command line",
+			Port:        3000,
+			Process:     &PortProcess{PID: 3, Name: "node", CommandLine: "node app.js"},
+			Expectation: Expectation{Name: "frontend", Kind: RangeConfigKind, Exists: true},
+		},
+		{
+			Desc:        "unknown process",
+			Port:        9000,
+			Expectation: Expectation{},
+		},
+		{
+			Desc:        "invalid pattern is ignored",
+			Port:        4000,
+			Process:     java,
+			Expectation: Expectation{OnOpen: "ignore-completely", Kind: RangeConfigKind, Exists: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			config, kind, exists := configs.GetForProcess(test.Port, test.Process)
+			act := Expectation{Kind: kind, Exists: exists}
+			if config != nil {
+				act.Name = config.Name
+				act.OnOpen = config.OnOpen
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 type PortConfigTestExpectations struct {
 	InstancePortConfigs  []*gitpod.PortConfig
 	InstanceRangeConfigs []*RangeConfig
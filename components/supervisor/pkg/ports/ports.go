@@ -66,10 +66,10 @@ type localhostProxy struct {
 }
 
 type autoExposure struct {
-	state    api.PortAutoExposure
-	ctx      context.Context
-	public   bool
-	protocol string
+	state      api.PortAutoExposure
+	ctx        context.Context
+	visibility string
+	protocol   string
 }
 
 // Manager brings together served and exposed ports. It keeps track of which port is exposed, which one is served,
@@ -113,6 +113,7 @@ type managedPort struct {
 	OnExposed    api.OnPortExposedAction // deprecated
 	OnOpen       api.PortsStatus_OnOpenAction
 	AutoExposure api.PortAutoExposure
+	Process      *PortProcess
 
 	LocalhostPort uint32
 
@@ -272,14 +273,15 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 				continue
 			}
 
-			config, _, exists := pm.configs.Get(port.Port)
+			config, _, exists := pm.configs.GetForProcess(port.Port, port.Process)
 			// don't serve ports that are configured to be ignored-completely
 			if exists && config.OnOpen == "ignore-completely" {
 				continue
 			}
 
+			// tcp takes precedence over udp on the same port, since most services serve both in that case
 			current, exists := servedMap[port.Port]
-			if !exists || (!port.BoundToLocalhost && current.BoundToLocalhost) {
+			if !exists || (current.UDP && !port.UDP) || (current.UDP == port.UDP && !port.BoundToLocalhost && current.BoundToLocalhost) {
 				servedMap[port.Port] = port
 			}
 		}
@@ -336,7 +338,7 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		if mp, exists := state[port]; exists {
 			return mp
 		}
-		config, _, exists := pm.configs.Get(port)
+		config, _, exists := pm.getConfig(port)
 		var portConfig *gitpod.PortConfig
 		if exists && config != nil {
 			portConfig = &config.PortConfig
@@ -360,18 +362,10 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		if pm.boundInternally(port) {
 			continue
 		}
-		Visibility := api.PortVisibility_private
-		if exposed.Public {
-			Visibility = api.PortVisibility_public
-		}
-		portProtocol := api.PortProtocol_http
-		if exposed.Protocol == gitpod.PortProtocolHTTPS {
-			portProtocol = api.PortProtocol_https
-		}
 		mp := genManagedPort(port)
 		mp.Exposed = true
-		mp.Protocol = portProtocol
-		mp.Visibility = Visibility
+		mp.Protocol = portProtocolFromGitpod(exposed.Protocol)
+		mp.Visibility = portVisibilityFromGitpod(exposed.Visibility)
 		mp.URL = exposed.URL
 	}
 
@@ -402,12 +396,8 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 				return
 			}
 
-			mp.Visibility = api.PortVisibility_private
-			if config.Visibility == "public" {
-				mp.Visibility = api.PortVisibility_public
-			}
-			public := mp.Visibility == api.PortVisibility_public
-			mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, public, config.Protocol).state
+			mp.Visibility = portVisibilityFromGitpod(config.Visibility)
+			mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, portVisibilityToGitpod(mp.Visibility), config.Protocol).state
 		})
 	}
 
@@ -421,6 +411,7 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		}
 		mp := genManagedPort(port)
 		mp.Served = true
+		mp.Process = served.Process
 
 		autoExposure, autoExposed := pm.autoExposed[port]
 		if autoExposed {
@@ -428,33 +419,27 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 			continue
 		}
 
-		var public bool
-		protocol := "http"
-		config, kind, exists := pm.configs.Get(mp.LocalhostPort)
-
-		getProtocol := func(p api.PortProtocol) string {
-			switch p {
-			case api.PortProtocol_https:
-				return "https"
-			default:
-				return "http"
-			}
+		visibility := api.PortVisibility_private
+		protocol := gitpod.PortProtocolHTTP
+		if served.UDP {
+			protocol = gitpod.PortProtocolUDP
 		}
+		config, kind, exists := pm.getConfig(mp.LocalhostPort)
 
 		configured := exists && kind == PortConfigKind
 		if mp.Exposed || configured {
-			public = mp.Visibility == api.PortVisibility_public
-			protocol = getProtocol(mp.Protocol)
+			visibility = mp.Visibility
+			protocol = portProtocolToGitpod(mp.Protocol)
 		} else if exists {
-			public = config.Visibility == "public"
+			visibility = portVisibilityFromGitpod(config.Visibility)
 			protocol = config.Protocol
 		}
 
-		if mp.Exposed && ((mp.Visibility == api.PortVisibility_public && public) || (mp.Visibility == api.PortVisibility_private && !public)) && protocol != "https" {
+		if mp.Exposed && mp.Visibility == visibility && protocol != "https" {
 			continue
 		}
 
-		mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, public, protocol).state
+		mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, portVisibilityToGitpod(visibility), protocol).state
 	}
 
 	var ports []uint32
@@ -474,14 +459,66 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 	return newState
 }
 
+// portProtocolFromGitpod maps the protocol of an exposed port to its supervisor API counterpart.
+func portProtocolFromGitpod(protocol string) api.PortProtocol {
+	switch protocol {
+	case gitpod.PortProtocolHTTPS:
+		return api.PortProtocol_https
+	case gitpod.PortProtocolTCP:
+		return api.PortProtocol_tcp
+	case gitpod.PortProtocolUDP:
+		return api.PortProtocol_udp
+	default:
+		return api.PortProtocol_http
+	}
+}
+
+// portProtocolToGitpod maps a supervisor API port protocol to the protocol used when exposing the port.
+func portProtocolToGitpod(protocol api.PortProtocol) string {
+	switch protocol {
+	case api.PortProtocol_https:
+		return gitpod.PortProtocolHTTPS
+	case api.PortProtocol_tcp:
+		return gitpod.PortProtocolTCP
+	case api.PortProtocol_udp:
+		return gitpod.PortProtocolUDP
+	default:
+		return gitpod.PortProtocolHTTP
+	}
+}
+
+// portVisibilityFromGitpod maps a configured or exposed port visibility to the supervisor API port visibility.
+func portVisibilityFromGitpod(visibility string) api.PortVisibility {
+	switch visibility {
+	case gitpod.PortVisibilityPublic:
+		return api.PortVisibility_public
+	case gitpod.PortVisibilityOrganization:
+		return api.PortVisibility_organization
+	default:
+		return api.PortVisibility_private
+	}
+}
+
+// portVisibilityToGitpod maps a supervisor API port visibility to the visibility used when exposing the port.
+func portVisibilityToGitpod(visibility api.PortVisibility) string {
+	switch visibility {
+	case api.PortVisibility_public:
+		return gitpod.PortVisibilityPublic
+	case api.PortVisibility_organization:
+		return gitpod.PortVisibilityOrganization
+	default:
+		return gitpod.PortVisibilityPrivate
+	}
+}
+
 // clients should guard a call with check whether such port is already exposed or auto exposed
-func (pm *Manager) autoExpose(ctx context.Context, localPort uint32, public bool, protocol string) *autoExposure {
-	exposing := pm.E.Expose(ctx, localPort, public, protocol)
+func (pm *Manager) autoExpose(ctx context.Context, localPort uint32, visibility string, protocol string) *autoExposure {
+	exposing := pm.E.Expose(ctx, localPort, visibility, protocol)
 	autoExpose := &autoExposure{
-		state:    api.PortAutoExposure_trying,
-		ctx:      ctx,
-		public:   public,
-		protocol: protocol,
+		state:      api.PortAutoExposure_trying,
+		ctx:        ctx,
+		visibility: visibility,
+		protocol:   protocol,
 	}
 	go func() {
 		err := <-exposing
@@ -508,7 +545,7 @@ func (pm *Manager) RetryAutoExpose(ctx context.Context, localPort uint32) {
 	if !autoExposed || autoExpose.state != api.PortAutoExposure_failed || autoExpose.ctx.Err() != nil {
 		return
 	}
-	pm.autoExpose(autoExpose.ctx, localPort, autoExpose.public, autoExpose.protocol)
+	pm.autoExpose(autoExpose.ctx, localPort, autoExpose.visibility, autoExpose.protocol)
 	pm.forceUpdate()
 }
 
@@ -534,7 +571,8 @@ func (pm *Manager) autoTunnel(ctx context.Context) {
 	}
 	var descs []*PortTunnelDescription
 	for _, served := range pm.served {
-		if pm.boundInternally(served.Port) {
+		// tunnels forward tcp only
+		if pm.boundInternally(served.Port) || served.UDP {
 			continue
 		}
 
@@ -561,7 +599,8 @@ func (pm *Manager) autoTunnel(ctx context.Context) {
 func (pm *Manager) updateProxies() {
 	servedPortMap := map[uint32]bool{}
 	for _, s := range pm.served {
-		servedPortMap[s.Port] = s.BoundToLocalhost
+		// localhost proxies forward tcp only
+		servedPortMap[s.Port] = s.BoundToLocalhost && !s.UDP
 	}
 
 	for port, proxy := range pm.proxies {
@@ -579,7 +618,7 @@ func (pm *Manager) updateProxies() {
 	for _, served := range pm.served {
 		localPort := served.Port
 		_, exists := pm.proxies[localPort]
-		if exists || !served.BoundToLocalhost {
+		if exists || !served.BoundToLocalhost || served.UDP {
 			continue
 		}
 
@@ -645,6 +684,18 @@ func getOnOpenAction(config *gitpod.PortConfig, port uint32) api.PortsStatus_OnO
 	return api.PortsStatus_notify
 }
 
+// getConfig returns the config for the given port, taking into account which process serves it.
+func (pm *Manager) getConfig(port uint32) (*SortConfig, ConfigKind, bool) {
+	var process *PortProcess
+	for _, served := range pm.served {
+		if served.Port == port {
+			process = served.Process
+			break
+		}
+	}
+	return pm.configs.GetForProcess(port, process)
+}
+
 func (pm *Manager) boundInternally(port uint32) bool {
 	_, exists := pm.internal[port]
 	return exists
@@ -670,7 +721,7 @@ func (pm *Manager) Expose(ctx context.Context, port uint32) error {
 		}
 	}
 
-	config, kind, exists := pm.configs.Get(port)
+	config, kind, exists := pm.getConfig(port)
 	if exists && kind == PortConfigKind {
 		// will be auto-exposed
 		return nil
@@ -681,15 +732,19 @@ func (pm *Manager) Expose(ctx context.Context, port uint32) error {
 	pm.mu.RUnlock()
 	unlock = false
 
-	public := false
+	visibility := gitpod.PortVisibilityPrivate
 	protocol := gitpod.PortProtocolHTTP
 
 	if exists {
-		public = config.Visibility != "private"
+		// configured ports are public unless configured to be private or organization-wide
+		visibility = gitpod.PortVisibilityPublic
+		if config.Visibility == gitpod.PortVisibilityPrivate || config.Visibility == gitpod.PortVisibilityOrganization {
+			visibility = config.Visibility
+		}
 		protocol = config.Protocol
 	}
 
-	err := <-pm.E.Expose(ctx, port, public, protocol)
+	err := <-pm.E.Expose(ctx, port, visibility, protocol)
 	if err != nil && err != context.Canceled {
 		log.WithError(err).WithField("port", port).Error("cannot expose port")
 	}
@@ -806,10 +861,10 @@ func (pm *Manager) getStatus() []*api.PortsStatus {
 		// Max number of port 65536
 		score1 := NON_CONFIGED_BASIC_SCORE + res[i].LocalPort
 		score2 := NON_CONFIGED_BASIC_SCORE + res[j].LocalPort
-		if c, _, ok := pm.configs.Get(res[i].LocalPort); ok {
+		if c, _, ok := pm.getConfig(res[i].LocalPort); ok {
 			score1 = c.Sort
 		}
-		if c, _, ok := pm.configs.Get(res[j].LocalPort); ok {
+		if c, _, ok := pm.getConfig(res[j].LocalPort); ok {
 			score2 = c.Sort
 		}
 		if score1 != score2 {
@@ -839,6 +894,13 @@ func (pm *Manager) getPortStatus(port uint32) *api.PortsStatus {
 		}
 	}
 	ps.AutoExposure = mp.AutoExposure
+	if mp.Process != nil {
+		ps.OwnerPid = mp.Process.PID
+		ps.OwnerCommand = mp.Process.CommandLine
+		if ps.OwnerCommand == "" {
+			ps.OwnerCommand = mp.Process.Name
+		}
+	}
 	if mp.Tunneled {
 		ps.Tunneled = &api.TunneledPortInfo{
 			TargetPort: mp.TunneledTargetPort,
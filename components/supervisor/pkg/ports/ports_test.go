@@ -47,15 +47,15 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic locally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}}},
 				{Exposed: []ExposedPort{{LocalPort: 8080, URL: "foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 60000, false}}},
-				{Served: []ServedPort{{net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}, {net.IPv4zero, 60000, false, nil, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 60000, false, nil, false}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080},
-				{LocalPort: 60000},
+				{LocalPort: 8080, Visibility: "private"},
+				{LocalPort: 60000, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -69,11 +69,11 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic globally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, nil, false}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080},
+				{LocalPort: 8080, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -85,11 +85,11 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "basic port publically exposed",
 			Changes: []Change{
 				{Served: []ServedPort{{Port: 8080}}},
-				{Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}}},
-				{Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}}},
+				{Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "public", URL: "foobar"}}},
+				{Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "private", URL: "foobar"}}},
 			},
 			ExpectedExposure: ExposureExpectation{
-				{LocalPort: 8080},
+				{LocalPort: 8080, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -103,7 +103,7 @@ func TestPortsUpdateState(t *testing.T) {
 			InternalPorts: []uint32{8080},
 			Changes: []Change{
 				{Served: []ServedPort{}},
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, nil, false}}},
 			},
 			ExpectedExposure: ExposureExpectation(nil),
 			ExpectedUpdates:  UpdateExpectation{{}},
@@ -117,13 +117,13 @@ func TestPortsUpdateState(t *testing.T) {
 						Port:   "4000-5000",
 					}},
 				}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}}},
-				{Exposed: []ExposedPort{{LocalPort: 4040, Public: true, URL: "4040-foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}, {net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, nil, false}}},
+				{Exposed: []ExposedPort{{LocalPort: 4040, Visibility: "public", URL: "4040-foobar"}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, nil, false}, {net.IPv4zero, 60000, false, nil, false}}},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 4040},
-				{LocalPort: 60000},
+				{LocalPort: 4040, Visibility: "private"},
+				{LocalPort: 60000, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -145,29 +145,29 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "private", URL: "foobar"}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "public", URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "public", URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}},
 				},
 				{
 					Served: []ServedPort{},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false, nil, false}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080, Public: false},
+				{LocalPort: 8080, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -179,16 +179,41 @@ func TestPortsUpdateState(t *testing.T) {
 				[]*api.PortsStatus{{LocalPort: 8080, Served: true, OnOpen: api.PortsStatus_notify, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_public, OnExposed: api.OnPortExposedAction_notify, Url: "foobar"}}},
 			},
 		},
+		{
+			Desc: "auto expose configured organization ports",
+			Changes: []Change{
+				{
+					Config: &ConfigChange{instance: []*gitpod.PortsItems{
+						{Port: 8080, Visibility: "organization"},
+					}},
+				},
+				{
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "organization", URL: "foobar"}},
+				},
+				{
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}},
+				},
+			},
+			ExpectedExposure: []ExposedPort{
+				{LocalPort: 8080, Visibility: "organization"},
+			},
+			ExpectedUpdates: UpdateExpectation{
+				{},
+				[]*api.PortsStatus{{LocalPort: 8080, OnOpen: api.PortsStatus_notify}},
+				[]*api.PortsStatus{{LocalPort: 8080, OnOpen: api.PortsStatus_notify, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_organization, OnExposed: api.OnPortExposedAction_notify, Url: "foobar"}}},
+				[]*api.PortsStatus{{LocalPort: 8080, Served: true, OnOpen: api.PortsStatus_notify, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_organization, OnExposed: api.OnPortExposedAction_notify, Url: "foobar"}}},
+			},
+		},
 		{
 			Desc: "starting multiple proxies for the same served event",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 3000, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, nil, false}, {net.IPv4zero, 3000, true, nil, false}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080},
-				{LocalPort: 3000},
+				{LocalPort: 8080, Visibility: "private"},
+				{LocalPort: 3000, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -207,14 +232,14 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, nil, false}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "private", URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080},
+				{LocalPort: 8080, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -227,20 +252,20 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}, {net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -252,10 +277,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}, {net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -265,7 +290,7 @@ func TestPortsUpdateState(t *testing.T) {
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -277,17 +302,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}, {net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -299,17 +324,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}, {net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -321,17 +346,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}, {net.IPv6zero, 5900, true, nil, false}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -343,17 +368,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, nil, false}, {net.IPv6zero, 5900, true, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -365,17 +390,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}, {net.IPv6zero, 5900, false, nil, false}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -387,17 +412,17 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, nil, false}, {net.IPv6zero, 5900, false, nil, false}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5900},
+				{LocalPort: 5900, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -414,14 +439,14 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, nil, false}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: "private", URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080},
+				{LocalPort: 8080, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -439,14 +464,14 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, nil, false}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 3000, Visibility: "private", URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 3000},
+				{LocalPort: 3000, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -455,6 +480,36 @@ func TestPortsUpdateState(t *testing.T) {
 				{{LocalPort: 3000, Name: "react", Served: true, OnOpen: api.PortsStatus_notify, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_private, OnExposed: api.OnPortExposedAction_notify, Url: "foobar"}}},
 			},
 		},
+		{
+			Desc: "ignore ports served by a process except the configured one",
+			Changes: []Change{
+				{
+					Config: &ConfigChange{instance: []*gitpod.PortsItems{
+						{Port: 8080, Name: "app"},
+						{Port: "1-65535", Process: "java", OnOpen: "ignore-completely"},
+					}},
+				},
+				{
+					Served: []ServedPort{
+						{Address: net.IPv4zero, Port: 3000, Process: &PortProcess{PID: 2, Name: "node", CommandLine: "node server.js"}},
+						{Address: net.IPv4zero, Port: 8080, Process: &PortProcess{PID: 1, Name: "java", CommandLine: "java -jar app.jar"}},
+						{Address: net.IPv4zero, Port: 9000, Process: &PortProcess{PID: 1, Name: "java", CommandLine: "java -jar app.jar"}},
+					},
+				},
+			},
+			ExpectedExposure: []ExposedPort{
+				{LocalPort: 8080, Visibility: "private"},
+				{LocalPort: 3000, Visibility: "private"},
+			},
+			ExpectedUpdates: UpdateExpectation{
+				{},
+				{{LocalPort: 8080, Name: "app", OnOpen: api.PortsStatus_notify}},
+				{
+					{LocalPort: 8080, Name: "app", Served: true, OnOpen: api.PortsStatus_notify, OwnerPid: 1, OwnerCommand: "java -jar app.jar"},
+					{LocalPort: 3000, Served: true, OnOpen: api.PortsStatus_notify_private, OwnerPid: 2, OwnerCommand: "node server.js"},
+				},
+			},
+		},
 		{
 			Desc: "change configed ports order",
 			Changes: []Change{
@@ -472,10 +527,10 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5002, false}},
+					Served: []ServedPort{{net.IPv4zero, 5002, false, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5002, false}, {net.IPv4zero, 5001, false}},
+					Served: []ServedPort{{net.IPv4zero, 5002, false, nil, false}, {net.IPv4zero, 5001, false, nil, false}},
 				},
 				{
 					Config: &ConfigChange{instance: []*gitpod.PortsItems{
@@ -484,17 +539,17 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5001, false}, {net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 5001, false, nil, false}, {net.IPv4zero, 3000, false, nil, false}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 3000, Visibility: "private", URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 5002},
-				{LocalPort: 5001},
-				{LocalPort: 3000},
-				{LocalPort: 3001},
+				{LocalPort: 5002, Visibility: "private"},
+				{LocalPort: 5001, Visibility: "private"},
+				{LocalPort: 3000, Visibility: "private"},
+				{LocalPort: 3001, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -557,10 +612,10 @@ func TestPortsUpdateState(t *testing.T) {
 					},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, nil, false}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}, {net.IPv4zero, 3001, false}, {net.IPv4zero, 3002, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, nil, false}, {net.IPv4zero, 3001, false, nil, false}, {net.IPv4zero, 3002, false, nil, false}},
 				},
 				{
 					Config: &ConfigChange{
@@ -581,10 +636,10 @@ func TestPortsUpdateState(t *testing.T) {
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 3000},
-				{LocalPort: 3001},
-				{LocalPort: 3002},
-				{LocalPort: 3003},
+				{LocalPort: 3000, Visibility: "private"},
+				{LocalPort: 3001, Visibility: "private"},
+				{LocalPort: 3002, Visibility: "private"},
+				{LocalPort: 3003, Visibility: "private"},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -628,7 +683,7 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "expose port without served, port should be responded for use case of openvscode-server",
 			Changes: []Change{
 				{
-					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 3000, Visibility: "private", URL: "foobar"}},
 				},
 			},
 			// this will not exposed because test manager didn't implement it properly
@@ -704,9 +759,10 @@ func TestPortsUpdateState(t *testing.T) {
 				for _, c := range test.Changes {
 					if c.Config != nil {
 						change := &Configs{}
-						portConfigs, rangeConfigs := parseInstanceConfigs(c.Config.instance)
+						portConfigs, rangeConfigs, processConfigs := parseInstanceConfigs(c.Config.instance)
 						change.instancePortConfigs = portConfigs
 						change.instanceRangeConfigs = rangeConfigs
+						change.instanceProcessConfigs = processConfigs
 						config.Changes <- change
 					} else if c.ConfigErr != nil {
 						config.Error <- c.ConfigErr
@@ -788,13 +844,13 @@ func (tep *testExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 func (tep *testExposedPorts) Run(ctx context.Context) {
 }
 
-func (tep *testExposedPorts) Expose(ctx context.Context, local uint32, public bool, protocol string) <-chan error {
+func (tep *testExposedPorts) Expose(ctx context.Context, local uint32, visibility string, protocol string) <-chan error {
 	tep.mu.Lock()
 	defer tep.mu.Unlock()
 
 	tep.Exposures = append(tep.Exposures, ExposedPort{
-		LocalPort: local,
-		Public:    public,
+		LocalPort:  local,
+		Visibility: visibility,
 	})
 	return nil
 }
@@ -969,7 +1025,7 @@ func TestManager_getStatus(t *testing.T) {
 			for _, port := range tt.fields.orderInYaml {
 				portsItems = append(portsItems, &gitpod.PortsItems{Port: port})
 			}
-			portsConfig, rangeConfig := parseInstanceConfigs(portsItems)
+			portsConfig, rangeConfig, _ := parseInstanceConfigs(portsItems)
 			pm := &Manager{
 				configs: &Configs{
 					instancePortConfigs:  portsConfig,
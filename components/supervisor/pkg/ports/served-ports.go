@@ -13,6 +13,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,6 +27,17 @@ type ServedPort struct {
 	Address          net.IP
 	Port             uint32
 	BoundToLocalhost bool
+	// Process is the process listening on the port. It is nil if it cannot be determined.
+	Process *PortProcess
+	// UDP is true if the port is served over udp rather than tcp
+	UDP bool
+}
+
+// PortProcess describes the process listening on a port.
+type PortProcess struct {
+	PID         uint32
+	Name        string
+	CommandLine string
 }
 
 // ServedPortsObserver observes the locally served ports and provides
@@ -44,13 +56,23 @@ const (
 
 	fnNetTCP  = "/proc/net/tcp"
 	fnNetTCP6 = "/proc/net/tcp6"
+	fnNetUDP  = "/proc/net/udp"
+	fnNetUDP6 = "/proc/net/udp6"
+	fnProc    = "/proc"
+
+	fnLocalPortRange = "/proc/sys/net/ipv4/ip_local_port_range"
 )
 
 // PollingServedPortsObserver regularly polls "/proc" to observe port changes.
 type PollingServedPortsObserver struct {
 	RefreshInterval time.Duration
 
-	fileOpener func(fn string) (io.ReadCloser, error)
+	fileOpener   func(fn string) (io.ReadCloser, error)
+	socketOwners func(inodes map[uint64]struct{}) map[uint64]*PortProcess
+	knownOwners  map[uint64]*PortProcess
+	// ephemeralPorts is the range the kernel picks client ports from. UDP sockets bound to these ports
+	// are most likely clients waiting for a response, e.g. DNS lookups, rather than servers.
+	ephemeralPorts [2]uint32
 }
 
 // Observe starts observing the served ports until the context is canceled.
@@ -60,6 +82,14 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 			return os.Open(fn)
 		}
 	}
+	if p.socketOwners == nil {
+		p.socketOwners = func(inodes map[uint64]struct{}) map[uint64]*PortProcess {
+			return findSocketOwners(fnProc, inodes)
+		}
+	}
+	if p.ephemeralPorts == [2]uint32{} {
+		p.ephemeralPorts = readLocalPortRange(fnLocalPortRange)
+	}
 
 	var (
 		errchan = make(chan error, 1)
@@ -82,10 +112,11 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 			var (
 				visited = make(map[string]struct{})
 				ports   []ServedPort
+				inodes  []uint64
 			)
 
 			var protos []string
-			for _, path := range []string{fnNetTCP, fnNetTCP6} {
+			for _, path := range []string{fnNetTCP, fnNetTCP6, fnNetUDP, fnNetUDP6} {
 				if _, err := os.Stat(path); err == nil {
 					protos = append(protos, path)
 				}
@@ -97,24 +128,37 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 					errchan <- err
 					continue
 				}
-				ps, err := readNetTCPFile(fc, true)
+				udp := fn == fnNetUDP || fn == fnNetUDP6
+				var sockets []netTCPSocket
+				if udp {
+					sockets, err = readNetUDPSockets(fc, p.ephemeralPorts)
+				} else {
+					sockets, err = readNetTCPSockets(fc, true)
+				}
 				fc.Close()
 
 				if err != nil {
 					errchan <- err
 					continue
 				}
-				for _, port := range ps {
-					key := fmt.Sprintf("%s:%d", hex.EncodeToString(port.Address), port.Port)
+				for _, socket := range sockets {
+					port := socket.ServedPort
+					key := fmt.Sprintf("%t:%s:%d", port.UDP, hex.EncodeToString(port.Address), port.Port)
 					_, exists := visited[key]
 					if exists {
 						continue
 					}
 					visited[key] = struct{}{}
 					ports = append(ports, port)
+					inodes = append(inodes, socket.Inode)
 				}
 			}
 
+			owners := p.resolveOwners(inodes)
+			for i := range ports {
+				ports[i].Process = owners[inodes[i]]
+			}
+
 			if len(ports) > 0 {
 				reschan <- ports
 			}
@@ -124,14 +168,165 @@ func (p *PollingServedPortsObserver) Observe(ctx context.Context) (<-chan []Serv
 	return reschan, errchan
 }
 
+// resolveOwners returns the processes owning the sockets with the given inodes.
+// Owners are remembered for as long as their sockets exist, so that "/proc" is only
+// scanned when new sockets show up.
+func (p *PollingServedPortsObserver) resolveOwners(inodes []uint64) map[uint64]*PortProcess {
+	owners := make(map[uint64]*PortProcess, len(inodes))
+	unknown := make(map[uint64]struct{})
+	for _, inode := range inodes {
+		owner, known := p.knownOwners[inode]
+		if !known {
+			unknown[inode] = struct{}{}
+			continue
+		}
+		owners[inode] = owner
+	}
+	if len(unknown) > 0 {
+		found := p.socketOwners(unknown)
+		for inode := range unknown {
+			// sockets without owner are remembered as well, e.g. if they belong
+			// to a process we are not allowed to inspect
+			owners[inode] = found[inode]
+		}
+	}
+	p.knownOwners = owners
+	return owners
+}
+
+// findSocketOwners scans the file descriptors of all processes for the sockets with the given inodes.
+func findSocketOwners(procfs string, inodes map[uint64]struct{}) map[uint64]*PortProcess {
+	owners := make(map[uint64]*PortProcess, len(inodes))
+	procs, err := os.ReadDir(procfs)
+	if err != nil {
+		log.WithError(err).Debug("cannot list processes")
+		return owners
+	}
+	for _, proc := range procs {
+		pid, err := strconv.ParseUint(proc.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join(procfs, proc.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+
+		var process *PortProcess
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil || !strings.HasPrefix(link, "socket:[") || !strings.HasSuffix(link, "]") {
+				continue
+			}
+			inode, err := strconv.ParseUint(link[len("socket:["):len(link)-1], 10, 64)
+			if err != nil {
+				continue
+			}
+			if _, wanted := inodes[inode]; !wanted {
+				continue
+			}
+			if _, found := owners[inode]; found {
+				continue
+			}
+			if process == nil {
+				process = readPortProcess(filepath.Join(procfs, proc.Name()), uint32(pid))
+			}
+			owners[inode] = process
+		}
+		if len(owners) == len(inodes) {
+			break
+		}
+	}
+	return owners
+}
+
+func readPortProcess(procDir string, pid uint32) *PortProcess {
+	process := &PortProcess{PID: pid}
+	if comm, err := os.ReadFile(filepath.Join(procDir, "comm")); err == nil {
+		process.Name = strings.TrimSpace(string(comm))
+	}
+	if cmdline, err := os.ReadFile(filepath.Join(procDir, "cmdline")); err == nil {
+		process.CommandLine = strings.Join(strings.FieldsFunc(string(cmdline), func(r rune) bool { return r == 0 }), " ")
+	}
+	return process
+}
+
+// readLocalPortRange returns the range of ephemeral ports configured in fn, falling back to the kernel's default.
+func readLocalPortRange(fn string) [2]uint32 {
+	res := [2]uint32{32768, 60999}
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		return res
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) != 2 {
+		return res
+	}
+	low, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return res
+	}
+	high, err := strconv.ParseUint(fields[1], 10, 16)
+	if err != nil || high < low {
+		return res
+	}
+	return [2]uint32{uint32(low), uint32(high)}
+}
+
+// netTCPSocket is a socket listed in a /proc/net/tcp* or /proc/net/udp* file.
+type netTCPSocket struct {
+	ServedPort
+	Inode uint64
+}
+
 func readNetTCPFile(fc io.Reader, listeningOnly bool) (ports []ServedPort, err error) {
+	sockets, err := readNetTCPSockets(fc, listeningOnly)
+	if err != nil {
+		return nil, err
+	}
+	for _, socket := range sockets {
+		ports = append(ports, socket.ServedPort)
+	}
+	return ports, nil
+}
+
+func readNetTCPSockets(fc io.Reader, listeningOnly bool) (sockets []netTCPSocket, err error) {
+	return readNetSockets(fc, func(fields []string) bool {
+		// 0A is TCP_LISTEN
+		return !listeningOnly || fields[3] == "0A"
+	})
+}
+
+// readNetUDPSockets returns the unconnected udp sockets of a /proc/net/udp* file, which are bound
+// to a port outside of the ephemeral port range.
+func readNetUDPSockets(fc io.Reader, ephemeralPorts [2]uint32) (sockets []netTCPSocket, err error) {
+	sockets, err = readNetSockets(fc, func(fields []string) bool {
+		// 07 is TCP_CLOSE, which udp sockets without a peer are in, connected sockets talk to a single peer only
+		return fields[3] == "07" && strings.HasSuffix(fields[2], ":0000")
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := sockets[:0]
+	for _, socket := range sockets {
+		if ephemeralPorts[0] <= socket.Port && socket.Port <= ephemeralPorts[1] {
+			continue
+		}
+		socket.UDP = true
+		res = append(res, socket)
+	}
+	return res, nil
+}
+
+func readNetSockets(fc io.Reader, include func(fields []string) bool) (sockets []netTCPSocket, err error) {
 	scanner := bufio.NewScanner(fc)
 	for scanner.Scan() {
 		fields := strings.Fields(scanner.Text())
 		if len(fields) < 4 {
 			continue
 		}
-		if listeningOnly && fields[3] != "0A" {
+		if !include(fields) {
 			continue
 		}
 
@@ -143,26 +338,34 @@ func readNetTCPFile(fc io.Reader, listeningOnly bool) (ports []ServedPort, err e
 
 		port, err := strconv.ParseUint(portHex, 16, 32)
 		if err != nil {
-			log.WithError(err).WithField("port", portHex).Warn("cannot parse port entry from /proc/net/tcp* file")
+			log.WithError(err).WithField("port", portHex).Warn("cannot parse port entry from /proc/net file")
 			continue
 		}
 		ipAddress := hexDecodeIP([]byte(addrHex))
 
-		ports = append(ports, ServedPort{
-			BoundToLocalhost: ipAddress.IsLoopback(),
-			Address:          ipAddress,
-			Port:             uint32(port),
+		var inode uint64
+		if len(fields) > 9 {
+			inode, _ = strconv.ParseUint(fields[9], 10, 64)
+		}
+
+		sockets = append(sockets, netTCPSocket{
+			ServedPort: ServedPort{
+				BoundToLocalhost: ipAddress.IsLoopback(),
+				Address:          ipAddress,
+				Port:             uint32(port),
+			},
+			Inode: inode,
 		})
 
-		sort.Slice(ports, func(i, j int) bool {
-			if ports[i].Address.Equal(ports[j].Address) {
-				return ports[i].Port < ports[j].Port
+		sort.Slice(sockets, func(i, j int) bool {
+			if sockets[i].Address.Equal(sockets[j].Address) {
+				return sockets[i].Port < sockets[j].Port
 			}
-			return bytes.Compare(ports[i].Address, ports[j].Address) < 0
+			return bytes.Compare(sockets[i].Address, sockets[j].Address) < 0
 		})
 
-		sort.Slice(ports, func(i, j int) bool {
-			return ports[i].Port < ports[j].Port
+		sort.Slice(sockets, func(i, j int) bool {
+			return sockets[i].Port < sockets[j].Port
 		})
 	}
 	if err = scanner.Err(); err != nil {
@@ -10,6 +10,8 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -36,13 +38,35 @@ const validTCP6Input = `  sl  local_address                         remote_addre
    7: 0000000000000000FFFF0000940C380A:59D7 0000000000000000FFFF00006100840A:E08A 06 00000000:00000000 03:000003E6 00000000     0        0 0 3 0000000000000000
   20: 0000000000000000FFFF00000100007F:59D7 0000000000000000FFFF00000100007F:EB64 01 00000000:00000000 02:000003D2 00000000 33333        0 57014424 2 0000000000000000 20 4 0 10 -1`
 
+const validUDPInput = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  123: 00000000:14E9 00000000:0000 07 00000000:00000000 00:00000000 00000000 33333        0 57030001 2 0000000000000000 0
+  124: 0100007F:0035 00000000:0000 07 00000000:00000000 00:00000000 00000000 33333        0 57030002 2 0000000000000000 0
+  125: 00000000:9C40 00000000:0000 07 00000000:00000000 00:00000000 00000000 33333        0 57030003 2 0000000000000000 0
+  126: 940C380A:D431 08080808:0035 01 00000000:00000000 00:00000000 00000000 33333        0 57030004 2 0000000000000000 0
+`
+
 func TestObserve(t *testing.T) {
 	type Expectation [][]ServedPort
 	tests := []struct {
-		Name         string
-		FileContents []string
-		Expectation  Expectation
+		Name            string
+		FileContents    []string
+		UDPFileContents []string
+		Expectation     Expectation
 	}{
+		{
+			Name:            "tcp and udp",
+			FileContents:    []string{validTCPInput, ""},
+			UDPFileContents: []string{validUDPInput, ""},
+			Expectation: Expectation{
+				{
+					{Address: net.IPv4(127, 0, 0, 1), Port: 5900, BoundToLocalhost: true},
+					{Address: net.IPv4zero, Port: 6080},
+					{Address: net.IPv4zero, Port: 23000},
+					{Address: net.IPv4(127, 0, 0, 1), Port: 53, BoundToLocalhost: true, UDP: true},
+					{Address: net.IPv4zero, Port: 5353, UDP: true},
+				},
+			},
+		},
 		{
 			Name: "basic positive",
 			FileContents: []string{
@@ -208,10 +232,19 @@ func TestObserve(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			var f int
+			var f, u int
 			obs := PollingServedPortsObserver{
 				RefreshInterval: 100 * time.Millisecond,
+				ephemeralPorts:  [2]uint32{32768, 60999},
 				fileOpener: func(fn string) (io.ReadCloser, error) {
+					if fn == fnNetUDP || fn == fnNetUDP6 {
+						var content string
+						if u < len(test.UDPFileContents) {
+							content = test.UDPFileContents[u]
+							u++
+						}
+						return io.NopCloser(strings.NewReader(content)), nil
+					}
 					if f >= len(test.FileContents) {
 						return nil, os.ErrNotExist
 					}
@@ -300,3 +333,116 @@ func TestReadNetTCPFile(t *testing.T) {
 		})
 	}
 }
+
+func TestReadNetUDPSockets(t *testing.T) {
+	sockets, err := readNetUDPSockets(strings.NewReader(validUDPInput), [2]uint32{32768, 60999})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var act []ServedPort
+	for _, socket := range sockets {
+		act = append(act, socket.ServedPort)
+	}
+	expectation := []ServedPort{
+		{Address: net.IPv4(127, 0, 0, 1), Port: 53, BoundToLocalhost: true, UDP: true},
+		{Address: net.IPv4zero, Port: 5353, UDP: true},
+	}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadLocalPortRange(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Content     string
+		Expectation [2]uint32
+	}{
+		{Name: "configured", Content: "10000\t20000\n", Expectation: [2]uint32{10000, 20000}},
+		{Name: "invalid", Content: "20000\n", Expectation: [2]uint32{32768, 60999}},
+		{Name: "missing", Expectation: [2]uint32{32768, 60999}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fn := filepath.Join(t.TempDir(), "ip_local_port_range")
+			if test.Content != "" {
+				err := os.WriteFile(fn, []byte(test.Content), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			act := readLocalPortRange(fn)
+			if act != test.Expectation {
+				t.Errorf("unexpected port range: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestFindSocketOwners(t *testing.T) {
+	procfs := t.TempDir()
+	for _, proc := range []struct {
+		PID     string
+		Comm    string
+		Cmdline string
+		FDs     map[string]string
+	}{
+		{PID: "1", Comm: "supervisor", Cmdline: "supervisor\x00run\x00", FDs: map[string]string{"0": "/dev/null", "3": "socket:[100]"}},
+		{PID: "42", Comm: "java", Cmdline: "java\x00-jar\x00app.jar\x00", FDs: map[string]string{"3": "socket:[200]", "4": "socket:[300]", "5": "pipe:[400]"}},
+	} {
+		dir := filepath.Join(procfs, proc.PID)
+		if err := os.MkdirAll(filepath.Join(dir, "fd"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "comm"), []byte(proc.Comm+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "cmdline"), []byte(proc.Cmdline), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		for fd, target := range proc.FDs {
+			if err := os.Symlink(target, filepath.Join(dir, "fd", fd)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(procfs, "sys"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	java := &PortProcess{PID: 42, Name: "java", CommandLine: "java -jar app.jar"}
+	act := findSocketOwners(procfs, map[uint64]struct{}{200: {}, 300: {}, 400: {}, 500: {}})
+	if diff := cmp.Diff(map[uint64]*PortProcess{200: java, 300: java}, act); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolveOwners(t *testing.T) {
+	var lookups [][]uint64
+	obs := PollingServedPortsObserver{
+		socketOwners: func(inodes map[uint64]struct{}) map[uint64]*PortProcess {
+			var lookup []uint64
+			res := make(map[uint64]*PortProcess)
+			for inode := range inodes {
+				lookup = append(lookup, inode)
+				if inode != 3 {
+					res[inode] = &PortProcess{PID: uint32(inode)}
+				}
+			}
+			sort.Slice(lookup, func(i, j int) bool { return lookup[i] < lookup[j] })
+			lookups = append(lookups, lookup)
+			return res
+		},
+	}
+
+	obs.resolveOwners([]uint64{1, 2, 3})
+	obs.resolveOwners([]uint64{1, 3})
+	act := obs.resolveOwners([]uint64{1, 2, 4})
+
+	if diff := cmp.Diff([][]uint64{{1, 2, 3}, {2, 4}}, lookups); diff != "" {
+		t.Errorf("unexpected lookups (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[uint64]*PortProcess{1: {PID: 1}, 2: {PID: 2}, 4: {PID: 4}}, act); diff != "" {
+		t.Errorf("unexpected owners (-want +got):\n%s", diff)
+	}
+}
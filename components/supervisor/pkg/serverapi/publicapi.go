@@ -231,14 +231,22 @@ func (s *Service) OpenPort(ctx context.Context, port *gitpod.WorkspaceInstancePo
 			Port: uint64(port.Port),
 		},
 	}
-	if port.Visibility == gitpod.PortVisibilityPublic {
+	switch port.Visibility {
+	case gitpod.PortVisibilityPublic:
 		payload.Port.Policy = v1.PortPolicy_PORT_POLICY_PUBLIC
-	} else {
+	case gitpod.PortVisibilityOrganization:
+		payload.Port.Policy = v1.PortPolicy_PORT_POLICY_ORGANIZATION
+	default:
 		payload.Port.Policy = v1.PortPolicy_PORT_POLICY_PRIVATE
 	}
-	if port.Protocol == gitpod.PortProtocolHTTPS {
+	switch port.Protocol {
+	case gitpod.PortProtocolHTTPS:
 		payload.Port.Protocol = v1.PortProtocol_PORT_PROTOCOL_HTTPS
-	} else {
+	case gitpod.PortProtocolTCP:
+		payload.Port.Protocol = v1.PortProtocol_PORT_PROTOCOL_TCP
+	case gitpod.PortProtocolUDP:
+		payload.Port.Protocol = v1.PortProtocol_PORT_PROTOCOL_UDP
+	default:
 		payload.Port.Protocol = v1.PortProtocol_PORT_PROTOCOL_HTTP
 	}
 	_, err = service.UpdatePort(ctx, payload)
@@ -402,14 +410,22 @@ func workspaceStatusToWorkspaceInstance(status *v1.WorkspaceStatus) *gitpod.Work
 			Port: float64(port.Port),
 			URL:  port.Url,
 		}
-		if port.Policy == v1.PortPolicy_PORT_POLICY_PUBLIC {
+		switch port.Policy {
+		case v1.PortPolicy_PORT_POLICY_PUBLIC:
 			info.Visibility = gitpod.PortVisibilityPublic
-		} else {
+		case v1.PortPolicy_PORT_POLICY_ORGANIZATION:
+			info.Visibility = gitpod.PortVisibilityOrganization
+		default:
 			info.Visibility = gitpod.PortVisibilityPrivate
 		}
-		if port.Protocol == v1.PortProtocol_PORT_PROTOCOL_HTTPS {
+		switch port.Protocol {
+		case v1.PortProtocol_PORT_PROTOCOL_HTTPS:
 			info.Protocol = gitpod.PortProtocolHTTPS
-		} else {
+		case v1.PortProtocol_PORT_PROTOCOL_TCP:
+			info.Protocol = gitpod.PortProtocolTCP
+		case v1.PortProtocol_PORT_PROTOCOL_UDP:
+			info.Protocol = gitpod.PortProtocolUDP
+		default:
 			info.Protocol = gitpod.PortProtocolHTTP
 		}
 		instance.Status.ExposedPorts = append(instance.Status.ExposedPorts, info)
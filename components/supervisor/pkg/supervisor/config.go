@@ -371,6 +371,90 @@ type TaskConfig struct {
 	Env      *map[string]interface{} `json:"env,omitempty"`
 	OpenIn   *string                 `json:"openIn,omitempty"`
 	OpenMode *string                 `json:"openMode,omitempty"`
+
+	DependsOn []string            `json:"dependsOn,omitempty"`
+	ReadyWhen *TaskReadyCondition `json:"readyWhen,omitempty"`
+
+	Restart     *string          `json:"restart,omitempty"`
+	MaxRestarts *int             `json:"maxRestarts,omitempty"`
+	HealthCheck *TaskHealthCheck `json:"healthCheck,omitempty"`
+}
+
+const (
+	// TaskRestartNever never restarts a task once its command exited.
+	TaskRestartNever = "never"
+	// TaskRestartOnFailure restarts a task if its command exited with a non-zero exit code.
+	TaskRestartOnFailure = "on-failure"
+	// TaskRestartAlways restarts a task whenever its command exited.
+	TaskRestartAlways = "always"
+
+	defaultTaskMaxRestarts = 10
+)
+
+// restartPolicy returns the restart policy of the task, defaulting to TaskRestartNever.
+func (c TaskConfig) restartPolicy() string {
+	if c.Restart == nil {
+		return TaskRestartNever
+	}
+	switch *c.Restart {
+	case TaskRestartOnFailure, TaskRestartAlways:
+		return *c.Restart
+	default:
+		return TaskRestartNever
+	}
+}
+
+func (c TaskConfig) maxRestarts() int {
+	if c.MaxRestarts == nil || *c.MaxRestarts < 0 {
+		return defaultTaskMaxRestarts
+	}
+	return *c.MaxRestarts
+}
+
+// TaskReadyCondition defines when a task is considered ready by the tasks depending on it.
+type TaskReadyCondition struct {
+	Port      *uint32 `json:"port,omitempty"`
+	File      *string `json:"file,omitempty"`
+	Succeeded bool    `json:"succeeded,omitempty"`
+}
+
+// TaskHealthCheck defines how the health of a running task is probed.
+// Either Command or Port must be set.
+type TaskHealthCheck struct {
+	// Command is run in the repository root, the task is healthy if it exits with 0.
+	Command *string `json:"command,omitempty"`
+	// Port is probed with an HTTP GET request, the task is healthy if it answers with a status below 400.
+	Port *uint32 `json:"port,omitempty"`
+	// Path is the HTTP path requested on Port, defaults to "/".
+	Path *string `json:"path,omitempty"`
+	// Interval is the number of seconds between two checks, defaults to 10.
+	Interval *uint32 `json:"interval,omitempty"`
+	// FailureThreshold is the number of consecutive failed checks after which the task is unhealthy, defaults to 3.
+	FailureThreshold *uint32 `json:"failureThreshold,omitempty"`
+}
+
+func (c TaskHealthCheck) interval() time.Duration {
+	if c.Interval == nil || *c.Interval == 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(*c.Interval) * time.Second
+}
+
+func (c TaskHealthCheck) failureThreshold() uint32 {
+	if c.FailureThreshold == nil || *c.FailureThreshold == 0 {
+		return 3
+	}
+	return *c.FailureThreshold
+}
+
+func (c TaskHealthCheck) path() string {
+	if c.Path == nil || *c.Path == "" {
+		return "/"
+	}
+	if !strings.HasPrefix(*c.Path, "/") {
+		return "/" + *c.Path
+	}
+	return *c.Path
 }
 
 // Validate validates this configuration.
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/config"
+)
+
+// notifyDevcontainerWarnings tells the user which parts of their dev container config are ignored,
+// as they would otherwise only find out once the workspace does not behave as configured.
+func notifyDevcontainerWarnings(ctx context.Context, notifications *NotificationService, configService *config.ConfigService) {
+	var notified string
+	cfgs := configService.Observe(ctx)
+	for {
+		select {
+		case _, ok := <-cfgs:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+
+		message := devcontainerWarningsMessage(configService.Warnings())
+		if message == "" || message == notified {
+			continue
+		}
+		notified = message
+
+		go func() {
+			_, err := notifications.Notify(ctx, &api.NotifyRequest{
+				Level:   api.NotifyRequest_WARNING,
+				Message: message,
+			})
+			if err != nil && ctx.Err() == nil {
+				log.WithError(err).Warn("cannot notify about dev container config warnings")
+			}
+		}()
+	}
+}
+
+func devcontainerWarningsMessage(warnings []string) string {
+	if len(warnings) == 0 {
+		return ""
+	}
+	for i, w := range warnings {
+		warnings[i] = strings.TrimPrefix(w, "devcontainer.json: ")
+	}
+	return "Parts of your devcontainer.json are not supported by Gitpod: " + strings.Join(warnings, "; ") + "."
+}
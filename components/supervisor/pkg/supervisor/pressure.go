@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/analytics"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// resourcePressureNotificationCooldown is the minimum time between two resource pressure notifications
+const resourcePressureNotificationCooldown = 10 * time.Minute
+
+// notifyResourcePressure warns the user when ws-daemon reports that the workspace is under resource pressure,
+// so that they can free up memory before the OOM killer does it for them.
+func notifyResourcePressure(ctx context.Context, cfg *Config, telemetry analytics.Writer, notifications *NotificationService, topService *TopService) {
+	var lastNotified time.Time
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		data := topService.data
+		if data == nil || data.Pressure == nil {
+			continue
+		}
+		if time.Since(lastNotified) < resourcePressureNotificationCooldown {
+			continue
+		}
+		lastNotified = time.Now()
+
+		dontShowAgain := "Don't Show Again"
+		resp, err := notifications.Notify(ctx, &api.NotifyRequest{
+			Level:   api.NotifyRequest_WARNING,
+			Message: resourcePressureMessage(data.Pressure),
+			Actions: []string{dontShowAgain},
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithError(err).Warn("cannot notify about resource pressure")
+			}
+			continue
+		}
+		telemetry.Track(analytics.TrackMessage{
+			Identity: analytics.Identity{UserID: cfg.OwnerId},
+			Event:    "gitpod_resource_pressure_notification",
+			Properties: map[string]interface{}{
+				"instanceId":     cfg.WorkspaceInstanceID,
+				"workspaceId":    cfg.WorkspaceID,
+				"debugWorkspace": cfg.isDebugWorkspace(),
+				"reason":         data.Pressure.Reason,
+				"action":         resp.Action,
+			},
+		})
+		if resp.Action == dontShowAgain {
+			return
+		}
+	}
+}
+
+func resourcePressureMessage(pressure *api.ResourcePressure) string {
+	if pressure.Reason == "IOPressure" {
+		return fmt.Sprintf("Your workspace is slowed down by disk IO: processes spent %.0f%% of the last 10 seconds waiting for it.", pressure.Io)
+	}
+	return fmt.Sprintf("Your workspace is running out of memory: processes spent %.0f%% of the last 10 seconds waiting for it. Stop processes you don't need, or they might get killed.", pressure.Memory)
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -48,6 +49,12 @@ type RegisterableRESTService interface {
 	RegisterREST(ctx context.Context, mux *runtime.ServeMux, grpcEndpoint string) error
 }
 
+// RegisterableHTTPService can register plain HTTP handlers.
+type RegisterableHTTPService interface {
+	// RegisterHTTP registers HTTP handlers
+	RegisterHTTP(mux *http.ServeMux)
+}
+
 type DesktopIDEStatus struct {
 	Link     string `json:"link"`
 	Label    string `json:"label"`
@@ -928,6 +935,7 @@ func (state *InMemoryContentState) ContentSource() (src csapi.WorkspaceInitSourc
 
 type portService struct {
 	portsManager *ports.Manager
+	inspector    *ports.RequestInspector
 
 	api.UnimplementedPortServiceServer
 }
@@ -1038,6 +1046,67 @@ func (s *portService) RetryAutoExpose(ctx context.Context, req *api.RetryAutoExp
 	return &api.RetryAutoExposeResponse{}, nil
 }
 
+// SetInspection enables or disables capturing the HTTP requests of a port.
+func (s *portService) SetInspection(ctx context.Context, req *api.SetPortInspectionRequest) (*api.SetPortInspectionResponse, error) {
+	if req.Port == 0 || req.Port > 65535 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", req.Port)
+	}
+	s.inspector.SetEnabled(req.Port, req.Enabled)
+	return &api.SetPortInspectionResponse{}, nil
+}
+
+// ListInspectedPorts lists the ports whose HTTP requests are captured. ws-proxy polls it to decide what to capture.
+func (s *portService) ListInspectedPorts(ctx context.Context, req *api.ListInspectedPortsRequest) (*api.ListInspectedPortsResponse, error) {
+	return &api.ListInspectedPortsResponse{Ports: s.inspector.Ports()}, nil
+}
+
+// RecordRequest records an HTTP request ws-proxy forwarded to an inspected port.
+func (s *portService) RecordRequest(ctx context.Context, req *api.RecordPortRequestRequest) (*api.RecordPortRequestResponse, error) {
+	if req.Exchange.GetRequest() == nil {
+		return nil, status.Error(codes.InvalidArgument, "exchange has no request")
+	}
+	err := s.inspector.Record(req.Port, req.Exchange)
+	if err != nil {
+		return nil, inspectionError(err)
+	}
+	return &api.RecordPortRequestResponse{}, nil
+}
+
+// ListRequests lists the captured HTTP requests of a port, the most recent one first.
+func (s *portService) ListRequests(ctx context.Context, req *api.ListPortRequestsRequest) (*api.ListPortRequestsResponse, error) {
+	exchanges, err := s.inspector.List(req.Port)
+	if err != nil {
+		return nil, inspectionError(err)
+	}
+	return &api.ListPortRequestsResponse{Exchanges: exchanges}, nil
+}
+
+// ReplayRequest sends a captured HTTP request to the port again.
+func (s *portService) ReplayRequest(ctx context.Context, req *api.ReplayPortRequestRequest) (*api.ReplayPortRequestResponse, error) {
+	scheme := "http"
+	for _, p := range s.portsManager.Status() {
+		if p.LocalPort == req.Port && p.Exposed.GetProtocol() == api.PortProtocol_https {
+			scheme = "https"
+		}
+	}
+	exchange, err := s.inspector.Replay(ctx, req.Port, req.Id, scheme)
+	if err != nil {
+		return nil, inspectionError(err)
+	}
+	return &api.ReplayPortRequestResponse{Exchange: exchange}, nil
+}
+
+func inspectionError(err error) error {
+	switch {
+	case errors.Is(err, ports.ErrPortNotInspected), errors.Is(err, ports.ErrBodyTruncated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ports.ErrExchangeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 // ResourcesStatus provides workspace resources status information.
 func (s *statusService) ResourcesStatus(ctx context.Context, in *api.ResourcesStatuRequest) (*api.ResourcesStatusResponse, error) {
 	return s.topService.data, nil
@@ -7,7 +7,9 @@ package supervisor
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/dropwriter"
+	"github.com/pkg/sftp"
 	"github.com/sirupsen/logrus"
 )
 
@@ -118,7 +121,7 @@ func (s *sshServer) handleConn(ctx context.Context, conn net.Conn) {
 		"-oHostKey "+s.sshkey,
 		"-oPidFile /dev/null",
 		"-oUseDNS no", // Disable DNS lookups.
-		"-oSubsystem sftp internal-sftp",
+		"-oSubsystem sftp "+bin+" sftp-server",
 		"-oStrictModes no", // don't care for home directory and file permissions
 		"-oTrustedUserCAKeys "+s.caPath,
 	)
@@ -207,6 +210,36 @@ func (s *sshServer) handleConn(ctx context.Context, conn net.Conn) {
 	}
 }
 
+// ServeSFTP serves the SFTP protocol on rwc until the client ends the session. sshd starts it as the sftp
+// subsystem of sessions, which run as the workspace user, so that files are read and written with the
+// permissions of the workspace user and new files are owned by them.
+func ServeSFTP(rwc io.ReadWriteCloser) error {
+	if uid := os.Geteuid(); uid != gitpodUID {
+		return xerrors.Errorf("sftp server must run as the workspace user (uid %d), not as uid %d", gitpodUID, uid)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "/home/gitpod"
+	}
+	return serveSFTP(rwc, home)
+}
+
+func serveSFTP(rwc io.ReadWriteCloser, workdir string) error {
+	// like OpenSSH's sftp-server relative paths are resolved against the home directory,
+	// so that they match what scp and rsync, which run through exec requests, use.
+	server, err := sftp.NewServer(rwc, sftp.WithServerWorkingDirectory(workdir))
+	if err != nil {
+		return xerrors.Errorf("cannot create sftp server: %w", err)
+	}
+	defer server.Close()
+
+	err = server.Serve()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
 func prepareSSHKey(ctx context.Context, sshkey string) error {
 	bin, err := os.Executable()
 	if err != nil {
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/sftp"
+)
+
+type pipeConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+func TestServeSFTP(t *testing.T) {
+	home := t.TempDir()
+	err := os.WriteFile(filepath.Join(home, "existing.txt"), []byte("hello"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+	served := make(chan error, 1)
+	go func() {
+		served <- serveSFTP(pipeConn{serverReader, serverWriter}, home)
+	}()
+
+	client, err := sftp.NewClientPipe(clientReader, clientWriter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := client.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wd != home {
+		t.Errorf("working directory is %s, expected the home directory %s", wd, home)
+	}
+
+	// relative paths resolve against the home directory
+	f, err := client.Create("uploaded.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.Write([]byte("world"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	b, err := os.ReadFile(filepath.Join(home, "uploaded.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "world" {
+		t.Errorf("unexpected content of uploaded file: %q", b)
+	}
+
+	err = client.Mkdir("dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.Rename("uploaded.txt", "dir/renamed.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = client.Open(filepath.Join(home, "existing.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err = io.ReadAll(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "hello" {
+		t.Errorf("unexpected content of downloaded file: %q", b)
+	}
+
+	entries, err := client.ReadDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff([]string{"dir", "existing.txt"}, names); diff != "" {
+		t.Errorf("unexpected directory listing (-want +got):\n%s", diff)
+	}
+	if _, err := os.Stat(filepath.Join(home, "dir", "renamed.txt")); err != nil {
+		t.Errorf("renamed file does not exist: %v", err)
+	}
+
+	err = client.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("server did not end cleanly: %v", err)
+	}
+}
+
+func TestServeSFTPRequiresWorkspaceUser(t *testing.T) {
+	if os.Geteuid() == gitpodUID {
+		t.Skip("test runs as the workspace user")
+	}
+	err := ServeSFTP(pipeConn{})
+	if err == nil {
+		t.Error("sftp server started as a different user than the workspace user")
+	}
+}
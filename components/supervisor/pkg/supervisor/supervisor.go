@@ -56,6 +56,7 @@ import (
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/executor"
 	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/config"
@@ -317,6 +318,8 @@ func Run(options ...RunOption) {
 	if !cfg.isHeadless() && !opts.RunGP {
 		go analyseConfigChanges(ctx, cfg, telemetry, gitpodConfigService)
 		go analysePerfChanges(ctx, cfg, telemetry, topService)
+		go notifyResourcePressure(ctx, cfg, telemetry, notificationService, topService)
+		go notifyDevcontainerWarnings(ctx, notificationService, gitpodConfigService)
 	}
 
 	supervisorMetrics := metrics.NewMetrics()
@@ -338,6 +341,10 @@ func Run(options ...RunOption) {
 
 	willShutdownCtx, fireWillShutdown := context.WithCancel(ctx)
 	termMux := terminal.NewMux()
+	if !cfg.isHeadless() {
+		// task terminals keep their output across workspace restarts
+		termMux.ScrollbackLocation = logs.TerminalStoreLocation
+	}
 	termMuxSrv := terminal.NewMuxTerminalService(termMux)
 	termMuxSrv.DefaultWorkdir = cfg.RepoRoot
 	if cfg.WorkspaceRoot != "" {
@@ -353,6 +360,7 @@ func Run(options ...RunOption) {
 		}
 	}
 	termMuxSrv.Env = childProcEnvvars
+	termMuxSrv.RecordingLocation = filepath.Join(logs.TerminalStoreLocation, "recordings")
 	termMuxSrv.DefaultCreds = &syscall.Credential{
 		Uid: gitpodUID,
 		Gid: gitpodGID,
@@ -394,7 +402,7 @@ func Run(options ...RunOption) {
 		notificationService,
 		NewInfoService(cfg, cstate, gitpodService),
 		&ControlService{portsManager: portMgmt, gitpodService: gitpodService},
-		&portService{portsManager: portMgmt},
+		&portService{portsManager: portMgmt, inspector: ports.NewRequestInspector(50)},
 		&taskService{
 			wg:              taskServiceWg,
 			tasksManager:    taskManager,
@@ -1469,6 +1477,12 @@ func startAPIEndpoint(
 		}
 	}))
 
+	for _, reg := range services {
+		if reg, ok := reg.(RegisterableHTTPService); ok {
+			reg.RegisterHTTP(routes)
+		}
+	}
+
 	upgrader := websocket.Upgrader{}
 	routes.Handle("/_supervisor/tunnel", http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		wsConn, err := upgrader.Upgrade(rw, r, nil)
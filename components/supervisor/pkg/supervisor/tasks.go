@@ -11,18 +11,24 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
+	"net/http"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/terminal"
+	"golang.org/x/xerrors"
 )
 
 type tasksSubscription struct {
@@ -70,6 +76,37 @@ type task struct {
 	successChan chan taskSuccess
 	title       string
 	lastOutput  string
+
+	// dependsOn are the tasks which must be ready before this task is started
+	dependsOn []*task
+	// readyChan is closed once the task satisfies its readiness condition
+	readyChan chan struct{}
+	readyOnce sync.Once
+	// closedChan is closed once the task is closed or blocked, i.e. won't become ready anymore
+	closedChan chan struct{}
+	closedOnce sync.Once
+
+	// restartBackoff delays restarts of a task which keeps on exiting
+	restartBackoff *backoff.ExponentialBackOff
+	// startedAt is when the task terminal was last started
+	startedAt time.Time
+}
+
+func (t *task) markReady() {
+	t.readyOnce.Do(func() { close(t.readyChan) })
+}
+
+func (t *task) markClosed() {
+	t.closedOnce.Do(func() { close(t.closedChan) })
+}
+
+func (t *task) isReady() bool {
+	select {
+	case <-t.readyChan:
+		return true
+	default:
+		return false
+	}
 }
 
 type headlessTaskProgressReporter interface {
@@ -185,6 +222,18 @@ func (tm *tasksManager) setTaskState(t *task, newState api.TaskState) {
 	})
 }
 
+// closeTask marks the task as closed and releases everyone waiting for it.
+// A task which exits right after it satisfied its readiness condition, e.g. a readyWhen succeeded task
+// which completes within the readiness interval, is marked ready before its dependents are released.
+func (tm *tasksManager) closeTask(t *task) {
+	if !t.isReady() && t.config.ReadyWhen != nil && tm.readinessConditionHolds(t) {
+		log.WithField("task", t.title).Info("task is ready")
+		t.markReady()
+	}
+	tm.setTaskState(t, api.TaskState_closed)
+	t.markClosed()
+}
+
 func (tm *tasksManager) init(ctx context.Context) {
 	defer close(tm.ready)
 
@@ -232,14 +281,94 @@ func (tm *tasksManager) init(ctx context.Context) {
 			config:      config,
 			successChan: make(chan taskSuccess, 1),
 			title:       presentation.Name,
+			readyChan:   make(chan struct{}),
+			closedChan:  make(chan struct{}),
 		}
+		_ = os.Remove(readyMarkerFileName(task, tm.storeLocation))
 		task.command = getCommand(task, tm.config.isHeadless(), tm.config.isPrebuild(), tm.contentSource, tm.storeLocation)
 		if tm.config.isHeadless() && task.command == "exit" {
 			task.State = api.TaskState_closed
 			task.successChan <- taskSuccessful
+			task.markReady()
+			task.markClosed()
 		}
 		tm.tasks = append(tm.tasks, task)
 	}
+
+	err = resolveTaskDependencies(tm.tasks)
+	if err != nil {
+		log.WithError(err).Error("invalid task dependencies: tasks with dependencies will not be started")
+		for _, task := range tm.tasks {
+			if len(task.config.DependsOn) == 0 || task.State == api.TaskState_closed {
+				continue
+			}
+			task.State = api.TaskState_blocked
+			task.successChan <- taskFailed(fmt.Sprintf("%s: %s", task.title, err.Error()))
+			task.markClosed()
+		}
+	}
+}
+
+// resolveTaskDependencies links the tasks to the tasks they depend on by name
+// and verifies that the dependencies form a directed acyclic graph.
+func resolveTaskDependencies(tasks []*task) error {
+	byName := make(map[string]*task, len(tasks))
+	for _, t := range tasks {
+		if _, exists := byName[t.title]; exists {
+			// a nil entry marks an ambiguous name
+			byName[t.title] = nil
+			continue
+		}
+		byName[t.title] = t
+	}
+
+	for _, t := range tasks {
+		t.dependsOn = nil
+		for _, name := range t.config.DependsOn {
+			dep, exists := byName[name]
+			if !exists {
+				return xerrors.Errorf("task %q depends on unknown task %q", t.title, name)
+			}
+			if dep == nil {
+				return xerrors.Errorf("task %q depends on %q which is not a unique task name", t.title, name)
+			}
+			t.dependsOn = append(t.dependsOn, dep)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make(map[*task]int, len(tasks))
+	var visit func(t *task, path []string) error
+	visit = func(t *task, path []string) error {
+		path = append(path, t.title)
+		switch marks[t] {
+		case visited:
+			return nil
+		case visiting:
+			return xerrors.Errorf("task dependencies form a cycle: %s", strings.Join(path, " -> "))
+		}
+		marks[t] = visiting
+		for _, dep := range t.dependsOn {
+			if err := visit(dep, path); err != nil {
+				return err
+			}
+		}
+		marks[t] = visited
+		return nil
+	}
+	for _, t := range tasks {
+		if marks[t] != unvisited {
+			continue
+		}
+		if err := visit(t, nil); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (tm *tasksManager) waitForIde(parent context.Context, timeout time.Duration) {
@@ -271,111 +400,394 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 	tm.init(ctx)
 
 	for _, t := range tm.tasks {
-		if t.State == api.TaskState_closed {
+		if t.State == api.TaskState_closed || t.State == api.TaskState_blocked {
 			continue
 		}
-		taskLog := log.WithField("command", t.command)
-		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
-		if t.config.Env != nil {
-			openRequest.Env = make(map[string]string, len(*t.config.Env))
-			for key, value := range *t.config.Env {
-				// Required check because a string is considered valid JSON (e.g. "hello")
-				// We don't want to marshall basic strings otherwise we get a double quoted environment variable
-				// See: https://github.com/gitpod-io/gitpod/issues/5887
-				if val, ok := value.(string); ok {
-					openRequest.Env[key] = val
+		if len(t.dependsOn) > 0 {
+			go tm.awaitDependencies(ctx, t)
+			continue
+		}
+		tm.startTask(ctx, t)
+	}
+
+	var success taskSuccess
+	for _, task := range tm.tasks {
+		select {
+		case <-ctx.Done():
+			success = taskFailed(ctx.Err().Error())
+		case taskResult := <-task.successChan:
+			if taskResult.Failed() {
+				success = success.Fail(string(taskResult))
+			}
+		}
+	}
+
+	if tm.config.isPrebuild() && tm.reporter != nil {
+		tm.reporter.done(success)
+	}
+	successChan <- success
+}
+
+// startTask opens the task terminal and runs the task command in it.
+func (tm *tasksManager) startTask(ctx context.Context, t *task) {
+	taskLog := log.WithField("command", t.command)
+	taskLog.Info("starting a task terminal...")
+	openRequest := &api.OpenTerminalRequest{}
+	if t.config.Env != nil {
+		openRequest.Env = make(map[string]string, len(*t.config.Env))
+		for key, value := range *t.config.Env {
+			// Required check because a string is considered valid JSON (e.g. "hello")
+			// We don't want to marshall basic strings otherwise we get a double quoted environment variable
+			// See: https://github.com/gitpod-io/gitpod/issues/5887
+			if val, ok := value.(string); ok {
+				openRequest.Env[key] = val
+			} else {
+				v, err := json.Marshal(value)
+				if err != nil {
+					taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
 				} else {
-					v, err := json.Marshal(value)
-					if err != nil {
-						taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
-					} else {
-						openRequest.Env[key] = string(v)
-					}
+					openRequest.Env[key] = string(v)
 				}
 			}
 		}
-		resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
-			ReadTimeout: 5 * time.Second,
-			Title:       t.title,
-		})
-		if err != nil {
-			taskLog.WithError(err).Error("cannot open new task terminal")
-			t.successChan <- taskFailed("cannot open new task terminal")
-			tm.setTaskState(t, api.TaskState_closed)
+	}
+	resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
+		ReadTimeout:  5 * time.Second,
+		Title:        t.title,
+		PersistentID: "task-" + t.Id,
+	})
+	if err != nil {
+		taskLog.WithError(err).Error("cannot open new task terminal")
+		t.successChan <- taskFailed("cannot open new task terminal")
+		tm.closeTask(t)
+		return
+	}
+
+	taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
+	term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
+	if !ok {
+		taskLog.Error("cannot find a task terminal")
+		t.successChan <- taskFailed("cannot find a task terminal")
+		tm.closeTask(t)
+		return
+	}
+
+	taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
+	taskLog.Info("task terminal has been started")
+	t.startedAt = time.Now()
+	tm.updateState(func() bool {
+		t.Terminal = resp.Terminal.Alias
+		t.State = api.TaskState_running
+		t.WaitingFor = nil
+		return true
+	})
+	if !t.isReady() {
+		go tm.awaitReadiness(ctx, t)
+	}
+
+	exited := make(chan struct{})
+	if t.config.HealthCheck != nil && !tm.config.isHeadless() {
+		go tm.monitorHealth(ctx, t, resp.Terminal.Alias, exited)
+	}
+
+	taskWatchWg := &sync.WaitGroup{}
+
+	go func(t *task, term *terminal.Term) {
+		state, err := term.Wait()
+		close(exited)
+		taskLog.Info("task terminal has been closed. Waiting for watch() to finish...")
+		taskWatchWg.Wait()
+
+		if tm.shouldRestart(t, term, state) {
+			tm.restartTask(ctx, t)
+			return
+		}
+		taskLog.Info("watch() has finished, setting task state to closed")
+
+		if term.ForceSuccess {
+			// Simulate state.Success()
+			t.successChan <- taskSuccessful
+		} else if state != nil {
+			if state.Success() {
+				t.successChan <- taskSuccessful
+			} else {
+				t.successChan <- taskFailed(state.String())
+			}
+		} else if err != nil {
+			t.successChan <- taskSuccessful
+		} else {
+			msg := "cannot wait for task"
+			if err != nil {
+				msg = err.Error()
+			}
+
+			t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
+		}
+		tm.closeTask(t)
+	}(t, term)
+
+	tm.watch(t, term, taskWatchWg)
+
+	if t.command != "" {
+		term.PTY.Write([]byte(t.command + "\n"))
+	}
+}
+
+// shouldRestart decides whether a task whose terminal exited is restarted according to its restart policy.
+// Tasks are never restarted in prebuilds or if they were stopped by the user.
+func (tm *tasksManager) shouldRestart(t *task, term *terminal.Term, state *os.ProcessState) bool {
+	if tm.config.isHeadless() || term.ForceSuccess || term.StoppedByUser() {
+		return false
+	}
+	switch t.config.restartPolicy() {
+	case TaskRestartAlways:
+	case TaskRestartOnFailure:
+		if state != nil && state.Success() {
+			return false
+		}
+	default:
+		return false
+	}
+	if int(t.RestartCount) >= t.config.maxRestarts() {
+		log.WithField("task", t.title).WithField("restarts", t.RestartCount).Warn("task exceeded its maximum number of restarts, it won't be restarted anymore")
+		return false
+	}
+	return true
+}
+
+const (
+	maxIntervalBetweenTaskRestarts = 1 * time.Minute
+	// a task which was running for longer than this is considered stable again
+	taskRestartResetPeriod = 5 * time.Minute
+)
+
+// restartTask starts the task again after backing off. Restarted tasks only run their before and command parts.
+func (tm *tasksManager) restartTask(ctx context.Context, t *task) {
+	if t.restartBackoff == nil {
+		t.restartBackoff = backoff.NewExponentialBackOff()
+		t.restartBackoff.MaxInterval = maxIntervalBetweenTaskRestarts
+		t.restartBackoff.MaxElapsedTime = 0
+	}
+	if time.Since(t.startedAt) > taskRestartResetPeriod {
+		t.restartBackoff.Reset()
+	}
+	delay := t.restartBackoff.NextBackOff()
+
+	tm.updateState(func() bool {
+		t.State = api.TaskState_opening
+		t.Terminal = ""
+		t.Health = api.TaskHealth_unknown_health
+		t.RestartCount++
+		return true
+	})
+	log.WithField("task", t.title).WithField("restarts", t.RestartCount).WithField("backoff", delay.String()).Info("restarting task")
+
+	select {
+	case <-ctx.Done():
+		t.successChan <- taskFailed(fmt.Sprintf("%s: %s", t.title, ctx.Err().Error()))
+		tm.closeTask(t)
+		return
+	case <-time.After(delay):
+	}
+
+	t.command = getCommand(t, false, false, csapi.WorkspaceInitFromBackup, tm.storeLocation)
+	tm.startTask(ctx, t)
+}
+
+// monitorHealth runs the health check of the task until its terminal exits. Once the check failed
+// often enough in a row the task is marked unhealthy and, if it has a restart policy, its terminal is closed
+// so that it gets restarted.
+func (tm *tasksManager) monitorHealth(ctx context.Context, t *task, alias string, exited <-chan struct{}) {
+	var (
+		hc        = t.config.HealthCheck
+		interval  = hc.interval()
+		threshold = hc.failureThreshold()
+		failures  uint32
+		taskLog   = log.WithField("task", t.title)
+	)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-exited:
+			return
+		case <-ticker.C:
+		}
+
+		err := probeTaskHealth(ctx, *hc, tm.config.RepoRoot, interval)
+		if err == nil {
+			failures = 0
+			tm.setTaskHealth(t, api.TaskHealth_healthy)
+			continue
+		}
+		failures++
+		taskLog.WithError(err).WithField("failures", failures).Debug("task health check failed")
+		if failures < threshold {
 			continue
 		}
 
-		taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
-		term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
-		if !ok {
-			taskLog.Error("cannot find a task terminal")
-			t.successChan <- taskFailed("cannot find a task terminal")
-			tm.setTaskState(t, api.TaskState_closed)
+		tm.setTaskHealth(t, api.TaskHealth_unhealthy)
+		if t.config.restartPolicy() == TaskRestartNever {
 			continue
 		}
+		taskLog.WithError(err).Warn("task is unhealthy, restarting it")
+		err = tm.terminalService.Mux.CloseTerminal(ctx, alias, false)
+		if err != nil {
+			taskLog.WithError(err).Error("cannot close terminal of unhealthy task")
+			continue
+		}
+		return
+	}
+}
 
-		taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
-		taskLog.Info("task terminal has been started")
-		tm.updateState(func() bool {
-			t.Terminal = resp.Terminal.Alias
-			t.State = api.TaskState_running
-			return true
-		})
+func (tm *tasksManager) setTaskHealth(t *task, health api.TaskHealth) {
+	tm.updateState(func() bool {
+		if t.Health == health {
+			return false
+		}
+		t.Health = health
+		return true
+	})
+}
 
-		taskWatchWg := &sync.WaitGroup{}
+// probeTaskHealth runs a single health check, returning an error if the task is not healthy.
+func probeTaskHealth(ctx context.Context, hc TaskHealthCheck, repoRoot string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-		go func(t *task, term *terminal.Term) {
-			state, err := term.Wait()
-			taskLog.Info("task terminal has been closed. Waiting for watch() to finish...")
-			taskWatchWg.Wait()
-			taskLog.Info("watch() has finished, setting task state to closed")
+	if hc.Command != nil {
+		cmd := runAsGitpodUser(exec.CommandContext(ctx, "/bin/sh", "-c", *hc.Command))
+		cmd.Dir = repoRoot
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return xerrors.Errorf("health check command failed: %w: %s", err, strings.TrimSpace(string(out)))
+		}
+	}
+	if hc.Port != nil {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://localhost:%d%s", *hc.Port, hc.path()), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return xerrors.Errorf("health check request failed: %w", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			return xerrors.Errorf("health check request failed with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
 
-			if term.ForceSuccess {
-				// Simulate state.Success()
-				t.successChan <- taskSuccessful
-			} else if state != nil {
-				if state.Success() {
-					t.successChan <- taskSuccessful
-				} else {
-					t.successChan <- taskFailed(state.String())
-				}
-			} else if err != nil {
-				t.successChan <- taskSuccessful
-			} else {
-				msg := "cannot wait for task"
-				if err != nil {
-					msg = err.Error()
-				}
+// awaitDependencies starts the task once all tasks it depends on are ready,
+// or blocks it if one of them closes without ever becoming ready.
+func (tm *tasksManager) awaitDependencies(ctx context.Context, t *task) {
+	tm.updateState(func() bool {
+		t.State = api.TaskState_waiting
+		t.WaitingFor = make([]string, 0, len(t.dependsOn))
+		for _, dep := range t.dependsOn {
+			t.WaitingFor = append(t.WaitingFor, dep.Id)
+		}
+		return true
+	})
+
+	for _, dep := range t.dependsOn {
+		select {
+		case <-ctx.Done():
+			return
+		case <-dep.readyChan:
+		case <-dep.closedChan:
+		}
+		if !dep.isReady() {
+			log.WithField("task", t.title).WithField("dependency", dep.title).Warn("task dependency closed without becoming ready, task will not be started")
+			t.successChan <- taskFailed(fmt.Sprintf("%s: dependency %s never became ready", t.title, dep.title))
+			tm.setTaskState(t, api.TaskState_blocked)
+			t.markClosed()
+			return
+		}
 
-				t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
+		tm.updateState(func() bool {
+			waitingFor := make([]string, 0, len(t.WaitingFor))
+			for _, id := range t.WaitingFor {
+				if id != dep.Id {
+					waitingFor = append(waitingFor, id)
+				}
 			}
-			tm.setTaskState(t, api.TaskState_closed)
-		}(t, term)
+			t.WaitingFor = waitingFor
+			return true
+		})
+	}
+
+	tm.startTask(ctx, t)
+}
 
-		tm.watch(t, term, taskWatchWg)
+const taskReadinessInterval = 1 * time.Second
 
-		if t.command != "" {
-			term.PTY.Write([]byte(t.command + "\n"))
-		}
+// awaitReadiness marks the task ready once its readyWhen condition holds.
+// Tasks without a condition are ready as soon as their terminal is running.
+func (tm *tasksManager) awaitReadiness(ctx context.Context, t *task) {
+	if t.config.ReadyWhen == nil {
+		t.markReady()
+		return
 	}
 
-	var success taskSuccess
-	for _, task := range tm.tasks {
+	ticker := time.NewTicker(taskReadinessInterval)
+	defer ticker.Stop()
+	for {
+		if tm.readinessConditionHolds(t) {
+			log.WithField("task", t.title).Info("task is ready")
+			t.markReady()
+			return
+		}
+
 		select {
 		case <-ctx.Done():
-			success = taskFailed(ctx.Err().Error())
-		case taskResult := <-task.successChan:
-			if taskResult.Failed() {
-				success = success.Fail(string(taskResult))
-			}
+			return
+		case <-t.closedChan:
+			// closeTask checked the condition a last time before closing the task
+			return
+		case <-ticker.C:
 		}
 	}
+}
 
-	if tm.config.isPrebuild() && tm.reporter != nil {
-		tm.reporter.done(success)
+// readinessConditionHolds checks the readyWhen condition of the task
+func (tm *tasksManager) readinessConditionHolds(t *task) bool {
+	cond := t.config.ReadyWhen
+	if cond == nil {
+		return true
+	}
+
+	if cond.Port != nil {
+		conn, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%d", *cond.Port), taskReadinessInterval)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+	}
+	if cond.File != nil {
+		fn := *cond.File
+		if !filepath.IsAbs(fn) {
+			fn = filepath.Join(tm.config.RepoRoot, fn)
+		}
+		if !fileExists(fn)() {
+			return false
+		}
+	}
+	if cond.Succeeded && !fileExists(readyMarkerFileName(t, tm.storeLocation))() {
+		return false
+	}
+	return true
+}
+
+func fileExists(fn string) func() bool {
+	return func() bool {
+		_, err := os.Stat(fn)
+		return err == nil
 	}
-	successChan <- success
 }
 
 func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csapi.WorkspaceInitSource, storeLocation string) string {
@@ -385,6 +797,14 @@ func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csap
 		format:   "{\n%s\n}",
 		sep:      " && ",
 	})
+	if task.config.ReadyWhen != nil && task.config.ReadyWhen.Succeeded {
+		readyMarker := "touch " + readyMarkerFileName(task, storeLocation)
+		if strings.TrimSpace(command) == "" {
+			command = readyMarker
+		} else {
+			command += " && " + readyMarker
+		}
+	}
 
 	if isHeadless {
 		// it's important that prebuild tasks exit eventually
@@ -395,6 +815,11 @@ func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csap
 		return command + "; exit"
 	}
 
+	if task.config.restartPolicy() != TaskRestartNever && strings.TrimSpace(command) != "" {
+		// the terminal must exit together with the command for the task to be restarted
+		command += "; exit"
+	}
+
 	histfileCommand := getHistfileCommand(task, commands, contentSource, storeLocation)
 	if strings.TrimSpace(command) == "" {
 		return histfileCommand
@@ -454,6 +879,11 @@ func prebuildLogFileName(task *task, storeLocation string) string {
 	return logs.PrebuildLogFileName(storeLocation, task.Id)
 }
 
+// readyMarkerFileName is the file a task touches once its commands have completed successfully.
+func readyMarkerFileName(task *task, storeLocation string) string {
+	return storeLocation + "/ready-" + task.Id
+}
+
 func (tm *tasksManager) watch(task *task, term *terminal.Term, wg *sync.WaitGroup) {
 	if !tm.config.isPrebuild() {
 		return
@@ -7,10 +7,14 @@ package supervisor
 import (
 	"context"
 	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sirupsen/logrus"
@@ -22,8 +26,9 @@ import (
 )
 
 var (
-	skipCommand = "echo \"skip\""
-	failCommand = "exit 1"
+	skipCommand        = "echo \"skip\""
+	failCommand        = "exit 1"
+	dependencyTaskName = "dependency"
 )
 
 var exampleEnvVarInputs = &map[string]interface{}{
@@ -197,6 +202,47 @@ func TestTaskManager(t *testing.T) {
 				Success: true,
 			},
 		},
+		{
+			Desc:     "headless prebuild should run dependent tasks once their dependencies succeeded",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: &dependencyTaskName, Init: &skipCommand, ReadyWhen: &TaskReadyCondition{Succeeded: true}},
+				{Init: &skipCommand, DependsOn: []string{dependencyTaskName}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
+			},
+		},
+		{
+			Desc:     "headless prebuild should fail if a dependency never becomes ready",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: &dependencyTaskName, Init: &failCommand, ReadyWhen: &TaskReadyCondition{Succeeded: true}},
+				{Init: &skipCommand, DependsOn: []string{dependencyTaskName}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: false,
+			},
+		},
+		{
+			Desc:     "headless prebuild should fail on cyclic task dependencies",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: &dependencyTaskName, Init: &skipCommand, DependsOn: []string{dependencyTaskName}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: false,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Desc, func(t *testing.T) {
@@ -294,6 +340,25 @@ func TestGetTask(t *testing.T) {
 			ContentSource: csapi.WorkspaceInitFromOther,
 			Expectation:   " HISTFILE=//cmd-0 history -r; {\nbefore\n} && {\ninit\n} && {\ncommand\n}",
 		},
+		{
+			Name:          "with restart policy",
+			Task:          TaskConfig{Command: p("command"), Restart: p(TaskRestartOnFailure)},
+			ContentSource: csapi.WorkspaceInitFromOther,
+			Expectation:   " HISTFILE=//cmd-0 history -r; {\ncommand\n}; exit",
+		},
+		{
+			Name:          "restart policy is ignored in prebuilds",
+			Task:          TaskConfig{Init: p("init"), Command: p("command"), Restart: p(TaskRestartAlways)},
+			IsHeadless:    true,
+			ContentSource: csapi.WorkspaceInitFromOther,
+			Expectation:   "{\ninit\n}; exit",
+		},
+		{
+			Name:          "restart policy without command",
+			Task:          TaskConfig{Restart: p(TaskRestartAlways)},
+			ContentSource: csapi.WorkspaceInitFromOther,
+			Expectation:   "",
+		},
 	}
 
 	for _, test := range tests {
@@ -351,3 +416,157 @@ func TestTaskSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveTaskDependencies(t *testing.T) {
+	p := func(v string) *string { return &v }
+	tests := []struct {
+		Name        string
+		Tasks       []TaskConfig
+		Expectation map[string][]string
+		Error       bool
+	}{
+		{
+			Name:        "no dependencies",
+			Tasks:       []TaskConfig{{}, {}},
+			Expectation: map[string][]string{},
+		},
+		{
+			Name: "chain",
+			Tasks: []TaskConfig{
+				{Name: p("db")},
+				{Name: p("api"), DependsOn: []string{"db"}},
+				{Name: p("web"), DependsOn: []string{"api", "db"}},
+			},
+			Expectation: map[string][]string{
+				"api": {"db"},
+				"web": {"api", "db"},
+			},
+		},
+		{
+			Name: "default task names",
+			Tasks: []TaskConfig{
+				{},
+				{DependsOn: []string{"Gitpod Task 1"}},
+			},
+			Expectation: map[string][]string{
+				"Gitpod Task 2": {"Gitpod Task 1"},
+			},
+		},
+		{
+			Name: "unknown dependency",
+			Tasks: []TaskConfig{
+				{Name: p("api"), DependsOn: []string{"db"}},
+			},
+			Error: true,
+		},
+		{
+			Name: "ambiguous dependency",
+			Tasks: []TaskConfig{
+				{Name: p("db")},
+				{Name: p("db")},
+				{Name: p("api"), DependsOn: []string{"db"}},
+			},
+			Error: true,
+		},
+		{
+			Name: "self dependency",
+			Tasks: []TaskConfig{
+				{Name: p("api"), DependsOn: []string{"api"}},
+			},
+			Error: true,
+		},
+		{
+			Name: "cycle",
+			Tasks: []TaskConfig{
+				{Name: p("a"), DependsOn: []string{"c"}},
+				{Name: p("b"), DependsOn: []string{"a"}},
+				{Name: p("c"), DependsOn: []string{"b"}},
+			},
+			Error: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var tasks []*task
+			for i, config := range test.Tasks {
+				title := "Gitpod Task " + strconv.Itoa(i+1)
+				if config.Name != nil {
+					title = *config.Name
+				}
+				tasks = append(tasks, &task{TaskStatus: api.TaskStatus{Id: strconv.Itoa(i)}, config: config, title: title})
+			}
+
+			err := resolveTaskDependencies(tasks)
+			if test.Error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := make(map[string][]string)
+			for _, task := range tasks {
+				for _, dep := range task.dependsOn {
+					act[task.title] = append(act[task.title], dep.title)
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProbeTaskHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	_, portStr, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := strconv.ParseUint(portStr, 10, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := uint32(p)
+	unusedPort := uint32(0)
+	path := func(v string) *string { return &v }
+
+	tests := []struct {
+		Name        string
+		HealthCheck TaskHealthCheck
+		Healthy     bool
+	}{
+		{
+			Name:        "healthy",
+			HealthCheck: TaskHealthCheck{Port: &port, Path: path("healthz")},
+			Healthy:     true,
+		},
+		{
+			Name:        "error status",
+			HealthCheck: TaskHealthCheck{Port: &port},
+		},
+		{
+			Name:        "connection refused",
+			HealthCheck: TaskHealthCheck{Port: &unusedPort},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := probeTaskHealth(context.Background(), test.HealthCheck, "", 5*time.Second)
+			if diff := cmp.Diff(test.Healthy, err == nil); diff != "" {
+				t.Errorf("unexpected health (-want +got):\n%s\nerror: %v", diff, err)
+			}
+		})
+	}
+}
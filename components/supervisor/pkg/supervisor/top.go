@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	linuxproc "github.com/c9s/goprocinfo/linux"
@@ -87,10 +88,19 @@ func calcSeverity(value int64) api.ResourceStatusSeverity {
 	}
 }
 
+// workspaceDiskLocation is the location whose disk usage is reported.
+// It is subject to the disk quota of the workspace.
+const workspaceDiskLocation = "/workspace"
+
 // Top provides workspace resources status information.
 func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 	const socketFN = "/.supervisor/info.sock"
 
+	disk, err := resolveDiskStatus(workspaceDiskLocation)
+	if err != nil {
+		log.WithError(err).Debug("cannot resolve disk status")
+	}
+
 	if _, err := os.Stat(socketFN); os.IsNotExist(err) {
 		memory, err := resolveMemoryStatus()
 		if err != nil {
@@ -110,6 +120,7 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		return &api.ResourcesStatusResponse{
 			Memory: memory,
 			Cpu:    cpu,
+			Disk:   disk,
 		}, nil
 	} else {
 		conn, err := grpc.DialContext(ctx, "unix://"+socketFN, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -127,6 +138,15 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		cpuPercentage := int64((float64(resp.Resources.Cpu.Used) / float64(resp.Resources.Cpu.Limit)) * 100)
 		memoryPercentage := int64((float64(resp.Resources.Memory.Used) / float64(resp.Resources.Memory.Limit)) * 100)
 
+		var pressure *api.ResourcePressure
+		if p := resp.Resources.GetPressure(); p != nil {
+			pressure = &api.ResourcePressure{
+				Memory: p.Memory,
+				Io:     p.Io,
+				Reason: p.Reason,
+			}
+		}
+
 		return &api.ResourcesStatusResponse{
 			Memory: &api.ResourceStatus{
 				Limit:    resp.Resources.Memory.Limit,
@@ -138,6 +158,8 @@ func Top(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 				Used:     resp.Resources.Cpu.Used,
 				Severity: calcSeverity(cpuPercentage),
 			},
+			Pressure: pressure,
+			Disk:     disk,
 		}, nil
 	}
 }
@@ -182,6 +204,30 @@ func resolveMemoryStatus() (*api.ResourceStatus, error) {
 	}, nil
 }
 
+// resolveDiskStatus reports the disk usage of the filesystem at path. For a directory with an XFS project quota
+// the kernel reports the quota as the size of the filesystem.
+func resolveDiskStatus(path string) (*api.ResourceStatus, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot stat filesystem of %s: %w", path, err)
+	}
+
+	limit := int64(stat.Blocks) * stat.Bsize
+	used := int64(stat.Blocks-stat.Bfree) * stat.Bsize
+
+	var percentage int64
+	if limit > 0 {
+		percentage = int64((float64(used) / float64(limit)) * 100)
+	}
+
+	return &api.ResourceStatus{
+		Limit:    limit,
+		Used:     used,
+		Severity: calcSeverity(percentage),
+	}, nil
+}
+
 func resolveCPUStatus() (*api.ResourceStatus, error) {
 	t, err := resolveCPUStat()
 	if err != nil {
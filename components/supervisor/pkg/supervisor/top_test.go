@@ -92,3 +92,21 @@ func TestTopServiceWithUpstreamFailure(t *testing.T) {
 		t.Errorf("Total Cpu should be 5")
 	}
 }
+
+func TestResolveDiskStatus(t *testing.T) {
+	disk, err := resolveDiskStatus(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if disk.Limit <= 0 {
+		t.Errorf("disk limit should be positive, got %d", disk.Limit)
+	}
+	if disk.Used < 0 || disk.Used > disk.Limit {
+		t.Errorf("used disk space should be between 0 and %d, got %d", disk.Limit, disk.Used)
+	}
+
+	_, err = resolveDiskStatus("/does/not/exist")
+	if err == nil {
+		t.Errorf("expected an error for a location that does not exist")
+	}
+}
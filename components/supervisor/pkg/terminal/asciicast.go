@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	_pty "github.com/creack/pty"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+var (
+	// ErrAlreadyRecording means the terminal is already being recorded.
+	ErrAlreadyRecording = errors.New("already recording")
+	// ErrNotRecording means the terminal is not being recorded.
+	ErrNotRecording = errors.New("not recording")
+)
+
+// recordingTitleInterval is how often the terminal title is checked for changes while recording.
+const recordingTitleInterval = 200 * time.Millisecond
+
+// asciicastHeader is the first line of an asciicast v2 file.
+// See https://docs.asciinema.org/manual/asciicast/v2/
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// asciicastRecorder writes terminal events to a file in the asciicast v2 format.
+type asciicastRecorder struct {
+	Path string
+
+	mu      sync.Mutex
+	file    *os.File
+	out     *bufio.Writer
+	start   time.Time
+	pending []byte
+	closed  bool
+	done    chan struct{}
+}
+
+func newAsciicastRecorder(fn string, header asciicastHeader) (*asciicastRecorder, error) {
+	err := os.MkdirAll(filepath.Dir(fn), 0o755)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(fn, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	header.Version = 2
+	header.Timestamp = start.Unix()
+	h, err := json.Marshal(header)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	out := bufio.NewWriter(f)
+	_, err = out.Write(append(h, '\n'))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &asciicastRecorder{
+		Path:  fn,
+		file:  f,
+		out:   out,
+		start: start,
+		done:  make(chan struct{}),
+	}, nil
+}
+
+// Output records output of the terminal.
+func (r *asciicastRecorder) Output(p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// events must be valid UTF-8, hence incomplete runes are held back until the rest of them arrives
+	data := append(r.pending, p...)
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	r.pending = append([]byte(nil), data[cut:]...)
+	if cut == 0 {
+		return
+	}
+	r.event("o", string(data[:cut]))
+}
+
+// Resize records a change of the terminal size.
+func (r *asciicastRecorder) Resize(cols, rows uint16) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.event("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Marker records a marker with the given label, e.g. for title changes.
+func (r *asciicastRecorder) Marker(label string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.event("m", label)
+}
+
+// event writes an event. Callers are expected to hold mu.
+func (r *asciicastRecorder) event(code, data string) {
+	if r.closed {
+		return
+	}
+	// asciinema itself records with microsecond precision
+	elapsed := math.Round(time.Since(r.start).Seconds()*1e6) / 1e6
+	line, err := json.Marshal([]interface{}{elapsed, code, data})
+	if err != nil {
+		log.WithError(err).WithField("path", r.Path).Warn("cannot marshal asciicast event")
+		return
+	}
+	_, err = r.out.Write(append(line, '\n'))
+	if err != nil {
+		log.WithError(err).WithField("path", r.Path).Warn("cannot write asciicast event")
+	}
+}
+
+// Close flushes the recording and closes its file.
+func (r *asciicastRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	if len(r.pending) > 0 {
+		r.event("o", string(r.pending))
+		r.pending = nil
+	}
+	r.closed = true
+	close(r.done)
+
+	err := r.out.Flush()
+	if cerr := r.file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot write recording %s: %w", r.Path, err)
+	}
+	return nil
+}
+
+// StartRecording starts recording the terminal to the given file in the asciicast v2 format.
+func (term *Term) StartRecording(fn string) error {
+	title, _, _ := term.GetTitle()
+	header := asciicastHeader{
+		Width:  DEFAULT_COLS,
+		Height: DEFAULT_ROWS,
+		Title:  title,
+		Env: map[string]string{
+			"SHELL": term.Command.Path,
+			"TERM":  "xterm-256color",
+		},
+	}
+	if size, err := _pty.GetsizeFull(term.PTY); err == nil {
+		header.Width = size.Cols
+		header.Height = size.Rows
+	}
+
+	term.Stdout.mu.Lock()
+	defer term.Stdout.mu.Unlock()
+	if term.Stdout.cast != nil {
+		return ErrAlreadyRecording
+	}
+	rec, err := newAsciicastRecorder(fn, header)
+	if err != nil {
+		return err
+	}
+	term.Stdout.cast = rec
+
+	go term.recordTitleChanges(rec, title)
+	return nil
+}
+
+// StopRecording stops the recording of the terminal and returns the file it was written to.
+func (term *Term) StopRecording() (string, error) {
+	term.Stdout.mu.Lock()
+	rec := term.Stdout.cast
+	term.Stdout.cast = nil
+	term.Stdout.mu.Unlock()
+
+	if rec == nil {
+		return "", ErrNotRecording
+	}
+	return rec.Path, rec.Close()
+}
+
+func (term *Term) recording() *asciicastRecorder {
+	term.Stdout.mu.RLock()
+	defer term.Stdout.mu.RUnlock()
+	return term.Stdout.cast
+}
+
+func (term *Term) recordTitleChanges(rec *asciicastRecorder, title string) {
+	ticker := time.NewTicker(recordingTitleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-rec.done:
+			return
+		case <-ticker.C:
+		}
+
+		newTitle, _, _ := term.GetTitle()
+		if newTitle == title {
+			continue
+		}
+		title = newTitle
+		rec.Marker("title: " + title)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// scrollbackSpillInterval is how often the output of a persistent terminal is spilled to disk.
+// Output produced after the last spill is lost if the workspace does not shut down gracefully.
+const scrollbackSpillInterval = 10 * time.Second
+
+// scrollbackFileName is the file the output of the persistent terminal with the given ID is spilled to.
+func scrollbackFileName(location, id string) string {
+	return filepath.Join(location, "scrollback-"+id+".gz")
+}
+
+// readScrollback reads a spilled scrollback. It returns no content if there is none.
+func readScrollback(fn string) ([]byte, error) {
+	f, err := os.Open(fn)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read scrollback %s: %w", fn, err)
+	}
+	defer r.Close()
+
+	// the spilled content never exceeds the size of the ring buffer it comes from
+	content, err := io.ReadAll(io.LimitReader(r, terminalBacklogSize))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read scrollback %s: %w", fn, err)
+	}
+	return content, nil
+}
+
+// writeScrollback atomically replaces the spilled scrollback with content.
+func writeScrollback(fn string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(fn), 0o755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(fn), filepath.Base(fn)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := gzip.NewWriter(f)
+	_, err = w.Write(content)
+	if err == nil {
+		err = w.Close()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot write scrollback %s: %w", fn, err)
+	}
+	return os.Rename(f.Name(), fn)
+}
+
+// spillScrollback writes the recorded output of the terminal to its scrollback file
+// if there was new output since the last spill.
+func (term *Term) spillScrollback() {
+	if term.scrollbackFile == "" {
+		return
+	}
+
+	term.spillMu.Lock()
+	defer term.spillMu.Unlock()
+
+	content, written := term.Stdout.recording()
+	if written == term.spilled {
+		return
+	}
+	err := writeScrollback(term.scrollbackFile, content)
+	if err != nil {
+		log.WithError(err).WithField("file", term.scrollbackFile).Warn("cannot spill terminal scrollback")
+		return
+	}
+	term.spilled = written
+}
+
+// spillScrollbackPeriodically spills the scrollback until the terminal process exits.
+// The final spill happens when the terminal is closed.
+func (term *Term) spillScrollbackPeriodically() {
+	ticker := time.NewTicker(scrollbackSpillInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-term.waitDone:
+			return
+		case <-ticker.C:
+			term.spillScrollback()
+		}
+	}
+}
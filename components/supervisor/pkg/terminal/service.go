@@ -8,9 +8,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
@@ -32,10 +34,11 @@ func NewMuxTerminalService(m *Mux) *MuxTerminalService {
 		shell = "/bin/bash"
 	}
 	return &MuxTerminalService{
-		Mux:            m,
-		DefaultWorkdir: "/workspace",
-		DefaultShell:   shell,
-		Env:            os.Environ(),
+		Mux:               m,
+		DefaultWorkdir:    "/workspace",
+		DefaultShell:      shell,
+		Env:               os.Environ(),
+		RecordingLocation: filepath.Join(os.TempDir(), "terminal-recordings"),
 	}
 }
 
@@ -53,6 +56,9 @@ type MuxTerminalService struct {
 	DefaultCreds       *syscall.Credential
 	DefaultAmbientCaps []uintptr
 
+	// RecordingLocation is the directory terminal recordings are stored in
+	RecordingLocation string
+
 	api.UnimplementedTerminalServiceServer
 }
 
@@ -66,6 +72,66 @@ func (srv *MuxTerminalService) RegisterREST(mux *runtime.ServeMux, grpcEndpoint
 	return api.RegisterTerminalServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
 }
 
+// RecordingsHTTPPath is the path terminal recordings are downloadable from.
+const RecordingsHTTPPath = "/_supervisor/v1/terminal/recordings/"
+
+// RegisterHTTP registers the terminal recording download handler.
+func (srv *MuxTerminalService) RegisterHTTP(mux *http.ServeMux) {
+	mux.Handle(RecordingsHTTPPath, http.StripPrefix(RecordingsHTTPPath, http.HandlerFunc(srv.serveRecording)))
+}
+
+// serveRecording serves a finished recording from the recording location for download.
+func (srv *MuxTerminalService) serveRecording(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	name := r.URL.Path
+	if name == "" || strings.Contains(name, "/") || !strings.HasSuffix(name, ".cast") {
+		http.NotFound(w, r)
+		return
+	}
+	fn := filepath.Join(srv.RecordingLocation, name)
+	if srv.isRecording(fn) {
+		http.Error(w, "recording is still in progress", http.StatusConflict)
+		return
+	}
+
+	f, err := os.Open(fn)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("path", fn).Warn("cannot open terminal recording")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil || !stat.Mode().IsRegular() {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-asciicast")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	http.ServeContent(w, r, name, stat.ModTime(), f)
+}
+
+// isRecording returns true if a terminal is still writing to the given recording.
+func (srv *MuxTerminalService) isRecording(fn string) bool {
+	srv.Mux.mu.RLock()
+	defer srv.Mux.mu.RUnlock()
+	for _, term := range srv.Mux.terms {
+		if rec := term.recording(); rec != nil && rec.Path == fn {
+			return true
+		}
+	}
+	return false
+}
+
 // Open opens a new terminal running the shell.
 func (srv *MuxTerminalService) Open(ctx context.Context, req *api.OpenTerminalRequest) (*api.OpenTerminalResponse, error) {
 	return srv.OpenWithOptions(ctx, req, TermOptions{
@@ -142,6 +208,9 @@ func (srv *MuxTerminalService) OpenWithOptions(ctx context.Context, req *api.Ope
 
 // Close closes a terminal for the given alias.
 func (srv *MuxTerminalService) Shutdown(ctx context.Context, req *api.ShutdownTerminalRequest) (*api.ShutdownTerminalResponse, error) {
+	if term, ok := srv.Mux.Get(req.Alias); ok {
+		term.markStoppedByUser()
+	}
 	err := srv.Mux.CloseTerminal(ctx, req.Alias, req.ForceSuccess)
 	if err == ErrNotFound {
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -233,6 +302,13 @@ func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.T
 	log.WithField("alias", req.Alias).Info("new terminal client")
 	defer log.WithField("alias", req.Alias).Info("terminal client left")
 
+	if len(term.history) > 0 {
+		err := resp.Send(&api.ListenTerminalResponse{Output: &api.ListenTerminalResponse_Data{Data: term.history}, Historical: true})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	errchan := make(chan error, 1)
 	messages := make(chan *api.ListenTerminalResponse, 1)
 	go func() {
@@ -338,6 +414,9 @@ func (srv *MuxTerminalService) SetSize(ctx context.Context, req *api.SetTerminal
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if rec := term.recording(); rec != nil {
+		rec.Resize(uint16(req.Size.Cols), uint16(req.Size.Rows))
+	}
 
 	return &api.SetTerminalSizeResponse{}, nil
 }
@@ -365,3 +444,44 @@ func (srv *MuxTerminalService) UpdateAnnotations(ctx context.Context, req *api.U
 	term.UpdateAnnotations(req.Changed, req.Deleted)
 	return &api.UpdateTerminalAnnotationsResponse{}, nil
 }
+
+// StartRecording starts recording a terminal in the asciicast v2 format.
+func (srv *MuxTerminalService) StartRecording(ctx context.Context, req *api.StartTerminalRecordingRequest) (*api.StartTerminalRecordingResponse, error) {
+	srv.Mux.mu.RLock()
+	term, ok := srv.Mux.terms[req.Alias]
+	srv.Mux.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "terminal not found")
+	}
+
+	fn := filepath.Join(srv.RecordingLocation, fmt.Sprintf("%s-%s.cast", req.Alias, time.Now().UTC().Format("20060102T150405Z")))
+	err := term.StartRecording(fn)
+	if err == ErrAlreadyRecording {
+		return nil, status.Error(codes.FailedPrecondition, "terminal is already being recorded")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	log.WithField("alias", req.Alias).WithField("path", fn).Info("started terminal recording")
+	return &api.StartTerminalRecordingResponse{Path: fn}, nil
+}
+
+// StopRecording stops recording a terminal.
+func (srv *MuxTerminalService) StopRecording(ctx context.Context, req *api.StopTerminalRecordingRequest) (*api.StopTerminalRecordingResponse, error) {
+	srv.Mux.mu.RLock()
+	term, ok := srv.Mux.terms[req.Alias]
+	srv.Mux.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "terminal not found")
+	}
+
+	fn, err := term.StopRecording()
+	if err == ErrNotRecording {
+		return nil, status.Error(codes.FailedPrecondition, "terminal is not being recorded")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	log.WithField("alias", req.Alias).WithField("path", fn).Info("stopped terminal recording")
+	return &api.StopTerminalRecordingResponse{Path: fn}, nil
+}
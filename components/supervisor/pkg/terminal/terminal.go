@@ -49,6 +49,10 @@ type Mux struct {
 	aliases []string
 	terms   map[string]*Term
 	mu      sync.RWMutex
+
+	// ScrollbackLocation is the directory the output of persistent terminals is spilled to,
+	// see TermOptions.PersistentID. If empty, no terminal output is persisted.
+	ScrollbackLocation string
 }
 
 // Get returns a terminal for the given alias.
@@ -80,6 +84,15 @@ func (m *Mux) Start(cmd *exec.Cmd, options TermOptions) (alias string, err error
 
 	log.WithField("alias", alias).WithField("cmd", cmd.Path).Info("started new terminal")
 
+	if m.ScrollbackLocation != "" && options.PersistentID != "" {
+		term.scrollbackFile = scrollbackFileName(m.ScrollbackLocation, options.PersistentID)
+		term.history, err = readScrollback(term.scrollbackFile)
+		if err != nil {
+			log.WithError(err).WithField("alias", alias).Warn("cannot restore terminal scrollback")
+		}
+		go term.spillScrollbackPeriodically()
+	}
+
 	go func() {
 		term.waitErr = cmd.Wait()
 		close(term.waitDone)
@@ -302,6 +315,10 @@ type TermOptions struct {
 
 	// LogToStdout forwards the terminal's stdout to supervisor's stdout
 	LogToStdout bool
+
+	// PersistentID identifies the terminal across workspace restarts. If set, and the mux has
+	// a ScrollbackLocation, the terminal output is persisted and replayed to listeners after a restart.
+	PersistentID string
 }
 
 // Term is a pseudo-terminal.
@@ -321,11 +338,19 @@ type Term struct {
 
 	// ForceSuccess overrides the process' exit code to 0
 	ForceSuccess bool
+	// stoppedByUser is true if the terminal was shut down through the terminal service
+	stoppedByUser bool
 
 	Stdout *multiWriter
 
 	waitErr  error
 	waitDone chan struct{}
+
+	// history is the output of the previous session of a persistent terminal
+	history        []byte
+	scrollbackFile string
+	spillMu        sync.Mutex
+	spilled        int64
 }
 
 func (term *Term) GetTitle() (string, api.TerminalTitleSource, error) {
@@ -393,6 +418,19 @@ func (term *Term) resolveForegroundCommand() (string, error) {
 	return string(content), nil
 }
 
+// StoppedByUser returns true if the terminal was shut down through the terminal service, e.g. using gp tasks stop.
+func (term *Term) StoppedByUser() bool {
+	term.mu.RLock()
+	defer term.mu.RUnlock()
+	return term.stoppedByUser
+}
+
+func (term *Term) markStoppedByUser() {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+	term.stoppedByUser = true
+}
+
 // Wait waits for the terminal to exit and returns the resulted process state.
 func (term *Term) Wait() (*os.ProcessState, error) {
 	<-term.waitDone
@@ -418,6 +456,10 @@ func (term *Term) Close(ctx context.Context) error {
 	}
 
 	writeErr := term.Stdout.Close()
+	term.spillScrollback()
+	if _, err := term.StopRecording(); err != nil && err != ErrNotRecording {
+		log.WithError(err).Warn("cannot finish terminal recording")
+	}
 
 	slaveErr := errors.New("Slave FD nil")
 	if term.pts != nil {
@@ -458,6 +500,8 @@ type multiWriter struct {
 	// ring buffer to record last 256kb of pty output
 	// new listener is initialized with the latest recodring first
 	recorder *RingBuffer
+	// cast records the output in the asciicast format while the terminal is being recorded
+	cast *asciicastRecorder
 
 	logStdout bool
 	logLabel  string
@@ -593,6 +637,9 @@ func (mw *multiWriter) Write(p []byte) (n int, err error) {
 	defer mw.mu.Unlock()
 
 	mw.recorder.Write(p)
+	if mw.cast != nil {
+		mw.cast.Output(p)
+	}
 	if mw.logStdout {
 		log.WithFields(logrus.Fields{
 			"terminalOutput": true,
@@ -636,6 +683,14 @@ func (mw *multiWriter) Close() error {
 	return err
 }
 
+// recording returns a copy of the recorded output and the total number of bytes written so far.
+func (mw *multiWriter) recording() ([]byte, int64) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	return bytes.Clone(mw.recorder.Bytes()), mw.recorder.TotalWritten()
+}
+
 func (mw *multiWriter) ListenerCount() int {
 	mw.mu.Lock()
 	defer mw.mu.Unlock()
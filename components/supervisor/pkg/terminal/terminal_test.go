@@ -7,9 +7,13 @@ package terminal
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -391,3 +395,212 @@ func TestWorkDirProvider(t *testing.T) {
 		expectedWorkDir: providedWorkDir,
 	})
 }
+
+func TestScrollback(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	location := t.TempDir()
+	start := func(mux *Mux, id string, cmd *exec.Cmd) *Term {
+		alias, err := mux.Start(cmd, TermOptions{ReadTimeout: 0, PersistentID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		term, ok := mux.Get(alias)
+		if !ok {
+			t.Fatal("terminal is not found")
+		}
+		return term
+	}
+
+	mux := NewMux()
+	mux.ScrollbackLocation = location
+	term := start(mux, "0", exec.Command("/bin/sh", "-c", "echo previous session; sleep 1"))
+	if len(term.history) != 0 {
+		t.Fatalf("unexpected history: %q", term.history)
+	}
+	_, _ = io.Copy(io.Discard, term.Stdout.Listen())
+	mux.Close(ctx)
+
+	mux = NewMux()
+	mux.ScrollbackLocation = location
+	defer mux.Close(ctx)
+
+	term = start(mux, "0", exec.Command("/bin/sh", "-c", "sleep 1"))
+	if !strings.Contains(string(term.history), "previous session") {
+		t.Errorf("expected history to contain the previous session, got %q", term.history)
+	}
+
+	term = start(mux, "1", exec.Command("/bin/sh", "-c", "sleep 1"))
+	if len(term.history) != 0 {
+		t.Errorf("unexpected history of another terminal: %q", term.history)
+	}
+}
+
+func TestScrollbackFile(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "nested", "scrollback-0.gz")
+
+	content, err := readScrollback(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content) != 0 {
+		t.Errorf("unexpected content of missing scrollback: %q", content)
+	}
+
+	for _, expectation := range []string{"first\r\n", "second\r\n"} {
+		err = writeScrollback(fn, []byte(expectation))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err = readScrollback(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expectation, string(content)); diff != "" {
+			t.Errorf("unexpected scrollback (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestAsciicastRecorder(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "recordings", "test.cast")
+	rec, err := newAsciicastRecorder(fn, asciicastHeader{Width: 80, Height: 24, Title: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "é" is split across two writes
+	rec.Output([]byte("h\xc3"))
+	rec.Output([]byte("\xa9llo\r\n"))
+	rec.Resize(120, 40)
+	rec.Marker("title: test")
+	rec.Output([]byte("\xe2\x82"))
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Output([]byte("after close"))
+
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+
+	var header asciicastHeader
+	err = json.Unmarshal([]byte(lines[0]), &header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Timestamp = 0
+	if diff := cmp.Diff(asciicastHeader{Version: 2, Width: 80, Height: 24, Title: "test"}, header); diff != "" {
+		t.Errorf("unexpected header (-want +got):\n%s", diff)
+	}
+
+	var events [][]string
+	for _, line := range lines[1:] {
+		var event []interface{}
+		err = json.Unmarshal([]byte(line), &event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := event[0].(float64); !ok {
+			t.Errorf("event time is not a number: %v", event[0])
+		}
+		events = append(events, []string{event[1].(string), event[2].(string)})
+	}
+	expectation := [][]string{
+		{"o", "h"},
+		{"o", "éllo\r\n"},
+		{"r", "120x40"},
+		{"m", "title: test"},
+		// incomplete runes are flushed on close
+		{"o", "\ufffd\ufffd"},
+	}
+	if diff := cmp.Diff(expectation, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecording(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mux := NewMux()
+	defer mux.Close(ctx)
+	alias, err := mux.Start(exec.Command("/bin/sh"), TermOptions{ReadTimeout: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	term, ok := mux.Get(alias)
+	if !ok {
+		t.Fatal("terminal is not found")
+	}
+
+	fn := filepath.Join(t.TempDir(), alias+".cast")
+	err = term.StartRecording(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := term.StartRecording(fn); err != ErrAlreadyRecording {
+		t.Errorf("expected %v when starting a second recording, got %v", ErrAlreadyRecording, err)
+	}
+
+	stdout := term.Stdout.Listen()
+	_, err = term.PTY.Write([]byte("echo recorded; exit\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = io.Copy(io.Discard, stdout)
+	_, _ = term.Wait()
+
+	// closing the terminal finishes the recording
+	mux.Close(ctx)
+	if _, err := term.StopRecording(); err != ErrNotRecording {
+		t.Errorf("expected %v after the terminal was closed, got %v", ErrNotRecording, err)
+	}
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "recorded") {
+		t.Errorf("expected recording to contain the terminal output, got %q", content)
+	}
+}
+
+func TestServeRecording(t *testing.T) {
+	srv := NewMuxTerminalService(NewMux())
+	srv.RecordingLocation = t.TempDir()
+	err := os.WriteFile(filepath.Join(srv.RecordingLocation, "1-20260101T000000Z.cast"), []byte("recording"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes := http.NewServeMux()
+	srv.RegisterHTTP(routes)
+
+	tests := []struct {
+		Name         string
+		Method       string
+		Path         string
+		ExpectedCode int
+		ExpectedBody string
+	}{
+		{Name: "download", Method: http.MethodGet, Path: "1-20260101T000000Z.cast", ExpectedCode: http.StatusOK, ExpectedBody: "recording"},
+		{Name: "not found", Method: http.MethodGet, Path: "2-20260101T000000Z.cast", ExpectedCode: http.StatusNotFound},
+		{Name: "not a recording", Method: http.MethodGet, Path: "scrollback", ExpectedCode: http.StatusNotFound},
+		{Name: "outside of the recording location", Method: http.MethodGet, Path: "..%2F1-20260101T000000Z.cast", ExpectedCode: http.StatusNotFound},
+		{Name: "wrong method", Method: http.MethodPost, Path: "1-20260101T000000Z.cast", ExpectedCode: http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			routes.ServeHTTP(rec, httptest.NewRequest(test.Method, RecordingsHTTPPath+test.Path, nil))
+			if rec.Code != test.ExpectedCode {
+				t.Fatalf("unexpected status code: want %d, got %d", test.ExpectedCode, rec.Code)
+			}
+			if test.ExpectedBody != "" && rec.Body.String() != test.ExpectedBody {
+				t.Errorf("unexpected body: want %q, got %q", test.ExpectedBody, rec.Body.String())
+			}
+		})
+	}
+}
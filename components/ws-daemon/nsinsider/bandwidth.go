@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package main
+
+import (
+	"math"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"golang.org/x/xerrors"
+)
+
+const (
+	bandwidthTable = "gitpod-bandwidth"
+	// bandwidthIngressStats and bandwidthEgressStats count the traffic which exceeded the limits
+	bandwidthIngressStats = "ws-ingress-throttled-stats"
+	bandwidthEgressStats  = "ws-egress-throttled-stats"
+
+	// minBandwidthBurst is the smallest burst we allow, so that a single GSO packet never exceeds the limit
+	minBandwidthBurst = 64 * 1024
+)
+
+// bandwidthLimit is the chain limiting the traffic of one direction
+type bandwidthLimit struct {
+	Chain   string
+	Hook    *nftables.ChainHook
+	Counter string
+	Exprs   []expr.Any
+}
+
+// setupBandwidthLimit limits the bandwidth of the pod end of the workspace veth pair in bytes per second.
+// Traffic which exceeds a limit is counted and dropped, which TCP connections answer by slowing down.
+// A limit of zero removes the respective limit.
+func setupBandwidthLimit(vethIf string, ingress, egress uint64) error {
+	nftcon := nftables.Conn{}
+
+	// nft add table inet gitpod-bandwidth
+	table := nftcon.AddTable(&nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   bandwidthTable,
+	})
+	nftcon.FlushTable(table)
+
+	for _, l := range bandwidthLimits(vethIf, ingress, egress) {
+		// nft add chain inet gitpod-bandwidth $chain { type filter hook $hook priority 0 \; }
+		chain := nftcon.AddChain(&nftables.Chain{
+			Table:    table,
+			Name:     l.Chain,
+			Type:     nftables.ChainTypeFilter,
+			Hooknum:  l.Hook,
+			Priority: nftables.ChainPriorityFilter,
+		})
+
+		// nft add counter inet gitpod-bandwidth $counter
+		nftcon.AddObject(&nftables.CounterObj{
+			Table: table,
+			Name:  l.Counter,
+		})
+
+		nftcon.AddRule(&nftables.Rule{
+			Table: table,
+			Chain: chain,
+			Exprs: l.Exprs,
+		})
+	}
+
+	if err := nftcon.Flush(); err != nil {
+		return xerrors.Errorf("failed to apply bandwidth limit: %v", err)
+	}
+	return nil
+}
+
+// bandwidthLimits renders the limits of both directions. Traffic the workspace receives leaves the pod network
+// namespace through the veth, traffic it sends enters the namespace through it.
+func bandwidthLimits(vethIf string, ingress, egress uint64) []bandwidthLimit {
+	var res []bandwidthLimit
+	if ingress > 0 {
+		res = append(res, bandwidthLimit{
+			Chain:   "ingress",
+			Hook:    nftables.ChainHookPostrouting,
+			Counter: bandwidthIngressStats,
+			Exprs:   limitExprs(expr.MetaKeyOIFNAME, vethIf, ingress, bandwidthIngressStats),
+		})
+	}
+	if egress > 0 {
+		res = append(res, bandwidthLimit{
+			Chain:   "egress",
+			Hook:    nftables.ChainHookPrerouting,
+			Counter: bandwidthEgressStats,
+			Exprs:   limitExprs(expr.MetaKeyIIFNAME, vethIf, egress, bandwidthEgressStats),
+		})
+	}
+	return res
+}
+
+// limitExprs drops the traffic through the interface which exceeds rate bytes per second
+func limitExprs(key expr.MetaKey, ifname string, rate uint64, counter string) []expr.Any {
+	return []expr.Any{
+		// oifname "veth0" or iifname "veth0"
+		&expr.Meta{Key: key, Register: 1},
+		&expr.Cmp{
+			Op:       expr.CmpOpEq,
+			Register: 1,
+			Data:     []byte(ifname + "\x00"),
+		},
+		// limit rate over $rate bytes/second burst $burst bytes
+		&expr.Limit{
+			Type:  expr.LimitTypePktBytes,
+			Rate:  rate,
+			Unit:  expr.LimitTimeSecond,
+			Burst: bandwidthBurst(rate),
+			Over:  true,
+		},
+		// counter name $counter
+		&expr.Objref{
+			Type: 1,
+			Name: counter,
+		},
+		// drop
+		&expr.Verdict{Kind: expr.VerdictDrop},
+	}
+}
+
+// bandwidthBurst returns the number of bytes which may be sent at once, which is the traffic of 100ms
+func bandwidthBurst(rate uint64) uint32 {
+	return uint32(max(min(rate/10, math.MaxUint32), minBandwidthBurst))
+}
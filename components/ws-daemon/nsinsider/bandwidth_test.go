@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+)
+
+func TestBandwidthLimits(t *testing.T) {
+	limit := func(key expr.MetaKey, rate uint64, burst uint32, counter string) []expr.Any {
+		return []expr.Any{
+			&expr.Meta{Key: key, Register: 1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte("veth0\x00")},
+			&expr.Limit{Type: expr.LimitTypePktBytes, Rate: rate, Unit: expr.LimitTimeSecond, Burst: burst, Over: true},
+			&expr.Objref{Type: 1, Name: counter},
+			&expr.Verdict{Kind: expr.VerdictDrop},
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		Ingress     uint64
+		Egress      uint64
+		Expectation []bandwidthLimit
+	}{
+		{
+			Name: "unlimited",
+		},
+		{
+			Name:    "both directions",
+			Ingress: 50 * 1024 * 1024,
+			Egress:  10 * 1024 * 1024,
+			Expectation: []bandwidthLimit{
+				{Chain: "ingress", Hook: nftables.ChainHookPostrouting, Counter: bandwidthIngressStats, Exprs: limit(expr.MetaKeyOIFNAME, 50*1024*1024, 5*1024*1024, bandwidthIngressStats)},
+				{Chain: "egress", Hook: nftables.ChainHookPrerouting, Counter: bandwidthEgressStats, Exprs: limit(expr.MetaKeyIIFNAME, 10*1024*1024, 1024*1024, bandwidthEgressStats)},
+			},
+		},
+		{
+			Name:   "small limit",
+			Egress: 1024,
+			Expectation: []bandwidthLimit{
+				{Chain: "egress", Hook: nftables.ChainHookPrerouting, Counter: bandwidthEgressStats, Exprs: limit(expr.MetaKeyIIFNAME, 1024, minBandwidthBurst, bandwidthEgressStats)},
+			},
+		},
+		{
+			Name:    "limit beyond 4 GiB/s",
+			Ingress: 100 * 1024 * 1024 * 1024,
+			Expectation: []bandwidthLimit{
+				{Chain: "ingress", Hook: nftables.ChainHookPostrouting, Counter: bandwidthIngressStats, Exprs: limit(expr.MetaKeyOIFNAME, 100*1024*1024*1024, math.MaxUint32, bandwidthIngressStats)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := bandwidthLimits("veth0", test.Ingress, test.Egress)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected limits (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package main
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+)
+
+const (
+	egressTable     = "gitpod-egress"
+	egressDropStats = "ws-egress-drop-stats"
+	// egressInterface is the interface connections leave the workspace pod through
+	egressInterface = "eth0"
+)
+
+// setupEgressPolicy installs a chain which applies the first matching rule to new connections leaving the pod.
+// Rules are formatted as action,protocol,cidr,ports where empty fields match everything.
+func setupEgressPolicy(defaultAction string, rules []string, enforce bool) error {
+	nftcon := nftables.Conn{}
+
+	// nft add table inet gitpod-egress
+	table := nftcon.AddTable(&nftables.Table{
+		Family: nftables.TableFamilyINet,
+		Name:   egressTable,
+	})
+	nftcon.FlushTable(table)
+
+	// nft add chain inet gitpod-egress egress { type filter hook postrouting priority 0 \; }
+	chain := nftcon.AddChain(&nftables.Chain{
+		Table:    table,
+		Name:     "egress",
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookPostrouting,
+		Priority: nftables.ChainPriorityFilter,
+	})
+
+	// nft add counter inet gitpod-egress ws-egress-drop-stats
+	nftcon.AddObject(&nftables.CounterObj{
+		Table: table,
+		Name:  egressDropStats,
+	})
+
+	// oifname != "eth0" accept
+	nftcon.AddRule(&nftables.Rule{
+		Table: table,
+		Chain: chain,
+		Exprs: []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+			&expr.Cmp{
+				Op:       expr.CmpOpNeq,
+				Register: 1,
+				Data:     []byte(egressInterface + "\x00"),
+			},
+			&expr.Verdict{Kind: expr.VerdictAccept},
+		},
+	})
+
+	// ct state != new accept
+	// the policy only applies to connections the workspace opens, not to replies
+	nftcon.AddRule(&nftables.Rule{
+		Table: table,
+		Chain: chain,
+		Exprs: []expr.Any{
+			&expr.Ct{Key: expr.CtKeySTATE, Register: 1},
+			&expr.Bitwise{
+				DestRegister:   1,
+				SourceRegister: 1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitNEW),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{0, 0, 0, 0},
+			},
+			&expr.Verdict{Kind: expr.VerdictAccept},
+		},
+	})
+
+	deny := []expr.Any{
+		// counter name "ws-egress-drop-stats"
+		&expr.Objref{
+			Type: 1,
+			Name: egressDropStats,
+		},
+		&expr.Verdict{Kind: expr.VerdictDrop},
+	}
+	if !enforce {
+		deny[1] = &expr.Verdict{Kind: expr.VerdictAccept}
+	}
+
+	for _, r := range rules {
+		exprs, action, err := egressRuleExprs(r)
+		if err != nil {
+			return xerrors.Errorf("invalid egress rule %q: %w", r, err)
+		}
+		switch action {
+		case "allow":
+			exprs = append(exprs, &expr.Verdict{Kind: expr.VerdictAccept})
+		case "deny":
+			exprs = append(exprs, deny...)
+		default:
+			return xerrors.Errorf("invalid egress rule %q: unknown action %s", r, action)
+		}
+		nftcon.AddRule(&nftables.Rule{
+			Table: table,
+			Chain: chain,
+			Exprs: exprs,
+		})
+	}
+
+	switch defaultAction {
+	case "allow":
+	case "deny":
+		nftcon.AddRule(&nftables.Rule{
+			Table: table,
+			Chain: chain,
+			Exprs: deny,
+		})
+	default:
+		return xerrors.Errorf("unknown default action %s", defaultAction)
+	}
+
+	if err := nftcon.Flush(); err != nil {
+		return xerrors.Errorf("failed to apply egress policy: %v", err)
+	}
+
+	return nil
+}
+
+// egressRuleExprs returns the match expressions of a rule formatted as action,protocol,cidr,ports
+func egressRuleExprs(rule string) (exprs []expr.Any, action string, err error) {
+	fields := strings.Split(rule, ",")
+	if len(fields) != 4 {
+		return nil, "", xerrors.Errorf("expected four fields")
+	}
+	action, proto, cidr, ports := fields[0], fields[1], fields[2], fields[3]
+
+	if cidr != "" {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, "", err
+		}
+		// meta nfproto ipv4 ip daddr & mask == net
+		// or meta nfproto ipv6 ip6 daddr & mask == net
+		var (
+			nfproto byte   = unix.NFPROTO_IPV6
+			offset  uint32 = 24
+			ip             = ipnet.IP.To16()
+		)
+		if ip4 := ipnet.IP.To4(); ip4 != nil {
+			nfproto, offset, ip = unix.NFPROTO_IPV4, 16, ip4
+		}
+		exprs = append(exprs,
+			&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{nfproto},
+			},
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseNetworkHeader,
+				Offset:       offset,
+				Len:          uint32(len(ip)),
+			},
+			&expr.Bitwise{
+				DestRegister:   1,
+				SourceRegister: 1,
+				Len:            uint32(len(ip)),
+				Mask:           ipnet.Mask,
+				Xor:            make([]byte, len(ip)),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     ip,
+			},
+		)
+	}
+
+	switch proto {
+	case "":
+	case "tcp", "udp":
+		l4proto := byte(unix.IPPROTO_TCP)
+		if proto == "udp" {
+			l4proto = unix.IPPROTO_UDP
+		}
+		// meta l4proto tcp
+		exprs = append(exprs,
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+			&expr.Cmp{
+				Op:       expr.CmpOpEq,
+				Register: 1,
+				Data:     []byte{l4proto},
+			},
+		)
+	default:
+		return nil, "", xerrors.Errorf("unsupported protocol %s", proto)
+	}
+
+	if ports != "" {
+		if proto == "" {
+			return nil, "", xerrors.Errorf("ports require a protocol")
+		}
+		from, to, ok := strings.Cut(ports, "-")
+		if !ok {
+			to = from
+		}
+		f, err := strconv.ParseUint(from, 10, 16)
+		if err != nil {
+			return nil, "", err
+		}
+		t, err := strconv.ParseUint(to, 10, 16)
+		if err != nil {
+			return nil, "", err
+		}
+		// th dport from-to
+		exprs = append(exprs,
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseTransportHeader,
+				Offset:       2,
+				Len:          2,
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpGte,
+				Register: 1,
+				Data:     binary.BigEndian.AppendUint16(nil, uint16(f)),
+			},
+			&expr.Cmp{
+				Op:       expr.CmpOpLte,
+				Register: 1,
+				Data:     binary.BigEndian.AppendUint16(nil, uint16(t)),
+			},
+		)
+	}
+
+	return exprs, action, nil
+}
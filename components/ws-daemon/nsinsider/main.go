@@ -610,6 +610,45 @@ func main() {
 					return nil
 				},
 			},
+			{
+				Name:  "setup-bandwidth-limit",
+				Usage: "limit the bandwidth of the workspace veth",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{
+						Name:  "ingress",
+						Usage: "bytes per second the workspace may receive, 0 for unlimited",
+					},
+					&cli.Uint64Flag{
+						Name:  "egress",
+						Usage: "bytes per second the workspace may send, 0 for unlimited",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return setupBandwidthLimit("veth0", c.Uint64("ingress"), c.Uint64("egress"))
+				},
+			},
+			{
+				Name:  "setup-egress-policy",
+				Usage: "set up the egress network policy",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "default",
+						Usage:    "action for connections which match no rule, allow or deny",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:  "rule",
+						Usage: "rule as action,protocol,cidr,ports - empty fields match everything",
+					},
+					&cli.BoolFlag{
+						Name:     "enforce",
+						Required: false,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return setupEgressPolicy(c.String("default"), c.StringSlice("rule"), c.Bool("enforce"))
+				},
+			},
 		},
 	}
 
@@ -0,0 +1,247 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	cgroupsv2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// ResourcePressureConfig configures when a workspace is considered to be under resource pressure.
+// Thresholds are the share of time in percent during the last ten seconds in which some workspace
+// processes stalled on the resource. A threshold of zero disables the check for that resource.
+type ResourcePressureConfig struct {
+	Enabled bool    `json:"enabled"`
+	Memory  float64 `json:"memory"`
+	IO      float64 `json:"io"`
+	// NotifyUser lets supervisor warn the user while the workspace is under pressure
+	NotifyUser bool `json:"notifyUser"`
+}
+
+// Evaluate returns the reason the workspace is under pressure. Memory pressure takes precedence,
+// as it is what eventually gets workspace processes OOM killed.
+func (c ResourcePressureConfig) Evaluate(memory, io cgroups.PSI) (reason string, underPressure bool) {
+	if c.Memory > 0 && memory.SomeAvg10 >= c.Memory {
+		return workspacev1.ReasonMemoryPressure, true
+	}
+	if c.IO > 0 && io.SomeAvg10 >= c.IO {
+		return workspacev1.ReasonIOPressure, true
+	}
+	return workspacev1.ReasonNoPressure, false
+}
+
+// ResourcePressureV2 evaluates the memory and IO pressure of workspaces and reports it
+// using the ResourcePressure condition on the workspace resource.
+type ResourcePressureV2 struct {
+	Client    client.Client
+	Namespace string
+
+	mu  sync.RWMutex
+	cfg ResourcePressureConfig
+
+	transitions *prometheus.CounterVec
+}
+
+func NewResourcePressureV2(cfg ResourcePressureConfig, c client.Client, namespace string) *ResourcePressureV2 {
+	return &ResourcePressureV2{
+		Client:    c,
+		Namespace: namespace,
+		cfg:       cfg,
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "workspace_resource_pressure_total",
+			Help: "Number of times workspaces came under resource pressure",
+		}, []string{"reason"}),
+	}
+}
+
+func (p *ResourcePressureV2) Name() string  { return "resource-pressure-v2" }
+func (p *ResourcePressureV2) Type() Version { return Version2 }
+
+func (p *ResourcePressureV2) Describe(c chan<- *prometheus.Desc) {
+	p.transitions.Describe(c)
+}
+
+func (p *ResourcePressureV2) Collect(c chan<- prometheus.Metric) {
+	p.transitions.Collect(c)
+}
+
+func (p *ResourcePressureV2) Update(cfg ResourcePressureConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.cfg = cfg
+	log.WithField("config", cfg).Info("updating resource pressure config")
+}
+
+// Config returns the currently active resource pressure configuration
+func (p *ResourcePressureV2) Config() ResourcePressureConfig {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.cfg
+}
+
+func (p *ResourcePressureV2) Apply(ctx context.Context, opts *PluginOptions) error {
+	fullPath := filepath.Join(opts.BasePath, opts.CgroupPath)
+	if _, err := os.Stat(fullPath); err != nil {
+		return err
+	}
+
+	memory := cgroupsv2.NewMemoryController(fullPath)
+	io := cgroupsv2.NewIOController(fullPath)
+
+	dispatch.GetDispatchWaitGroup(ctx).Add(1)
+	go func() {
+		defer dispatch.GetDispatchWaitGroup(ctx).Done()
+
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+
+		var state pressureState
+		for {
+			select {
+			case <-ticker.C:
+				state = p.evaluate(ctx, memory, io, opts.InstanceId, state)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// pressureState is the pressure last reported for a workspace
+type pressureState struct {
+	// synced is true once the workspace status reflects the pressure
+	synced   bool
+	pressure *workspacev1.ResourcePressure
+}
+
+func (p *ResourcePressureV2) evaluate(ctx context.Context, memory *cgroupsv2.Memory, io *cgroupsv2.IO, instanceID string, state pressureState) pressureState {
+	cfg := p.Config()
+	if !cfg.Enabled {
+		return state
+	}
+
+	memoryPSI, err := memory.PSI()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).WithFields(log.OWI("", "", instanceID)).Warn("could not retrieve memory psi")
+		}
+		return state
+	}
+	ioPSI, err := io.PSI()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).WithFields(log.OWI("", "", instanceID)).Warn("could not retrieve io psi")
+		}
+		return state
+	}
+
+	reason, underPressure := cfg.Evaluate(memoryPSI, ioPSI)
+	var pressure *workspacev1.ResourcePressure
+	if underPressure {
+		pressure = &workspacev1.ResourcePressure{
+			Memory: int32(math.Round(memoryPSI.SomeAvg10)),
+			IO:     int32(math.Round(ioPSI.SomeAvg10)),
+		}
+	}
+	if state.synced && similarPressure(state.pressure, pressure) {
+		return state
+	}
+
+	status := metav1.ConditionFalse
+	if underPressure {
+		status = metav1.ConditionTrue
+	}
+	message := fmt.Sprintf("memory pressure %.0f%%, io pressure %.0f%%", memoryPSI.SomeAvg10, ioPSI.SomeAvg10)
+	cond := workspacev1.NewWorkspaceConditionResourcePressure(status, reason, message)
+
+	transitioned, err := p.updateStatus(ctx, instanceID, cond, pressure)
+	if apierrors.IsNotFound(err) {
+		// the workspace is gone already
+		return pressureState{synced: true, pressure: pressure}
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			log.WithError(err).WithFields(log.OWI("", "", instanceID)).Warn("could not update resource pressure condition")
+		}
+		return state
+	}
+	if transitioned && underPressure {
+		log.WithFields(log.OWI("", "", instanceID)).WithField("reason", reason).WithField("pressure", pressure).Info("workspace is under resource pressure")
+		p.transitions.WithLabelValues(reason).Inc()
+	}
+
+	return pressureState{synced: true, pressure: pressure}
+}
+
+// updateStatus sets the ResourcePressure condition on the workspace and returns whether its status changed
+func (p *ResourcePressureV2) updateStatus(ctx context.Context, instanceID string, cond metav1.Condition, pressure *workspacev1.ResourcePressure) (transitioned bool, err error) {
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var ws workspacev1.Workspace
+		err := p.Client.Get(ctx, types.NamespacedName{Namespace: p.Namespace, Name: instanceID}, &ws)
+		if err != nil {
+			return err
+		}
+
+		current := wsk8s.GetCondition(ws.Status.Conditions, cond.Type)
+		if current == nil && cond.Status == metav1.ConditionFalse {
+			// the workspace has never been under pressure
+			transitioned = false
+			return nil
+		}
+		if current != nil && current.Status == cond.Status {
+			// keep the time the workspace came under pressure, ws-manager bases evictions on it
+			cond.LastTransitionTime = current.LastTransitionTime
+			if cond.Status == metav1.ConditionFalse {
+				transitioned = false
+				return nil
+			}
+		}
+
+		transitioned = current == nil || current.Status != cond.Status
+		ws.Status.SetCondition(cond)
+		ws.Status.ResourcePressure = pressure
+		return p.Client.Status().Update(ctx, &ws)
+	})
+	return transitioned, err
+}
+
+// similarPressure returns true if both pressures are close enough to not warrant a status update
+func similarPressure(a, b *workspacev1.ResourcePressure) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	const tolerance = 5
+	return abs(a.Memory-b.Memory) < tolerance && abs(a.IO-b.IO) < tolerance
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cgroupsv2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	testNamespace  = "default"
+	testInstanceID = "ws-1"
+)
+
+func TestResourcePressureEvaluate(t *testing.T) {
+	cfg := ResourcePressureConfig{Enabled: true, Memory: 50, IO: 50}
+	pressured := pressureCondition(metav1.ConditionTrue, workspacev1.ReasonMemoryPressure)
+
+	type Expectation struct {
+		Condition *metav1.ConditionStatus
+		Reason    string
+		Pressure  *workspacev1.ResourcePressure
+		State     pressureState
+	}
+	tests := []struct {
+		Name        string
+		Config      ResourcePressureConfig
+		Memory, IO  float64
+		Workspace   *workspacev1.Workspace
+		State       pressureState
+		Expectation Expectation
+	}{
+		{
+			Name:      "disabled",
+			Config:    ResourcePressureConfig{Memory: 50, IO: 50},
+			Memory:    80,
+			Workspace: pressureWorkspace(nil, nil),
+		},
+		{
+			Name:      "memory pressure",
+			Config:    cfg,
+			Memory:    80,
+			IO:        60,
+			Workspace: pressureWorkspace(nil, nil),
+			Expectation: Expectation{
+				Condition: conditionStatus(metav1.ConditionTrue),
+				Reason:    workspacev1.ReasonMemoryPressure,
+				Pressure:  &workspacev1.ResourcePressure{Memory: 80, IO: 60},
+				State:     pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 80, IO: 60}},
+			},
+		},
+		{
+			Name:      "io pressure",
+			Config:    cfg,
+			Memory:    10,
+			IO:        60,
+			Workspace: pressureWorkspace(nil, nil),
+			Expectation: Expectation{
+				Condition: conditionStatus(metav1.ConditionTrue),
+				Reason:    workspacev1.ReasonIOPressure,
+				Pressure:  &workspacev1.ResourcePressure{Memory: 10, IO: 60},
+				State:     pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 10, IO: 60}},
+			},
+		},
+		{
+			Name:      "similar pressure is not reported again",
+			Config:    cfg,
+			Memory:    82,
+			Workspace: pressureWorkspace(&pressured, &workspacev1.ResourcePressure{Memory: 80}),
+			State:     pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 80}},
+			Expectation: Expectation{
+				Condition: conditionStatus(metav1.ConditionTrue),
+				Reason:    workspacev1.ReasonMemoryPressure,
+				Pressure:  &workspacev1.ResourcePressure{Memory: 80},
+				State:     pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 80}},
+			},
+		},
+		{
+			Name:      "pressure gone",
+			Config:    cfg,
+			Memory:    10,
+			Workspace: pressureWorkspace(&pressured, &workspacev1.ResourcePressure{Memory: 80}),
+			State:     pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 80}},
+			Expectation: Expectation{
+				Condition: conditionStatus(metav1.ConditionFalse),
+				Reason:    workspacev1.ReasonNoPressure,
+				State:     pressureState{synced: true},
+			},
+		},
+		{
+			Name:      "never under pressure",
+			Config:    cfg,
+			Memory:    10,
+			Workspace: pressureWorkspace(nil, nil),
+			Expectation: Expectation{
+				State: pressureState{synced: true},
+			},
+		},
+		{
+			Name:   "workspace is gone",
+			Config: cfg,
+			Memory: 80,
+			Expectation: Expectation{
+				State: pressureState{synced: true, pressure: &workspacev1.ResourcePressure{Memory: 80}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			writePSI(t, filepath.Join(dir, "memory.pressure"), test.Memory)
+			writePSI(t, filepath.Join(dir, "io.pressure"), test.IO)
+
+			p := newTestResourcePressure(t, test.Config, test.Workspace)
+			state := p.evaluate(context.Background(), cgroupsv2.NewMemoryController(dir), cgroupsv2.NewIOController(dir), testInstanceID, test.State)
+			if diff := cmp.Diff(test.Expectation.State, state, cmp.AllowUnexported(pressureState{})); diff != "" {
+				t.Errorf("unexpected state (-want +got):\n%s", diff)
+			}
+			if test.Workspace == nil {
+				return
+			}
+
+			var ws workspacev1.Workspace
+			err := p.Client.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: testInstanceID}, &ws)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cond := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionResourcePressure))
+			switch {
+			case test.Expectation.Condition == nil && cond != nil:
+				t.Errorf("unexpected condition: %v", cond)
+			case test.Expectation.Condition != nil && cond == nil:
+				t.Errorf("expected a %s condition", *test.Expectation.Condition)
+			case cond != nil && (cond.Status != *test.Expectation.Condition || cond.Reason != test.Expectation.Reason):
+				t.Errorf("unexpected condition: want %s/%s, got %s/%s", *test.Expectation.Condition, test.Expectation.Reason, cond.Status, cond.Reason)
+			}
+			if diff := cmp.Diff(test.Expectation.Pressure, ws.Status.ResourcePressure); diff != "" {
+				t.Errorf("unexpected resource pressure (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePressureUpdateStatus(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	pressured := pressureCondition(metav1.ConditionTrue, workspacev1.ReasonMemoryPressure)
+	pressured.LastTransitionTime = since
+
+	tests := []struct {
+		Name             string
+		Current          *metav1.Condition
+		Condition        metav1.Condition
+		Transitioned     bool
+		TransitionedTime *metav1.Time
+	}{
+		{
+			Name:         "comes under pressure",
+			Condition:    pressureCondition(metav1.ConditionTrue, workspacev1.ReasonMemoryPressure),
+			Transitioned: true,
+		},
+		{
+			Name:             "stays under pressure",
+			Current:          &pressured,
+			Condition:        pressureCondition(metav1.ConditionTrue, workspacev1.ReasonIOPressure),
+			TransitionedTime: &since,
+		},
+		{
+			Name:         "pressure gone",
+			Current:      &pressured,
+			Condition:    pressureCondition(metav1.ConditionFalse, workspacev1.ReasonNoPressure),
+			Transitioned: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			p := newTestResourcePressure(t, ResourcePressureConfig{}, pressureWorkspace(test.Current, nil))
+
+			transitioned, err := p.updateStatus(context.Background(), testInstanceID, test.Condition, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if transitioned != test.Transitioned {
+				t.Errorf("unexpected transition: want %v, got %v", test.Transitioned, transitioned)
+			}
+
+			var ws workspacev1.Workspace
+			err = p.Client.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: testInstanceID}, &ws)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cond := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionResourcePressure))
+			if cond == nil {
+				t.Fatal("expected a resource pressure condition")
+			}
+			if cond.Reason != test.Condition.Reason {
+				t.Errorf("unexpected reason: want %s, got %s", test.Condition.Reason, cond.Reason)
+			}
+			if test.TransitionedTime != nil && !cond.LastTransitionTime.Equal(test.TransitionedTime) {
+				t.Errorf("unexpected last transition time: want %s, got %s", test.TransitionedTime, cond.LastTransitionTime)
+			}
+		})
+	}
+}
+
+func newTestResourcePressure(t *testing.T, cfg ResourcePressureConfig, ws *workspacev1.Workspace) *ResourcePressureV2 {
+	scheme := runtime.NewScheme()
+	err := workspacev1.AddToScheme(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var objs []client.Object
+	if ws != nil {
+		objs = append(objs, ws)
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&workspacev1.Workspace{}).
+		Build()
+	return NewResourcePressureV2(cfg, c, testNamespace)
+}
+
+func pressureWorkspace(cond *metav1.Condition, pressure *workspacev1.ResourcePressure) *workspacev1.Workspace {
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceID, Namespace: testNamespace},
+		Status:     workspacev1.WorkspaceStatus{ResourcePressure: pressure},
+	}
+	if cond != nil {
+		ws.Status.SetCondition(*cond)
+	}
+	return ws
+}
+
+func pressureCondition(status metav1.ConditionStatus, reason string) metav1.Condition {
+	return workspacev1.NewWorkspaceConditionResourcePressure(status, reason, "")
+}
+
+func conditionStatus(status metav1.ConditionStatus) *metav1.ConditionStatus {
+	return &status
+}
+
+// writePSI writes a PSI file as found in cgroup v2, with the given share of time stalled during the last ten seconds
+func writePSI(t *testing.T, fn string, avg10 float64) {
+	content := fmt.Sprintf("some avg10=%.2f avg60=0.00 avg300=0.00 total=1000\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=500\n", avg10)
+	err := os.WriteFile(fn, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
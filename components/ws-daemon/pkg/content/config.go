@@ -52,6 +52,15 @@ type BackupConfig struct {
 
 	// Period is the time between regular workspace backups
 	Period util.Duration `json:"period"`
+
+	// Chunked uploads backups as content-addressed chunks. Chunks which did not change
+	// since the previous backup are not uploaded again.
+	Chunked bool `json:"chunked,omitempty"`
+
+	// MaxSnapshotChainLength enables incremental prebuild snapshots which only contain the changes since the
+	// snapshot the prebuild was initialized from. Once a chain of incremental snapshots reaches this length, a
+	// full snapshot is taken again. Values below two disable incremental snapshots.
+	MaxSnapshotChainLength int `json:"maxSnapshotChainLength,omitempty"`
 }
 
 type UserNamespacesConfig struct {
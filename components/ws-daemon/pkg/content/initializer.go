@@ -10,11 +10,13 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opentracing/opentracing-go"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/xerrors"
 	"google.golang.org/protobuf/proto"
 
@@ -29,7 +32,9 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunks"
 	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/snapshot"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/libcontainer/specconv"
 )
@@ -77,6 +82,22 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		rc[storage.DefaultBackup] = *backup
 	}
 
+	manifest, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(storage.DefaultBackupChunkManifest), &storage.SignedURLOptions{})
+	if err == storage.ErrNotFound {
+		// no chunked backup found - that's fine
+	} else if err != nil {
+		return nil, err
+	} else if backup != nil && !chunks.Supersedes(manifest.Meta, backup.Meta) {
+		// the regular backup is newer than the chunked one, which was left behind when the workspace
+		// stopped using chunked backups. Leaving out the manifest makes the restore use the regular backup.
+		log.WithField("owner", workspaceOwner).Warn("ignoring chunked backup which is older than the regular backup")
+	} else {
+		err = collectBackupChunks(ctx, rs, ps, workspaceOwner, *manifest, rc)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot collect backup chunks: %w", err)
+		}
+	}
+
 	si := initializer.GetSnapshot()
 	pi := initializer.GetPrebuild()
 	if ci := initializer.GetComposite(); ci != nil {
@@ -103,6 +124,11 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		}
 
 		rc[si.Snapshot] = *info
+
+		err = collectSnapshotChain(ctx, ps, si.Snapshot, rc)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if pi != nil && pi.Prebuild != nil && pi.Prebuild.Snapshot != "" {
 		bkt, obj, err := storage.ParseSnapshotName(pi.Prebuild.Snapshot)
@@ -116,12 +142,94 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 			return nil, xerrors.Errorf("cannot find prebuild: %w", err)
 		} else {
 			rc[pi.Prebuild.Snapshot] = *info
+
+			err = collectSnapshotChain(ctx, ps, pi.Prebuild.Snapshot, rc)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	return rc, nil
 }
 
+// collectSnapshotChain adds the chain of an incremental snapshot and all snapshots in it to the remote content
+func collectSnapshotChain(ctx context.Context, ps storage.PresignedAccess, name string, rc map[string]storage.DownloadInfo) error {
+	chain, err := snapshot.SignChain(ctx, ps, http.DefaultClient, name)
+	if err != nil {
+		return xerrors.Errorf("cannot collect snapshot chain of %s: %w", name, err)
+	}
+	for n, info := range chain {
+		rc[n] = info
+	}
+	return nil
+}
+
+// signConcurrency is the number of backup chunk downloads signed in parallel
+const signConcurrency = 16
+
+// collectBackupChunks adds the manifest of a chunked backup and all chunks it references to the remote content
+func collectBackupChunks(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, workspaceOwner string, manifestInfo storage.DownloadInfo, rc map[string]storage.DownloadInfo) error {
+	body, err := HTTPGet(ctx, manifestInfo.URL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	manifest, err := chunks.ReadManifest(body)
+	if err != nil {
+		return err
+	}
+
+	rc[storage.DefaultBackupChunkManifest] = manifestInfo
+
+	var (
+		mu   sync.Mutex
+		seen = make(map[string]struct{})
+	)
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(signConcurrency)
+	for _, c := range manifest.Chunks {
+		name := chunks.ObjectName(c.Digest)
+		if _, exists := seen[name]; exists {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		eg.Go(func() error {
+			info, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(name), &storage.SignedURLOptions{})
+			if err != nil {
+				return xerrors.Errorf("cannot sign %s: %w", name, err)
+			}
+			mu.Lock()
+			rc[name] = *info
+			mu.Unlock()
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// HTTPGet downloads url and returns storage.ErrNotFound if it does not exist
+func HTTPGet(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, storage.ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("non-OK status code: %v", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
 // RunInitializer runs a content initializer in a user, PID and mount namespace to isolate it from ws-daemon
 func RunInitializer(ctx context.Context, destination string, initializer *csapi.WorkspaceInitializer, remoteContent map[string]storage.DownloadInfo, opts RunInitializerOpts) (*csapi.InitializerMetrics, error) {
 	//nolint:ineffassign,staticcheck
@@ -442,6 +550,7 @@ func writeInitializerStats(statsFd *os.File, stats *csapi.InitializerMetrics) {
 }
 
 var _ storage.DirectAccess = &remoteContentStorage{}
+var _ storage.ObjectReader = &remoteContentStorage{}
 
 type remoteContentStorage struct {
 	RemoteContent map[string]storage.DownloadInfo
@@ -521,6 +630,16 @@ func (rs *remoteContentStorage) DownloadSnapshot(ctx context.Context, destinatio
 	return rs.Download(ctx, destination, name, mappings)
 }
 
+// ReadObject downloads an object of the remote content, e.g. a chunk of a backup
+func (rs *remoteContentStorage) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	info, exists := rs.RemoteContent[name]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	return HTTPGet(ctx, info.URL)
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *remoteContentStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	return []string{}, nil
@@ -541,6 +660,16 @@ func (rs *remoteContentStorage) UploadInstance(ctx context.Context, source strin
 	return "", "", xerrors.Errorf("not implemented")
 }
 
+// DeleteObjects does nothing
+func (rs *remoteContentStorage) DeleteObjects(ctx context.Context, names ...string) error {
+	return xerrors.Errorf("not implemented")
+}
+
+// CopySnapshot does nothing
+func (rs *remoteContentStorage) CopySnapshot(ctx context.Context, snapshot string, name string) error {
+	return xerrors.Errorf("not implemented")
+}
+
 // Bucket returns an empty string
 func (rs *remoteContentStorage) Bucket(string) string {
 	return ""
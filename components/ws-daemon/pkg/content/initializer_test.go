@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunks"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+func TestCollectRemoteContent(t *testing.T) {
+	const dgst = "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	var (
+		older = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		newer = older.Add(time.Hour)
+		chunk = chunks.ObjectName(dgst)
+	)
+
+	tests := []struct {
+		Name        string
+		Objects     map[string]time.Time
+		Expectation []string
+	}{
+		{
+			Name:        "regular backup",
+			Objects:     map[string]time.Time{storage.DefaultBackup: newer},
+			Expectation: []string{storage.DefaultBackup},
+		},
+		{
+			Name:        "chunked backup",
+			Objects:     map[string]time.Time{storage.DefaultBackupChunkManifest: newer, chunk: newer},
+			Expectation: []string{chunk, storage.DefaultBackupChunkManifest},
+		},
+		{
+			Name:        "chunked backup newer than regular backup",
+			Objects:     map[string]time.Time{storage.DefaultBackup: older, storage.DefaultBackupChunkManifest: newer, chunk: newer},
+			Expectation: []string{chunk, storage.DefaultBackupChunkManifest, storage.DefaultBackup},
+		},
+		{
+			Name:        "stale chunked backup",
+			Objects:     map[string]time.Time{storage.DefaultBackup: newer, storage.DefaultBackupChunkManifest: older, chunk: older},
+			Expectation: []string{storage.DefaultBackup},
+		},
+		{
+			Name:        "no modification times",
+			Objects:     map[string]time.Time{storage.DefaultBackup: {}, storage.DefaultBackupChunkManifest: {}, chunk: {}},
+			Expectation: []string{chunk, storage.DefaultBackupChunkManifest, storage.DefaultBackup},
+		},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(chunks.Manifest{
+			Version: chunks.ManifestVersion,
+			Chunks:  []chunks.Chunk{{Digest: dgst, Size: 1}},
+			Size:    1,
+		})
+	}))
+	defer srv.Close()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ps := &fakePresignedAccess{URL: srv.URL, Objects: test.Objects}
+			rc, err := CollectRemoteContent(context.Background(), &fakeDirectAccess{}, ps, "owner", &csapi.WorkspaceInitializer{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for name := range rc {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if diff := cmp.Diff(test.Expectation, names); diff != "" {
+				t.Errorf("unexpected remote content (-want +got):\n%s", diff)
+			}
+
+			// without the manifest, restoring a workspace falls back to the regular backup
+			_, err = (&remoteContentStorage{RemoteContent: rc}).ReadObject(context.Background(), storage.DefaultBackupChunkManifest)
+			if _, chunked := rc[storage.DefaultBackupChunkManifest]; !chunked && !errors.Is(err, storage.ErrNotFound) {
+				t.Errorf("expected the chunked backup to be skipped, got %v", err)
+			}
+		})
+	}
+}
+
+type fakeDirectAccess struct {
+	storage.DirectAccess
+}
+
+func (*fakeDirectAccess) Bucket(owner string) string {
+	return "bucket-" + owner
+}
+
+func (*fakeDirectAccess) BackupObject(name string) string {
+	return name
+}
+
+type fakePresignedAccess struct {
+	storage.PresignedAccess
+
+	URL     string
+	Objects map[string]time.Time
+}
+
+func (ps *fakePresignedAccess) SignDownload(ctx context.Context, bucket, obj string, options *storage.SignedURLOptions) (*storage.DownloadInfo, error) {
+	modified, exists := ps.Objects[obj]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return &storage.DownloadInfo{
+		Meta: storage.ObjectMeta{LastModified: modified},
+		URL:  ps.URL + "/" + obj,
+	}, nil
+}
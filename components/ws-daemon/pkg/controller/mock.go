@@ -85,6 +85,20 @@ func (mr *MockWorkspaceOperationsMockRecorder) InitWorkspace(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceOperations)(nil).InitWorkspace), arg0, arg1)
 }
 
+// ResizeDisk mocks base method.
+func (m *MockWorkspaceOperations) ResizeDisk(arg0 context.Context, arg1 string, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeDisk", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeDisk indicates an expected call of ResizeDisk.
+func (mr *MockWorkspaceOperationsMockRecorder) ResizeDisk(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockWorkspaceOperations)(nil).ResizeDisk), arg0, arg1, arg2)
+}
+
 // SetupWorkspace mocks base method.
 func (m *MockWorkspaceOperations) SetupWorkspace(arg0 context.Context, arg1 string, arg2 *v1.WorkspaceImageInfo) error {
 	m.ctrl.T.Helper()
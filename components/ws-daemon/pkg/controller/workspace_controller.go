@@ -185,9 +185,11 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 				WorkspaceID: ws.Spec.Ownership.WorkspaceID,
 				InstanceID:  ws.Name,
 			},
-			Initializer:  init,
-			Headless:     ws.IsHeadless(),
-			StorageQuota: ws.Spec.StorageQuota,
+			Initializer:      init,
+			Headless:         ws.IsHeadless(),
+			StorageQuota:     ws.Spec.StorageQuota,
+			IngressBandwidth: ws.Spec.IngressBandwidth,
+			EgressBandwidth:  ws.Spec.EgressBandwidth,
 		})
 
 		initMetrics := initializerMetricsFromInitializerStats(stats)
@@ -202,6 +204,7 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionContentReady(metav1.ConditionFalse, workspacev1.ReasonInitializationFailure, failure))
 			} else {
 				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionContentReady(metav1.ConditionTrue, workspacev1.ReasonInitializationSuccess, ""))
+				ws.Status.StorageQuota = ws.Spec.StorageQuota
 			}
 
 			// persist initializer metrics
@@ -235,8 +238,9 @@ func initializerMetricsFromInitializerStats(stats *csapi.InitializerMetrics) *wo
 		switch metric.Type {
 		case "git":
 			result.Git = &workspacev1.InitializerStepMetric{
-				Duration: &metav1.Duration{Duration: metric.Duration},
-				Size:     metric.Size,
+				Duration:     &metav1.Duration{Duration: metric.Duration},
+				Size:         metric.Size,
+				BytesFetched: metric.BytesFetched,
 			}
 		case "fileDownload":
 			result.FileDownload = &workspacev1.InitializerStepMetric{
@@ -263,6 +267,12 @@ func initializerMetricsFromInitializerStats(stats *csapi.InitializerMetrics) *wo
 				Duration: &metav1.Duration{Duration: metric.Duration},
 				Size:     metric.Size,
 			}
+		case "gitLfs":
+			result.GitLFS = &workspacev1.InitializerStepMetric{
+				Duration:     &metav1.Duration{Duration: metric.Duration},
+				Size:         metric.Size,
+				BytesFetched: metric.BytesFetched,
+			}
 		}
 	}
 
@@ -306,9 +316,41 @@ func (wsc *WorkspaceController) handleWorkspaceRunning(ctx context.Context, ws *
 			glog.WithFields(ws.OWI()).WithField("workspace", req.NamespacedName).WithField("imageInfo", glog.TrustedValueWrap{Value: imageInfo}).Info("updated image info")
 		}
 	}
+
+	if ws.Status.StorageQuota != 0 && ws.Spec.StorageQuota > ws.Status.StorageQuota {
+		err = wsc.resizeDisk(ctx, ws, req)
+		wsc.emitEvent(ws, "Disk resize", err)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, wsc.operations.SetupWorkspace(ctx, ws.Name, imageInfo)
 }
 
+// resizeDisk grows the disk quota of a running workspace to the one requested in its spec
+func (wsc *WorkspaceController) resizeDisk(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) error {
+	storageQuota := ws.Spec.StorageQuota
+	glog.WithFields(ws.OWI()).WithField("workspace", req.NamespacedName).WithField("from", ws.Status.StorageQuota).WithField("to", storageQuota).Info("resizing workspace disk")
+
+	err := wsc.operations.ResizeDisk(ctx, ws.Name, storageQuota)
+	if err != nil {
+		return fmt.Errorf("failed to resize disk: %w", err)
+	}
+
+	err = retry.RetryOnConflict(retryParams, func() error {
+		if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
+			return err
+		}
+		ws.Status.StorageQuota = storageQuota
+		return wsc.Status().Update(ctx, ws)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update workspace with disk quota: %w", err)
+	}
+	return nil
+}
+
 func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "handleWorkspaceStop")
 	defer tracing.FinishSpan(span, &err)
@@ -483,10 +525,12 @@ func (wsc *WorkspaceController) doWorkspaceContentBackup(ctx context.Context, sp
 			WorkspaceID: ws.Spec.Ownership.WorkspaceID,
 			InstanceID:  ws.Name,
 		},
-		SnapshotName:      snapshotName,
-		BackupLogs:        ws.Spec.Type == workspacev1.WorkspaceTypePrebuild,
-		UpdateGitStatus:   ws.Spec.Type == workspacev1.WorkspaceTypeRegular,
-		SkipBackupContent: false,
+		SnapshotName:        snapshotName,
+		BackupLogs:          ws.Spec.Type == workspacev1.WorkspaceTypePrebuild,
+		UpdateGitStatus:     ws.Spec.Type == workspacev1.WorkspaceTypeRegular,
+		SkipBackupContent:   false,
+		IncrementalSnapshot: ws.Spec.Type == workspacev1.WorkspaceTypePrebuild,
+		SnapshotParent:      snapshotParent(ws),
 	})
 
 	err = retry.RetryOnConflict(retryParams, func() error {
@@ -576,6 +620,29 @@ func (wsc *WorkspaceController) prepareInitializer(ctx context.Context, ws *work
 	return &init, nil
 }
 
+// snapshotParent returns the snapshot a workspace was initialized from, e.g. the prebuild of a previous commit
+func snapshotParent(ws *workspacev1.Workspace) string {
+	var init csapi.WorkspaceInitializer
+	err := proto.Unmarshal(ws.Spec.Initializer, &init)
+	if err != nil {
+		return ""
+	}
+
+	inits := []*csapi.WorkspaceInitializer{&init}
+	if ci := init.GetComposite(); ci != nil {
+		inits = ci.Initializer
+	}
+	for _, i := range inits {
+		if si := i.GetSnapshot(); si != nil && !si.FromVolumeSnapshot {
+			return si.Snapshot
+		}
+		if pi := i.GetPrebuild(); pi != nil && pi.Prebuild != nil && !pi.Prebuild.FromVolumeSnapshot {
+			return pi.Prebuild.Snapshot
+		}
+	}
+	return ""
+}
+
 func (wsc *WorkspaceController) emitEvent(ws *workspacev1.Workspace, operation string, failure error) {
 	if failure != nil {
 		wsc.recorder.Eventf(ws, corev1.EventTypeWarning, "Failed", "%s failed: %s", operation, failure.Error())
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -19,12 +20,15 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/chunks"
 	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+	"github.com/gitpod-io/gitpod/content-service/pkg/snapshot"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -78,6 +82,8 @@ type WorkspaceOperations interface {
 	Snapshot(ctx context.Context, instanceID, snapshotName string) (err error)
 	// Setup ensures that the workspace has been setup
 	SetupWorkspace(ctx context.Context, instanceID string, imageInfo *workspacev1.WorkspaceImageInfo) error
+	// ResizeDisk grows the disk quota of a running workspace
+	ResizeDisk(ctx context.Context, instanceID string, storageQuota int) error
 }
 
 type DefaultWorkspaceOperations struct {
@@ -86,6 +92,7 @@ type DefaultWorkspaceOperations struct {
 	backupWorkspaceLimiter chan struct{}
 	metrics                *Metrics
 	dispatch               *dispatch.Dispatch
+	xfs                    *quota.XFS
 }
 
 var _ WorkspaceOperations = (*DefaultWorkspaceOperations)(nil)
@@ -101,6 +108,9 @@ type InitOptions struct {
 	Initializer  *csapi.WorkspaceInitializer
 	Headless     bool
 	StorageQuota int
+	// IngressBandwidth and EgressBandwidth limit the network bandwidth in bytes per second, zero means unlimited
+	IngressBandwidth int64
+	EgressBandwidth  int64
 }
 
 type BackupOptions struct {
@@ -109,9 +119,13 @@ type BackupOptions struct {
 	UpdateGitStatus   bool
 	SnapshotName      string
 	SkipBackupContent bool
+	// IncrementalSnapshot permits the snapshot to only contain the changes since SnapshotParent,
+	// i.e. the snapshot the workspace was initialized from.
+	IncrementalSnapshot bool
+	SnapshotParent      string
 }
 
-func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer, dispatch *dispatch.Dispatch) (WorkspaceOperations, error) {
+func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer, dispatch *dispatch.Dispatch, xfs *quota.XFS) (WorkspaceOperations, error) {
 	waitingTimeHist, waitingTimeoutCounter, err := registerConcurrentBackupMetrics(reg, "_mk2")
 	if err != nil {
 		return nil, err
@@ -127,12 +141,13 @@ func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider,
 		// we permit five concurrent backups at any given time, hence the five in the channel
 		backupWorkspaceLimiter: make(chan struct{}, 5),
 		dispatch:               dispatch,
+		xfs:                    xfs,
 	}, nil
 }
 
 func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, options InitOptions) (*csapi.InitializerMetrics, string, error) {
 	ws, err := wso.provider.NewWorkspace(ctx, options.Meta.InstanceID, filepath.Join(wso.provider.Location, options.Meta.InstanceID),
-		wso.creator(options.Meta.Owner, options.Meta.WorkspaceID, options.Meta.InstanceID, options.Initializer, false, options.StorageQuota))
+		wso.creator(options.Meta.Owner, options.Meta.WorkspaceID, options.Meta.InstanceID, options.Initializer, false, options.StorageQuota, options.IngressBandwidth, options.EgressBandwidth))
 
 	if err != nil {
 		return nil, "bug: cannot add workspace to store", xerrors.Errorf("cannot add workspace to store: %w", err)
@@ -196,7 +211,7 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 	return stats, "", nil
 }
 
-func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID string, init *csapi.WorkspaceInitializer, storageDisabled bool, storageQuota int) WorkspaceFactory {
+func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID string, init *csapi.WorkspaceInitializer, storageDisabled bool, storageQuota int, ingressBandwidth, egressBandwidth int64) WorkspaceFactory {
 	var checkoutLocation string
 	allLocations := csapi.GetCheckoutLocationsFromInitializer(init)
 	if len(allLocations) > 0 {
@@ -214,6 +229,8 @@ func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID st
 			InstanceID:            instanceID,
 			RemoteStorageDisabled: storageDisabled,
 			StorageQuota:          storageQuota,
+			IngressBandwidth:      ingressBandwidth,
+			EgressBandwidth:       egressBandwidth,
 
 			ServiceLocDaemon: filepath.Join(wso.config.WorkingArea, serviceDirName),
 			ServiceLocNode:   filepath.Join(wso.config.WorkingAreaNode, serviceDirName),
@@ -233,6 +250,26 @@ func (wso *DefaultWorkspaceOperations) SetupWorkspace(ctx context.Context, insta
 	return nil
 }
 
+func (wso *DefaultWorkspaceOperations) ResizeDisk(ctx context.Context, instanceID string, storageQuota int) error {
+	ws, err := wso.provider.GetAndConnect(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("cannot find workspace %s during ResizeDisk: %w", instanceID, err)
+	}
+	if wso.xfs == nil || ws.XFSProjectID == 0 {
+		return fmt.Errorf("workspace %s has no disk quota", instanceID)
+	}
+
+	size := quota.Size(storageQuota)
+	err = wso.xfs.SetHardLimit(ws.XFSProjectID, size)
+	if err != nil {
+		return fmt.Errorf("cannot set disk quota of workspace %s: %w", instanceID, err)
+	}
+	glog.WithFields(ws.OWI()).WithField("size", size).Info("resized workspace disk")
+
+	ws.StorageQuota = storageQuota
+	return ws.Persist()
+}
+
 func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts BackupOptions) (*csapi.GitStatus, error) {
 	ws, err := wso.provider.GetAndConnect(ctx, opts.Meta.InstanceID)
 	if err != nil {
@@ -255,7 +292,15 @@ func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts
 		return nil, nil
 	}
 
-	err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName)
+	if opts.IncrementalSnapshot && opts.SnapshotName != storage.DefaultBackup && wso.config.Backup.MaxSnapshotChainLength > 1 {
+		var ps storage.PresignedAccess
+		ps, err = storage.NewPresignedAccess(&wso.config.Storage)
+		if err == nil {
+			err = wso.uploadSnapshot(ctx, ws, ps, opts.SnapshotName, opts.SnapshotParent)
+		}
+	} else {
+		err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName)
+	}
 	if err != nil {
 		glog.WithError(err).WithFields(ws.OWI()).Error("final backup failed for workspace")
 		return nil, fmt.Errorf("final backup failed for workspace %s", opts.Meta.InstanceID)
@@ -453,7 +498,8 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceLogs(ctx context.Context,
 	return err
 }
 
-func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName string) error {
+// waitForBackupSlot blocks until the number of simultaneous backups permits another one. The returned function releases the slot.
+func (wso *DefaultWorkspaceOperations) waitForBackupSlot() (release func()) {
 	// Avoid too many simultaneous backups in order to avoid excessive memory utilization.
 	var timedOut bool
 	waitStart := time.Now()
@@ -470,26 +516,35 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 	waitTime := time.Since(waitStart)
 	wso.metrics.BackupWaitingTimeHist.Observe(waitTime.Seconds())
 
-	defer func() {
+	return func() {
 		// timeout -> we did not add to the limiter
 		if timedOut {
 			return
 		}
 
 		<-wso.backupWorkspaceLimiter
-	}()
-
-	var (
-		loc  = sess.Location
-		opts []storage.UploadOption
-	)
+	}
+}
 
+func removeWorkspaceReadyFile(sess *session.Workspace) {
 	err := os.Remove(filepath.Join(sess.Location, wsinit.WorkspaceReadyFile))
 	if err != nil && !errors.Is(err, fs.ErrNotExist) {
 		// We'll still upload the backup, well aware that the UX during restart will be broken.
 		// But it's better to have a backup with all files (albeit one too many), than having no backup at all.
 		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot remove workspace ready file")
 	}
+}
+
+func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName string) error {
+	release := wso.waitForBackupSlot()
+	defer release()
+
+	var (
+		loc  = sess.Location
+		opts []storage.UploadOption
+	)
+
+	removeWorkspaceReadyFile(sess)
 
 	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
 	if rs == nil || !ok {
@@ -507,7 +562,7 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		}
 	}()
 
-	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "create archive"), func(ctx context.Context) (err error) {
+	err := retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "create archive"), func(ctx context.Context) (err error) {
 		tmpf, err = os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.tar", sess.InstanceID))
 		if err != nil {
 			return
@@ -520,18 +575,7 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 			}
 		}()
 
-		var opts []archive.TarOption
-		opts = append(opts)
-		mappings := []archive.IDMapping{
-			{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
-			{ContainerID: 1, HostID: 100000, Size: 65534},
-		}
-		opts = append(opts,
-			archive.WithUIDMapping(mappings),
-			archive.WithGIDMapping(mappings),
-		)
-
-		err = content.BuildTarbal(ctx, loc, tmpf.Name(), opts...)
+		err = content.BuildTarbal(ctx, loc, tmpf.Name(), backupTarOptions()...)
 		if err != nil {
 			return
 		}
@@ -557,6 +601,20 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		return xerrors.Errorf("cannot create archive: %w", err)
 	}
 
+	if wso.config.Backup.Chunked && backupName == storage.DefaultBackup {
+		var stats *chunks.UploadStats
+		err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload chunks"), func(ctx context.Context) (err error) {
+			stats, err = chunks.Upload(ctx, rs, tmpf.Name(), wso.config.TmpDir)
+			return
+		})
+		if err != nil {
+			return xerrors.Errorf("cannot upload workspace content: %w", err)
+		}
+		glog.WithFields(sess.OWI()).WithField("chunks", stats.Chunks).WithField("uploadedChunks", stats.UploadedChunks).WithField("size", stats.Size).WithField("uploadedSize", stats.UploadedSize).Info("uploaded chunked workspace backup")
+
+		return nil
+	}
+
 	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
 		_, _, err = rs.Upload(ctx, tmpf.Name(), backupName, opts...)
 		if err != nil {
@@ -569,9 +627,216 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		return xerrors.Errorf("cannot upload workspace content: %w", err)
 	}
 
+	if backupName == storage.DefaultBackup {
+		// a chunked backup from when chunking was enabled is of no use anymore. Should removing it fail,
+		// restoring the workspace still uses the regular backup because it is the more recent one.
+		err = chunks.Remove(ctx, rs)
+		if err != nil {
+			glog.WithError(err).WithFields(sess.OWI()).Warn("cannot remove previous chunked backup")
+		}
+	}
+
+	return nil
+}
+
+// backupTarOptions map the owners of the workspace content on disk back to the IDs within the workspace
+func backupTarOptions() []archive.TarOption {
+	mappings := []archive.IDMapping{
+		{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
+		{ContainerID: 1, HostID: 100000, Size: 65534},
+	}
+	return []archive.TarOption{
+		archive.WithUIDMapping(mappings),
+		archive.WithGIDMapping(mappings),
+	}
+}
+
+// uploadSnapshot takes a snapshot which only contains the changes since the parent snapshot. If there is no parent, it has no
+// index or the snapshot chain would grow beyond the configured maximum, a full snapshot is taken instead. Either way we upload
+// the index of the snapshot, such that the next snapshot can build upon it.
+func (wso *DefaultWorkspaceOperations) uploadSnapshot(ctx context.Context, sess *session.Workspace, ps storage.PresignedAccess, snapshotName, parent string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadSnapshot")
+	span.SetTag("parent", parent)
+	defer tracing.FinishSpan(span, &err)
+
+	log := glog.WithFields(sess.OWI()).WithField("snapshot", snapshotName).WithField("parent", parent)
+
+	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		return xerrors.Errorf("no remote storage configured")
+	}
+
+	removeWorkspaceReadyFile(sess)
+	idx, err := snapshot.BuildIndex(sess.Location)
+	if err != nil {
+		log.WithError(err).Warn("cannot index workspace content - taking a full snapshot")
+		return wso.uploadWorkspaceContent(ctx, sess, snapshotName)
+	}
+
+	var incremental bool
+	if parent != "" {
+		chain, parentIdx, err := downloadSnapshotParent(ctx, ps, parent)
+		switch {
+		case err != nil:
+			log.WithError(err).Warn("cannot download parent snapshot index - taking a full snapshot")
+		case parentIdx == nil:
+			log.Info("parent snapshot has no index - taking a full snapshot")
+		case chain.Len()+1 > wso.config.Backup.MaxSnapshotChainLength:
+			log.WithField("chainLength", chain.Len()).Info("snapshot chain reached its maximum length - taking a full snapshot")
+		default:
+			err = wso.uploadIncrementalSnapshot(ctx, sess, rs, snapshotName, parent, chain, parentIdx, idx)
+			if err != nil {
+				log.WithError(err).Warn("cannot upload incremental snapshot - taking a full snapshot")
+			}
+			incremental = err == nil
+		}
+	}
+	if !incremental {
+		err = wso.uploadWorkspaceContent(ctx, sess, snapshotName)
+		if err != nil {
+			return err
+		}
+	}
+
+	// without an index the next snapshot is a full one - that's no reason to fail this one
+	err = uploadSnapshotIndex(ctx, rs, wso.config.TmpDir, snapshotName, idx)
+	if err != nil {
+		log.WithError(err).Warn("cannot upload snapshot index")
+	}
+	return nil
+}
+
+// downloadSnapshotParent downloads the chain and index of a snapshot. The chain is nil for full snapshots,
+// the index is nil if the snapshot has none.
+func downloadSnapshotParent(ctx context.Context, ps storage.PresignedAccess, parent string) (chain *snapshot.Chain, idx *snapshot.Index, err error) {
+	read := func(name string, f func(io.Reader) error) error {
+		bkt, obj, err := storage.ParseSnapshotName(name)
+		if err != nil {
+			return err
+		}
+		info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+		if err != nil {
+			return err
+		}
+		body, err := content.HTTPGet(ctx, info.URL)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		return f(body)
+	}
+
+	err = read(snapshot.IndexName(parent), func(r io.Reader) (err error) {
+		idx, err = snapshot.ReadIndex(r)
+		return
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = read(snapshot.ChainName(parent), func(r io.Reader) (err error) {
+		chain, err = snapshot.ReadChain(r, parent)
+		return
+	})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, idx, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return chain, idx, nil
+}
+
+// uploadIncrementalSnapshot uploads the files which changed since the parent snapshot, followed by the chain of the new snapshot.
+// Without the chain the snapshot would be taken for a full one, hence we must not return successfully without it. The snapshots
+// in the chain are copied next to the new snapshot, such that deleting the workspaces which took them doesn't break the chain.
+func (wso *DefaultWorkspaceOperations) uploadIncrementalSnapshot(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, snapshotName, parent string, parentChain *snapshot.Chain, parentIdx, idx *snapshot.Index) error {
+	release := wso.waitForBackupSlot()
+	defer release()
+
+	changed, deleted := snapshot.Diff(parentIdx, idx)
+	chain := parentChain.Extend(parent, rs.Qualify(snapshotName), deleted)
+	for i := range chain.Layers[:len(chain.Layers)-1] {
+		src, dst := chain.Layers[i].Snapshot, snapshot.LayerName(snapshotName, i)
+		err := retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "copy snapshot layer"), func(ctx context.Context) error {
+			return rs.CopySnapshot(ctx, src, dst)
+		})
+		if err != nil {
+			return xerrors.Errorf("cannot copy snapshot %s: %w", src, err)
+		}
+		chain.Layers[i].Snapshot = rs.Qualify(dst)
+	}
+
+	tmpf, err := os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wssnap-%s-*.tar", sess.InstanceID))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpf.Name())
+	err = snapshot.WriteDelta(tmpf, sess.Location, changed, backupTarOptions()...)
+	if err != nil {
+		tmpf.Close()
+		return err
+	}
+	err = tmpf.Close()
+	if err != nil {
+		return err
+	}
+
+	chainf, err := os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wssnap-%s-*.chain.json", sess.InstanceID))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(chainf.Name())
+	err = json.NewEncoder(chainf).Encode(chain)
+	if err != nil {
+		chainf.Close()
+		return err
+	}
+	err = chainf.Close()
+	if err != nil {
+		return err
+	}
+
+	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload incremental snapshot"), func(ctx context.Context) (err error) {
+		_, _, err = rs.Upload(ctx, tmpf.Name(), snapshotName)
+		if err != nil {
+			return
+		}
+		_, _, err = rs.Upload(ctx, chainf.Name(), snapshot.ChainName(snapshotName), storage.WithContentType("application/json"))
+		return
+	})
+	if err != nil {
+		return err
+	}
+
+	glog.WithFields(sess.OWI()).WithField("changed", len(changed)).WithField("deleted", len(deleted)).WithField("chainLength", chain.Len()).Info("uploaded incremental snapshot")
 	return nil
 }
 
+func uploadSnapshotIndex(ctx context.Context, rs storage.DirectAccess, tmpdir, snapshotName string, idx *snapshot.Index) error {
+	f, err := os.CreateTemp(tmpdir, "wssnap-*.index.json.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	err = snapshot.WriteIndex(f, idx)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = rs.Upload(ctx, f.Name(), snapshot.IndexName(snapshotName))
+	return err
+}
+
 func (wso *DefaultWorkspaceOperations) writeImageInfo(_ context.Context, ws *session.Workspace, imageInfo *workspacev1.WorkspaceImageInfo) error {
 	if imageInfo == nil {
 		return nil
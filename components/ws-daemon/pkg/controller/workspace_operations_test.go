@@ -0,0 +1,417 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/snapshot"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+)
+
+const (
+	testParent   = "workspaces/prebuild/snapshot-1.tar@bucket"
+	testSnapshot = "snapshot-2.tar"
+)
+
+func TestUploadSnapshot(t *testing.T) {
+	tests := []struct {
+		Name string
+		// ParentChain is the number of snapshots the parent consists of - zero means there is no parent
+		ParentChain   int
+		NoParentIndex bool
+		// Chain lists the snapshots the new snapshot consists of - nil means it's a full snapshot
+		Chain []string
+	}{
+		{
+			Name: "no parent",
+		},
+		{
+			Name:          "parent without index",
+			ParentChain:   1,
+			NoParentIndex: true,
+		},
+		{
+			Name:        "full parent",
+			ParentChain: 1,
+			Chain: []string{
+				"workspaces/ws/snapshot-2.tar.layer-0@bucket",
+				"workspaces/ws/snapshot-2.tar@bucket",
+			},
+		},
+		{
+			Name:        "incremental parent",
+			ParentChain: 2,
+			Chain: []string{
+				"workspaces/ws/snapshot-2.tar.layer-0@bucket",
+				"workspaces/ws/snapshot-2.tar.layer-1@bucket",
+				"workspaces/ws/snapshot-2.tar@bucket",
+			},
+		},
+		{
+			Name:        "chain at maximum length",
+			ParentChain: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rs, ps := newMemoryStorage(t)
+
+			src := t.TempDir()
+			writeFiles(t, src, time.Unix(1000, 0), map[string]string{
+				"README.md":   "hello",
+				"src/main.go": "package main",
+			})
+
+			var parent string
+			if test.ParentChain > 0 {
+				parent = testParent
+				// the chain of an incremental parent references copies of the snapshots it builds on
+				chain := &snapshot.Chain{Version: snapshot.ChainVersion}
+				for i := 0; i < test.ParentChain-1; i++ {
+					layer := snapshot.LayerName(parent, i)
+					rs.put(layer, tarFiles(t, src))
+					chain.Layers = append(chain.Layers, snapshot.ChainLayer{Snapshot: layer})
+				}
+				if len(chain.Layers) > 0 {
+					chain.Layers = append(chain.Layers, snapshot.ChainLayer{Snapshot: parent})
+					rs.put(snapshot.ChainName(parent), jsonBytes(t, chain))
+				}
+				rs.put(parent, tarFiles(t, src))
+				if !test.NoParentIndex {
+					rs.put(snapshot.IndexName(parent), indexBytes(t, src))
+				}
+			}
+
+			writeFiles(t, src, time.Unix(2000, 0), map[string]string{
+				"src/main.go": "package main\n\nfunc main() {}",
+			})
+			err := os.Remove(filepath.Join(src, "README.md"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wso := newTestWorkspaceOperations(t, 3)
+			sess := &session.Workspace{
+				Location:           src,
+				NonPersistentAttrs: map[string]interface{}{session.AttrRemoteStorage: rs},
+			}
+			err = wso.uploadSnapshot(context.Background(), sess, ps, testSnapshot, parent)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			name := rs.Qualify(testSnapshot)
+			if _, ok := rs.get(name); !ok {
+				t.Fatal("snapshot was not uploaded")
+			}
+			if _, ok := rs.get(snapshot.IndexName(name)); !ok {
+				t.Error("snapshot index was not uploaded")
+			}
+
+			var act []string
+			if data, ok := rs.get(snapshot.ChainName(name)); ok {
+				chain, err := snapshot.ReadChain(bytes.NewReader(data), name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, l := range chain.Layers {
+					act = append(act, l.Snapshot)
+				}
+			}
+			if diff := cmp.Diff(test.Chain, act); diff != "" {
+				t.Fatalf("unexpected snapshot chain (-want +got):\n%s", diff)
+			}
+			if test.Chain == nil {
+				return
+			}
+
+			// the chain must survive the deletion of the prebuild workspace which took the parent snapshot
+			rs.deletePrefix("workspaces/prebuild/")
+
+			dst := t.TempDir()
+			found, err := snapshot.Restore(context.Background(), dst, rs, name, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !found {
+				t.Fatal("snapshot chain is incomplete")
+			}
+			want, err := snapshot.BuildIndex(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := snapshot.BuildIndex(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected restored content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDownloadSnapshotParent(t *testing.T) {
+	chain := (*snapshot.Chain)(nil).Extend(snapshot.LayerName(testParent, 0), testParent, []string{"README.md"})
+
+	src := t.TempDir()
+	writeFiles(t, src, time.Unix(1000, 0), map[string]string{"src/main.go": "package main"})
+	var (
+		idx       = indexBytes(t, src)
+		chainName = snapshot.ChainName(testParent)
+		indexName = snapshot.IndexName(testParent)
+	)
+
+	tests := []struct {
+		Name        string
+		Objects     map[string][]byte
+		Expectation *snapshot.Chain
+		Index       bool
+		Error       bool
+	}{
+		{
+			Name: "no index",
+		},
+		{
+			Name:    "full snapshot",
+			Objects: map[string][]byte{indexName: idx},
+			Index:   true,
+		},
+		{
+			Name:        "incremental snapshot",
+			Objects:     map[string][]byte{indexName: idx, chainName: jsonBytes(t, chain)},
+			Expectation: chain,
+			Index:       true,
+		},
+		{
+			Name:    "chain without index",
+			Objects: map[string][]byte{chainName: jsonBytes(t, chain)},
+		},
+		{
+			Name:    "invalid chain",
+			Objects: map[string][]byte{indexName: idx, chainName: []byte("{}")},
+			Error:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rs, ps := newMemoryStorage(t)
+			for name, data := range test.Objects {
+				rs.put(name, data)
+			}
+
+			actChain, actIdx, err := downloadSnapshotParent(context.Background(), ps, testParent)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, actChain); diff != "" {
+				t.Errorf("unexpected chain (-want +got):\n%s", diff)
+			}
+			if (actIdx != nil) != test.Index {
+				t.Errorf("unexpected index: %v", actIdx)
+			}
+		})
+	}
+}
+
+func newTestWorkspaceOperations(t *testing.T, maxChainLength int) *DefaultWorkspaceOperations {
+	return &DefaultWorkspaceOperations{
+		config: content.Config{
+			TmpDir: t.TempDir(),
+			Backup: content.BackupConfig{
+				Attempts:               1,
+				MaxSnapshotChainLength: maxChainLength,
+			},
+		},
+		metrics: &Metrics{
+			BackupWaitingTimeHist:       prometheus.NewHistogram(prometheus.HistogramOpts{Name: "backup_waiting_time"}),
+			BackupWaitingTimeoutCounter: prometheus.NewCounter(prometheus.CounterOpts{Name: "backup_waiting_timeouts"}),
+		},
+		backupWorkspaceLimiter: make(chan struct{}, 1),
+	}
+}
+
+// tarFiles produces a full snapshot of root
+func tarFiles(t *testing.T, root string) []byte {
+	idx, err := snapshot.BuildIndex(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for p := range idx.Entries {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	err = snapshot.WriteDelta(&buf, root, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func indexBytes(t *testing.T, root string) []byte {
+	idx, err := snapshot.BuildIndex(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = snapshot.WriteIndex(&buf, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func jsonBytes(t *testing.T, v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// writeFiles writes files with the given modification time, as the snapshot index has a resolution of one second
+func writeFiles(t *testing.T, root string, mtime time.Time, files map[string]string) {
+	for name, data := range files {
+		fn := filepath.Join(root, name)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(data), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.Chtimes(fn, mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newMemoryStorage produces a remote storage of the workspace "ws" and a presigned access which serves all its objects
+func newMemoryStorage(t *testing.T) (*memoryStorage, *presignedMemoryStorage) {
+	rs := &memoryStorage{objects: make(map[string][]byte)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := rs.get(strings.TrimPrefix(r.URL.Path, "/"))
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return rs, &presignedMemoryStorage{rs: rs, url: srv.URL}
+}
+
+// memoryStorage keeps the objects of all workspaces in memory, keyed by their fully qualified name
+type memoryStorage struct {
+	storage.DirectNoopStorage
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (rs *memoryStorage) get(name string) ([]byte, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	data, ok := rs.objects[name]
+	return data, ok
+}
+
+func (rs *memoryStorage) put(name string, data []byte) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.objects[name] = data
+}
+
+func (rs *memoryStorage) deletePrefix(prefix string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for name := range rs.objects {
+		if strings.HasPrefix(name, prefix) {
+			delete(rs.objects, name)
+		}
+	}
+}
+
+func (rs *memoryStorage) Qualify(name string) string {
+	return "workspaces/ws/" + name + "@bucket"
+}
+
+func (rs *memoryStorage) Upload(ctx context.Context, source string, name string, opts ...storage.UploadOption) (string, string, error) {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return "", "", err
+	}
+	rs.put(rs.Qualify(name), data)
+	bkt, obj, err := storage.ParseSnapshotName(rs.Qualify(name))
+	return bkt, obj, err
+}
+
+func (rs *memoryStorage) CopySnapshot(ctx context.Context, snapshot string, name string) error {
+	data, ok := rs.get(snapshot)
+	if !ok {
+		return storage.ErrNotFound
+	}
+	rs.put(rs.Qualify(name), data)
+	return nil
+}
+
+func (rs *memoryStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	data, ok := rs.get(name)
+	if !ok {
+		return false, nil
+	}
+	return true, archive.ExtractTarbal(ctx, bytes.NewReader(data), destination)
+}
+
+func (rs *memoryStorage) ReadObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, ok := rs.get(name)
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// presignedMemoryStorage signs downloads of the objects in a memoryStorage
+type presignedMemoryStorage struct {
+	storage.PresignedNoopStorage
+
+	rs  *memoryStorage
+	url string
+}
+
+func (ps *presignedMemoryStorage) SignDownload(ctx context.Context, bucket, obj string, options *storage.SignedURLOptions) (*storage.DownloadInfo, error) {
+	name := obj + "@" + bucket
+	if _, ok := ps.rs.get(name); !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &storage.DownloadInfo{URL: ps.url + "/" + url.PathEscape(name)}, nil
+}
@@ -21,15 +21,16 @@ import (
 type Config struct {
 	Runtime RuntimeConfig `json:"runtime"`
 
-	Content             content.Config            `json:"content"`
-	Uidmapper           iws.UidmapperConfig       `json:"uidmapper"`
-	CPULimit            cpulimit.Config           `json:"cpulimit"`
-	IOLimit             IOLimitConfig             `json:"ioLimit"`
-	ProcLimit           int64                     `json:"procLimit"`
-	NetLimit            netlimit.Config           `json:"netlimit"`
-	OOMScores           cgroup.OOMScoreAdjConfig  `json:"oomScores"`
-	DiskSpaceGuard      diskguard.Config          `json:"disk"`
-	WorkspaceController WorkspaceControllerConfig `json:"workspaceController"`
+	Content             content.Config                `json:"content"`
+	Uidmapper           iws.UidmapperConfig           `json:"uidmapper"`
+	CPULimit            cpulimit.Config               `json:"cpulimit"`
+	IOLimit             IOLimitConfig                 `json:"ioLimit"`
+	ProcLimit           int64                         `json:"procLimit"`
+	NetLimit            netlimit.Config               `json:"netlimit"`
+	OOMScores           cgroup.OOMScoreAdjConfig      `json:"oomScores"`
+	ResourcePressure    cgroup.ResourcePressureConfig `json:"resourcePressure"`
+	DiskSpaceGuard      diskguard.Config              `json:"disk"`
+	WorkspaceController WorkspaceControllerConfig     `json:"workspaceController"`
 
 	RegistryFacadeHost string `json:"registryFacadeHost,omitempty"`
 }
@@ -95,6 +95,30 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
+	var mgr manager.Manager
+
+	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
+		Scheme:                 scheme,
+		HealthProbeBindAddress: "0",
+		Metrics: metricsserver.Options{
+			// Disable the metrics server.
+			// We only need access to the reconciliation loop feature.
+			BindAddress: "0",
+		},
+		Cache: cache.Options{
+			DefaultNamespaces: map[string]cache.Config{
+				config.Runtime.KubernetesNamespace: {},
+				config.Runtime.SecretsNamespace:    {},
+			},
+		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port: 9443,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	cgroupV2IOLimiter, err := cgroup.NewIOLimiterV2(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
 	if err != nil {
 		return nil, err
@@ -105,6 +129,8 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
+	resourcePressurePlugin := cgroup.NewResourcePressureV2(config.ResourcePressure, mgr.GetClient(), config.Runtime.KubernetesNamespace)
+
 	cgroupPlugins, err := cgroup.NewPluginHost(config.CPULimit.CGroupBasePath,
 		&cgroup.FuseDeviceEnablerV2{},
 		cgroupV2IOLimiter,
@@ -134,6 +160,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		},
 		procV2Plugin,
 		cgroup.NewPSIMetrics(wrappedReg),
+		resourcePressurePlugin,
 	)
 	if err != nil {
 		return nil, err
@@ -159,40 +186,32 @@ func NewDaemon(config Config) (*Daemon, error) {
 		listener = append(listener, netlimiter)
 	}
 
+	if config.NetLimit.Egress.Enabled {
+		err = config.NetLimit.Egress.Validate()
+		if err != nil {
+			return nil, xerrors.Errorf("invalid egress policies: %w", err)
+		}
+	}
+	egresslimiter := netlimit.NewEgressLimiter(config.NetLimit.Egress, wrappedReg)
+	if config.NetLimit.Egress.Enabled {
+		listener = append(listener, egresslimiter)
+	}
+	listener = append(listener, netlimit.NewBandwidthMonitor(wrappedReg))
+
 	var configReloader CompositeConfigReloader
 	configReloader = append(configReloader, ConfigReloaderFunc(func(ctx context.Context, config *Config) error {
 		cgroupV2IOLimiter.Update(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
 		procV2Plugin.Update(config.ProcLimit)
+		resourcePressurePlugin.Update(config.ResourcePressure)
 		if config.NetLimit.Enabled {
 			netlimiter.Update(config.NetLimit)
 		}
+		if config.NetLimit.Egress.Enabled {
+			egresslimiter.Update(config.NetLimit.Egress)
+		}
 		return nil
 	}))
 
-	var mgr manager.Manager
-
-	mgr, err = ctrl.NewManager(restCfg, ctrl.Options{
-		Scheme:                 scheme,
-		HealthProbeBindAddress: "0",
-		Metrics: metricsserver.Options{
-			// Disable the metrics server.
-			// We only need access to the reconciliation loop feature.
-			BindAddress: "0",
-		},
-		Cache: cache.Options{
-			DefaultNamespaces: map[string]cache.Config{
-				config.Runtime.KubernetesNamespace: {},
-				config.Runtime.SecretsNamespace:    {},
-			},
-		},
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port: 9443,
-		}),
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	contentCfg := config.Content
 
 	xfs, err := quota.NewXFS(contentCfg.WorkingArea)
@@ -206,6 +225,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		&iws.Uidmapper{Config: config.Uidmapper, Runtime: containerRuntime},
 		xfs,
 		config.CPULimit.CGroupBasePath,
+		resourcePressurePlugin,
 	)
 
 	dsptch, err := dispatch.NewDispatch(containerRuntime, clientset, config.Runtime.KubernetesNamespace, nodename, listener...)
@@ -213,7 +233,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
-	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), wrappedReg, dsptch)
+	workspaceOps, err := controller.NewWorkspaceOperations(contentCfg, controller.NewWorkspaceProvider(contentCfg.WorkingArea, hooks), wrappedReg, dsptch, xfs)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +72,10 @@ type Workspace struct {
 	RemoteStorageDisabled bool `json:"remoteStorageDisabled,omitempty"`
 	StorageQuota          int  `json:"storageQuota,omitempty"`
 
+	// IngressBandwidth and EgressBandwidth limit the network bandwidth of the workspace in bytes per second
+	IngressBandwidth int64 `json:"ingressBandwidth,omitempty"`
+	EgressBandwidth  int64 `json:"egressBandwidth,omitempty"`
+
 	XFSProjectID int `json:"xfsProjectID"`
 
 	NonPersistentAttrs map[string]interface{} `json:"-"`
@@ -37,6 +37,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
 	nsi "github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
@@ -85,7 +86,7 @@ var (
 )
 
 // ServeWorkspace establishes the IWS server for a workspace
-func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string) func(ctx context.Context, ws *session.Workspace) error {
+func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string, resourcePressure *cgroup.ResourcePressureV2) func(ctx context.Context, ws *session.Workspace) error {
 	return func(ctx context.Context, ws *session.Workspace) (err error) {
 		span, _ := opentracing.StartSpanFromContext(ctx, "iws.ServeWorkspace")
 		defer tracing.FinishSpan(span, &err)
@@ -100,6 +101,7 @@ func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMount
 			FSShift:              fsshift,
 			CGroupMountPoint:     cgroupMountPoint,
 			WorkspaceCIDR:        workspaceCIDR,
+			ResourcePressure:     resourcePressure,
 			prepareForUserNSCond: sync.NewCond(&sync.Mutex{}),
 		}
 		err = iws.Start()
@@ -144,6 +146,9 @@ type InWorkspaceServiceServer struct {
 
 	WorkspaceCIDR string
 
+	// ResourcePressure determines whether the workspace is under resource pressure the user should know about
+	ResourcePressure *cgroup.ResourcePressureV2
+
 	srv  *grpc.Server
 	sckt io.Closer
 
@@ -354,6 +359,19 @@ func (wbs *InWorkspaceServiceServer) SetupPairVeths(ctx context.Context, req *ap
 		return nil, status.Errorf(codes.Internal, "cannot setup a pair of veths")
 	}
 
+	if wbs.Session.IngressBandwidth > 0 || wbs.Session.EgressBandwidth > 0 {
+		err = nsi.Nsinsider(wbs.Session.InstanceID, int(containerPID), func(c *exec.Cmd) {
+			c.Args = append(c.Args, "setup-bandwidth-limit",
+				fmt.Sprintf("--ingress=%d", wbs.Session.IngressBandwidth),
+				fmt.Sprintf("--egress=%d", wbs.Session.EgressBandwidth),
+			)
+		}, nsi.EnterMountNS(false), nsi.EnterNetNS(true))
+		if err != nil {
+			log.WithError(err).WithFields(wbs.Session.OWI()).Error("SetupPairVeths: cannot limit the bandwidth")
+			return nil, status.Errorf(codes.Internal, "cannot limit the bandwidth")
+		}
+	}
+
 	pid, err := wbs.Uidmapper.findHostPID(containerPID, uint64(req.Pid))
 	if err != nil {
 		return nil, xerrors.Errorf("cannot map in-container PID %d (container PID: %d): %w", req.Pid, containerPID, err)
@@ -1048,12 +1066,45 @@ func (wbs *InWorkspaceServiceServer) WorkspaceInfo(ctx context.Context, req *api
 		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	resources.Pressure = wbs.resourcePressure(cgroupPath)
 
 	return &api.WorkspaceInfoResponse{
 		Resources: resources,
 	}, nil
 }
 
+// resourcePressure returns the pressure the workspace is under if the user should be notified about it
+func (wbs *InWorkspaceServiceServer) resourcePressure(cgroupPath string) *api.ResourcePressure {
+	if wbs.ResourcePressure == nil {
+		return nil
+	}
+	cfg := wbs.ResourcePressure.Config()
+	if !cfg.Enabled || !cfg.NotifyUser {
+		return nil
+	}
+
+	memoryPSI, err := v2.NewMemoryControllerWithMount(wbs.CGroupMountPoint, cgroupPath).PSI()
+	if err != nil {
+		log.WithError(err).WithFields(wbs.Session.OWI()).Debug("could not retrieve memory psi")
+		return nil
+	}
+	ioPSI, err := v2.NewIOControllerWithMount(wbs.CGroupMountPoint, cgroupPath).PSI()
+	if err != nil {
+		log.WithError(err).WithFields(wbs.Session.OWI()).Debug("could not retrieve io psi")
+		return nil
+	}
+
+	reason, underPressure := cfg.Evaluate(memoryPSI, ioPSI)
+	if !underPressure {
+		return nil
+	}
+	return &api.ResourcePressure{
+		Memory: memoryPSI.SomeAvg10,
+		Io:     ioPSI.SomeAvg10,
+		Reason: reason,
+	}
+}
+
 func getWorkspaceResourceInfo(mountPoint, cgroupPath string) (*api.Resources, error) {
 	cpu, err := getCpuResourceInfoV2(mountPoint, cgroupPath)
 	if err != nil {
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const (
+	// workspaceVeth is the pod end of the veth pair iws sets up for the workspace, which carries the bandwidth limits
+	workspaceVeth = "veth0"
+
+	directionIngress = "ingress"
+	directionEgress  = "egress"
+)
+
+var (
+	// bandwidthTable is the nftables table nsinsider installs the bandwidth limits in
+	bandwidthTable = &nftables.Table{
+		Name:   "gitpod-bandwidth",
+		Family: nftables.TableFamilyINet,
+	}
+	// bandwidthCounters are the counters of the traffic which exceeded the limits by direction
+	bandwidthCounters = map[string]string{
+		directionIngress: "ws-ingress-throttled-stats",
+		directionEgress:  "ws-egress-throttled-stats",
+	}
+)
+
+// BandwidthMonitor exports the statistics of the bandwidth limits nsinsider installs on the workspace veth
+type BandwidthMonitor struct {
+	throttledBytes   *prometheus.GaugeVec
+	throttledPackets *prometheus.GaugeVec
+}
+
+func NewBandwidthMonitor(prom prometheus.Registerer) *BandwidthMonitor {
+	m := &BandwidthMonitor{
+		throttledBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_throttled_bytes",
+			Help: "Number of bytes dropped because they exceeded the bandwidth limit of a workspace",
+		}, []string{"node", "workspace", "direction"}),
+
+		throttledPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_throttled_packets",
+			Help: "Number of packets dropped because they exceeded the bandwidth limit of a workspace",
+		}, []string{"node", "workspace", "direction"}),
+	}
+
+	prom.MustRegister(
+		m.throttledBytes,
+		m.throttledPackets,
+	)
+
+	return m
+}
+
+func (m *BandwidthMonitor) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+
+	pid, err := disp.Runtime.ContainerPID(ctx, ws.ContainerID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	dispatch.GetDispatchWaitGroup(ctx).Add(1)
+	go func(*dispatch.Workspace) {
+		defer dispatch.GetDispatchWaitGroup(ctx).Done()
+
+		ticker := time.NewTicker(30 * time.Second)
+		defer ticker.Stop()
+
+		nodeName := os.Getenv("NODENAME")
+		defer func() {
+			for _, direction := range []string{directionIngress, directionEgress} {
+				m.throttledBytes.DeleteLabelValues(nodeName, ws.Pod.Name, direction)
+				m.throttledPackets.DeleteLabelValues(nodeName, ws.Pod.Name, direction)
+			}
+		}()
+
+		for {
+			select {
+			case <-ticker.C:
+				stats, err := getBandwidthStats(pid)
+				if errors.Is(err, errNoVeth) {
+					// the veth pair is set up once the workspace container runs
+					continue
+				}
+				if err != nil {
+					log.WithFields(ws.OWI()).WithError(err).Warn("could not get bandwidth stats")
+					continue
+				}
+				if len(stats) == 0 {
+					// the limits are installed together with the veth pair, hence this workspace has none
+					return
+				}
+
+				for direction, counter := range stats {
+					m.throttledBytes.WithLabelValues(nodeName, ws.Pod.Name, direction).Set(float64(counter.Bytes))
+					m.throttledPackets.WithLabelValues(nodeName, ws.Pod.Name, direction).Set(float64(counter.Packets))
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}(ws)
+
+	return nil
+}
+
+var errNoVeth = errors.New("workspace has no veth")
+
+// getBandwidthStats returns the counters of the traffic which exceeded the bandwidth limits of the workspace veth
+// in the network namespace of the process by direction. Directions without a limit have no counter.
+func getBandwidthStats(pid uint64) (map[string]*nftables.CounterObj, error) {
+	ns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get handle for network namespace: %w", err)
+	}
+	defer ns.Close()
+
+	handle, err := netlink.NewHandleAt(ns)
+	if err != nil {
+		return nil, fmt.Errorf("could not establish netlink connection: %w", err)
+	}
+	defer handle.Close()
+
+	_, err = handle.LinkByName(workspaceVeth)
+	if err != nil {
+		var notFound netlink.LinkNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, errNoVeth
+		}
+		return nil, fmt.Errorf("could not get %s: %w", workspaceVeth, err)
+	}
+
+	return getBandwidthCounters(ns)
+}
+
+// getBandwidthCounters reads the counters of the bandwidth table in the network namespace
+func getBandwidthCounters(ns netns.NsHandle) (map[string]*nftables.CounterObj, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	nftconn, err := nftables.New(nftables.WithNetNSFd(int(ns)))
+	if err != nil {
+		return nil, fmt.Errorf("could not establish netlink connection for nft: %w", err)
+	}
+
+	res := make(map[string]*nftables.CounterObj)
+	tables, err := nftconn.ListTablesOfFamily(bandwidthTable.Family)
+	if err != nil {
+		return nil, fmt.Errorf("could not list nft tables: %w", err)
+	}
+	if !slices.ContainsFunc(tables, func(t *nftables.Table) bool { return t.Name == bandwidthTable.Name }) {
+		return res, nil
+	}
+
+	objs, err := nftconn.GetObjects(bandwidthTable)
+	if err != nil {
+		return nil, fmt.Errorf("could not get bandwidth counters: %w", err)
+	}
+	for _, obj := range objs {
+		counter, ok := obj.(*nftables.CounterObj)
+		if !ok {
+			continue
+		}
+		for direction, name := range bandwidthCounters {
+			if counter.Name == name {
+				res[direction] = counter
+			}
+		}
+	}
+	return res, nil
+}
@@ -9,4 +9,49 @@ type Config struct {
 	Enforce              bool  `json:"enforce"`
 	ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 	BucketSize           int64 `json:"bucketSize"`
+
+	// Egress configures the egress network policies of workspaces
+	Egress EgressConfig `json:"egress"`
+}
+
+// EgressConfig configures which connections workspaces may open to destinations outside of the workspace pod
+type EgressConfig struct {
+	Enabled bool `json:"enabled"`
+	// Enforce drops connections a policy denies. Otherwise they are only counted.
+	Enforce bool `json:"enforce"`
+
+	// Policies are the egress policies by name
+	Policies map[string]EgressPolicy `json:"policies,omitempty"`
+	// WorkspaceClasses maps workspace classes to the name of the policy of their workspaces
+	WorkspaceClasses map[string]string `json:"workspaceClasses,omitempty"`
+	// Organizations maps organization IDs to the name of the policy of their workspaces.
+	// The policy of an organization takes precedence over the policy of the workspace class.
+	Organizations map[string]string `json:"organizations,omitempty"`
+}
+
+// EgressAction is the action of an egress rule
+type EgressAction string
+
+const (
+	EgressAllow EgressAction = "allow"
+	EgressDeny  EgressAction = "deny"
+)
+
+// EgressPolicy is a list of rules for new connections, of which the first matching one applies.
+// Note that DNS, Gitpod itself and package registries must be allowed explicitly by policies which deny by default.
+type EgressPolicy struct {
+	// Default is the action for connections which match no rule
+	Default EgressAction `json:"default"`
+	Rules   []EgressRule `json:"rules,omitempty"`
+}
+
+// EgressRule allows or denies connections to a set of destinations
+type EgressRule struct {
+	Action EgressAction `json:"action"`
+	// CIDRs are the IPv4 or IPv6 destination networks. The rule matches all destinations if empty.
+	CIDRs []string `json:"cidrs,omitempty"`
+	// Ports are destination ports or port ranges, e.g. 443 or 8000-8100. The rule matches all ports if empty.
+	Ports []string `json:"ports,omitempty"`
+	// Protocol is tcp or udp. The rule matches both if empty, and all protocols if it has no ports either.
+	Protocol string `json:"protocol,omitempty"`
 }
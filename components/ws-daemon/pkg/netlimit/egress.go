@@ -0,0 +1,288 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+)
+
+const (
+	// egressTable is the nftables table nsinsider installs egress policies in
+	egressTable = "gitpod-egress"
+	// egressDropStats counts the packets of connections egress policies denied
+	egressDropStats = "ws-egress-drop-stats"
+)
+
+// Validate ensures all policies are valid and all referenced policies exist
+func (c EgressConfig) Validate() error {
+	for name, p := range c.Policies {
+		if _, err := p.nsinsiderArgs(); err != nil {
+			return fmt.Errorf("invalid egress policy %s: %w", name, err)
+		}
+	}
+	for class, name := range c.WorkspaceClasses {
+		if _, ok := c.Policies[name]; !ok {
+			return fmt.Errorf("workspace class %s references unknown egress policy %s", class, name)
+		}
+	}
+	for org, name := range c.Organizations {
+		if _, ok := c.Policies[name]; !ok {
+			return fmt.Errorf("organization %s references unknown egress policy %s", org, name)
+		}
+	}
+	return nil
+}
+
+// policyFor returns the egress policy of the workspace pod, if any applies
+func (c EgressConfig) policyFor(pod *corev1.Pod) (name string, policy EgressPolicy, ok bool) {
+	name, ok = c.Organizations[pod.Labels[kubernetes.TeamLabel]]
+	if !ok {
+		name, ok = c.WorkspaceClasses[pod.Labels[kubernetes.WorkspaceClassLabel]]
+	}
+	if !ok {
+		return "", EgressPolicy{}, false
+	}
+	policy, ok = c.Policies[name]
+	return name, policy, ok
+}
+
+// nsinsiderArgs returns the arguments of nsinsider's setup-egress-policy command. Rules with several CIDRs, ports or
+// protocols are expanded into one rule per combination, which are passed as action,protocol,cidr,ports.
+func (p EgressPolicy) nsinsiderArgs() ([]string, error) {
+	if err := validateAction(p.Default); err != nil {
+		return nil, fmt.Errorf("invalid default action: %w", err)
+	}
+	args := []string{"--default", string(p.Default)}
+
+	for i, r := range p.Rules {
+		if err := validateAction(r.Action); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+
+		protocols := []string{r.Protocol}
+		switch {
+		case r.Protocol == "tcp" || r.Protocol == "udp":
+		case r.Protocol != "":
+			return nil, fmt.Errorf("rule %d: unsupported protocol %s", i, r.Protocol)
+		case len(r.Ports) > 0:
+			protocols = []string{"tcp", "udp"}
+		}
+
+		cidrs := []string{""}
+		if len(r.CIDRs) > 0 {
+			cidrs = make([]string, 0, len(r.CIDRs))
+			for _, c := range r.CIDRs {
+				_, ipnet, err := net.ParseCIDR(c)
+				if err != nil {
+					return nil, fmt.Errorf("rule %d: %w", i, err)
+				}
+				cidrs = append(cidrs, ipnet.String())
+			}
+		}
+
+		ports := []string{""}
+		if len(r.Ports) > 0 {
+			ports = make([]string, 0, len(r.Ports))
+			for _, pr := range r.Ports {
+				from, to, err := parsePortRange(pr)
+				if err != nil {
+					return nil, fmt.Errorf("rule %d: %w", i, err)
+				}
+				ports = append(ports, fmt.Sprintf("%d-%d", from, to))
+			}
+		}
+
+		for _, proto := range protocols {
+			for _, cidr := range cidrs {
+				for _, port := range ports {
+					args = append(args, "--rule", strings.Join([]string{string(r.Action), proto, cidr, port}, ","))
+				}
+			}
+		}
+	}
+	return args, nil
+}
+
+func validateAction(a EgressAction) error {
+	if a != EgressAllow && a != EgressDeny {
+		return fmt.Errorf("action must be %s or %s, not %q", EgressAllow, EgressDeny, a)
+	}
+	return nil
+}
+
+func parsePortRange(s string) (from, to uint16, err error) {
+	lo, hi, isRange := strings.Cut(s, "-")
+	if !isRange {
+		hi = lo
+	}
+	f, err := strconv.ParseUint(strings.TrimSpace(lo), 10, 16)
+	if err != nil || f == 0 {
+		return 0, 0, fmt.Errorf("invalid port %q", s)
+	}
+	t, err := strconv.ParseUint(strings.TrimSpace(hi), 10, 16)
+	if err != nil || t < f {
+		return 0, 0, fmt.Errorf("invalid port range %q", s)
+	}
+	return uint16(f), uint16(t), nil
+}
+
+// EgressLimiter installs the egress policy of workspaces in their network namespace
+type EgressLimiter struct {
+	mu             sync.RWMutex
+	limited        map[string]struct{}
+	droppedBytes   *prometheus.GaugeVec
+	droppedPackets *prometheus.GaugeVec
+	config         EgressConfig
+}
+
+func NewEgressLimiter(config EgressConfig, prom prometheus.Registerer) *EgressLimiter {
+	s := &EgressLimiter{
+		droppedBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_egress_dropped_bytes",
+			Help: "Number of bytes dropped due to the egress policy of a workspace",
+		}, []string{"node", "workspace", "policy"}),
+
+		droppedPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_egress_dropped_packets",
+			Help: "Number of packets dropped due to the egress policy of a workspace",
+		}, []string{"node", "workspace", "policy"}),
+		limited: map[string]struct{}{},
+		config:  config,
+	}
+
+	if config.Enabled {
+		prom.MustRegister(
+			s.droppedBytes,
+			s.droppedPackets,
+		)
+	}
+
+	return s
+}
+
+func (e *EgressLimiter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.limitWorkspace(ctx, ws)
+}
+
+func (e *EgressLimiter) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.limited[ws.InstanceID]; ok {
+		return nil
+	}
+
+	return e.limitWorkspace(ctx, ws)
+}
+
+func (e *EgressLimiter) limitWorkspace(ctx context.Context, ws *dispatch.Workspace) error {
+	name, policy, ok := e.config.policyFor(ws.Pod)
+	if !ok {
+		return nil
+	}
+	args, err := policy.nsinsiderArgs()
+	if err != nil {
+		return fmt.Errorf("invalid egress policy %s: %w", name, err)
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+
+	pid, err := disp.Runtime.ContainerPID(ctx, ws.ContainerID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	err = nsinsider.Nsinsider(ws.InstanceID, int(pid), func(cmd *exec.Cmd) {
+		cmd.Args = append(cmd.Args, "setup-egress-policy")
+		cmd.Args = append(cmd.Args, args...)
+		if e.config.Enforce {
+			cmd.Args = append(cmd.Args, "--enforce")
+		}
+	}, nsinsider.EnterMountNS(false), nsinsider.EnterNetNS(true))
+	if err != nil {
+		if errors.Is(context.Cause(ctx), context.Canceled) {
+			return nil
+		}
+		log.WithError(err).WithFields(ws.OWI()).WithField("policy", name).Error("cannot install egress policy")
+		return err
+	}
+	e.limited[ws.InstanceID] = struct{}{}
+	log.WithFields(ws.OWI()).WithField("policy", name).Info("installed egress policy")
+
+	dispatch.GetDispatchWaitGroup(ctx).Add(1)
+	go func(*dispatch.Workspace) {
+		defer dispatch.GetDispatchWaitGroup(ctx).Done()
+
+		ticker := time.NewTicker(30 * time.Second)
+		defer ticker.Stop()
+
+		nodeName := os.Getenv("NODENAME")
+		for {
+			select {
+			case <-ticker.C:
+				counter, err := getDropCounter(pid, &nftables.Table{Name: egressTable, Family: nftables.TableFamilyINet}, egressDropStats)
+				if err != nil {
+					log.WithFields(ws.OWI()).WithError(err).Warnf("could not get egress drop stats")
+					continue
+				}
+
+				e.droppedBytes.WithLabelValues(nodeName, ws.Pod.Name, name).Set(float64(counter.Bytes))
+				e.droppedPackets.WithLabelValues(nodeName, ws.Pod.Name, name).Set(float64(counter.Packets))
+
+			case <-ctx.Done():
+				e.droppedBytes.DeleteLabelValues(nodeName, ws.Pod.Name, name)
+				e.droppedPackets.DeleteLabelValues(nodeName, ws.Pod.Name, name)
+
+				e.mu.Lock()
+				delete(e.limited, ws.InstanceID)
+				e.mu.Unlock()
+				return
+			}
+		}
+	}(ws)
+
+	return nil
+}
+
+// Update changes the egress policies of workspaces which start afterwards
+func (e *EgressLimiter) Update(config EgressConfig) {
+	if err := config.Validate(); err != nil {
+		log.WithError(err).Error("invalid egress policies, keeping the previous ones")
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.config = config
+	log.WithField("policies", len(config.Policies)).Info("updating egress policies")
+}
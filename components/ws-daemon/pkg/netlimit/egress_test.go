@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+func TestEgressPolicyNsinsiderArgs(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Policy      EgressPolicy
+		Expectation []string
+		Error       bool
+	}{
+		{
+			Name:        "default only",
+			Policy:      EgressPolicy{Default: EgressAllow},
+			Expectation: []string{"--default", "allow"},
+		},
+		{
+			Name: "internal networks only",
+			Policy: EgressPolicy{
+				Default: EgressDeny,
+				Rules: []EgressRule{
+					{Action: EgressAllow, CIDRs: []string{"10.0.0.0/8", "fd00::/8"}},
+					{Action: EgressAllow, CIDRs: []string{"192.168.1.10/24"}, Protocol: "tcp", Ports: []string{"443", "8000-8080"}},
+				},
+			},
+			Expectation: []string{
+				"--default", "deny",
+				"--rule", "allow,,10.0.0.0/8,",
+				"--rule", "allow,,fd00::/8,",
+				"--rule", "allow,tcp,192.168.1.0/24,443-443",
+				"--rule", "allow,tcp,192.168.1.0/24,8000-8080",
+			},
+		},
+		{
+			Name: "ports without protocol",
+			Policy: EgressPolicy{
+				Default: EgressAllow,
+				Rules:   []EgressRule{{Action: EgressDeny, Ports: []string{"25"}}},
+			},
+			Expectation: []string{
+				"--default", "allow",
+				"--rule", "deny,tcp,,25-25",
+				"--rule", "deny,udp,,25-25",
+			},
+		},
+		{Name: "missing default", Policy: EgressPolicy{}, Error: true},
+		{Name: "unknown action", Policy: EgressPolicy{Default: EgressDeny, Rules: []EgressRule{{Action: "reject"}}}, Error: true},
+		{Name: "unsupported protocol", Policy: EgressPolicy{Default: EgressDeny, Rules: []EgressRule{{Action: EgressAllow, Protocol: "icmp"}}}, Error: true},
+		{Name: "invalid CIDR", Policy: EgressPolicy{Default: EgressDeny, Rules: []EgressRule{{Action: EgressAllow, CIDRs: []string{"10.0.0.0"}}}}, Error: true},
+		{Name: "port zero", Policy: EgressPolicy{Default: EgressDeny, Rules: []EgressRule{{Action: EgressAllow, Ports: []string{"0"}}}}, Error: true},
+		{Name: "reversed port range", Policy: EgressPolicy{Default: EgressDeny, Rules: []EgressRule{{Action: EgressAllow, Ports: []string{"90-80"}}}}, Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			args, err := test.Policy.nsinsiderArgs()
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, args); diff != "" {
+				t.Errorf("unexpected args (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEgressConfigValidate(t *testing.T) {
+	policies := map[string]EgressPolicy{"internal": {Default: EgressDeny}}
+	tests := []struct {
+		Name   string
+		Config EgressConfig
+		Valid  bool
+	}{
+		{Name: "empty", Config: EgressConfig{}, Valid: true},
+		{Name: "valid", Config: EgressConfig{Policies: policies, WorkspaceClasses: map[string]string{"locked": "internal"}, Organizations: map[string]string{"org": "internal"}}, Valid: true},
+		{Name: "invalid policy", Config: EgressConfig{Policies: map[string]EgressPolicy{"broken": {}}}},
+		{Name: "unknown class policy", Config: EgressConfig{Policies: policies, WorkspaceClasses: map[string]string{"locked": "external"}}},
+		{Name: "unknown org policy", Config: EgressConfig{Policies: policies, Organizations: map[string]string{"org": "external"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("expected valid=%v, got %v", test.Valid, err)
+			}
+		})
+	}
+}
+
+func TestEgressConfigPolicyFor(t *testing.T) {
+	cfg := EgressConfig{
+		Policies: map[string]EgressPolicy{
+			"internal":   {Default: EgressDeny},
+			"no-smtp":    {Default: EgressAllow},
+			"restricted": {Default: EgressDeny},
+		},
+		WorkspaceClasses: map[string]string{"locked": "internal", "default": "no-smtp"},
+		Organizations:    map[string]string{"security-team": "restricted"},
+	}
+	pod := func(class, team string) *corev1.Pod {
+		labels := map[string]string{kubernetes.WorkspaceClassLabel: class}
+		if team != "" {
+			labels[kubernetes.TeamLabel] = team
+		}
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: labels}}
+	}
+
+	tests := []struct {
+		Name        string
+		Pod         *corev1.Pod
+		Expectation string
+	}{
+		{Name: "class", Pod: pod("locked", "other-team"), Expectation: "internal"},
+		{Name: "organization overrides class", Pod: pod("locked", "security-team"), Expectation: "restricted"},
+		{Name: "organization without class", Pod: pod("", "security-team"), Expectation: "restricted"},
+		{Name: "no policy", Pod: pod("large", ""), Expectation: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			name, _, ok := cfg.policyFor(test.Pod)
+			if ok != (test.Expectation != "") || name != test.Expectation {
+				t.Errorf("expected policy %q, got %q (ok=%v)", test.Expectation, name, ok)
+			}
+		})
+	}
+}
@@ -88,6 +88,14 @@ func (c *ConnLimiter) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspa
 }
 
 func (n *ConnLimiter) GetConnectionDropCounter(pid uint64) (*nftables.CounterObj, error) {
+	return getDropCounter(pid, &nftables.Table{
+		Name:   "gitpod",
+		Family: nftables.TableFamilyIPv4,
+	}, "ws-connection-drop-stats")
+}
+
+// getDropCounter reads a named counter from the network namespace of the process
+func getDropCounter(pid uint64, table *nftables.Table, name string) (*nftables.CounterObj, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
@@ -95,24 +103,20 @@ func (n *ConnLimiter) GetConnectionDropCounter(pid uint64) (*nftables.CounterObj
 	if err != nil {
 		return nil, fmt.Errorf("could not get handle for network namespace: %w", err)
 	}
+	defer netns.Close()
 
 	nftconn, err := nftables.New(nftables.WithNetNSFd(int(netns)))
 	if err != nil {
 		return nil, fmt.Errorf("could not establish netlink connection for nft: %w", err)
 	}
 
-	gitpodTable := &nftables.Table{
-		Name:   "gitpod",
-		Family: nftables.TableFamilyIPv4,
-	}
-
 	counterObject, err := nftconn.GetObject(&nftables.CounterObj{
-		Table: gitpodTable,
-		Name:  "ws-connection-drop-stats",
+		Table: table,
+		Name:  name,
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("could not get connection drop stats: %w", err)
+		return nil, fmt.Errorf("could not get %s: %w", name, err)
 	}
 
 	dropCounter, ok := counterObject.(*nftables.CounterObj)
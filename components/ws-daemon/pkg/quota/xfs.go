@@ -139,6 +139,14 @@ func (xfs *XFS) SetQuotaWithPrjId(path string, quota Size, prjID int, isHard boo
 	return prjID, nil
 }
 
+// SetHardLimit changes the hard limit of an existing project, e.g. to grow the quota of a running workspace.
+// The soft limit is raised alongside, as workspaces get a soft limit while their content is initialized
+// which would otherwise keep enforcing the previous size once its grace period expired.
+func (xfs *XFS) SetHardLimit(projectID int, quota Size) error {
+	_, err := xfs.exec(xfs.Dir, fmt.Sprintf("limit -p bsoft=%d bhard=%d %d", quota, quota, projectID))
+	return err
+}
+
 // RegisterProject tells this implementation that a projectID is already in use
 func (xfs *XFS) RegisterProject(prjID int) {
 	xfs.mu.Lock()
@@ -191,3 +191,23 @@ func TestSetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestSetHardLimit(t *testing.T) {
+	var execs []string
+	xfs := &XFS{
+		exec: func(dir, command string) (output string, err error) {
+			execs = append(execs, command)
+			return "", nil
+		},
+		projectIDs: map[int]struct{}{1000: {}},
+		Dir:        "/",
+	}
+
+	err := xfs.SetHardLimit(1000, 200*Kilobyte)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"limit -p bsoft=204800 bhard=204800 1000"}, execs); diff != "" {
+		t.Errorf("unexpected SetHardLimit (-want +got):\n%s", diff)
+	}
+}
@@ -147,6 +147,18 @@ type Configuration struct {
 	PodRecreationMaxRetries int `json:"podRecreationMaxRetries,omitempty"`
 	// PodRecreationBackoff
 	PodRecreationBackoff util.Duration `json:"podRecreationBackoff,omitempty"`
+
+	// ResourcePressureEviction enables the eviction of the workspace which suffers most from memory pressure
+	// if several workspaces on a node are short of memory, before the kernel starts killing processes at random.
+	ResourcePressureEviction *ResourcePressureEvictionConfiguration `json:"resourcePressureEviction,omitempty"`
+}
+
+// ResourcePressureEvictionConfiguration configures when workspaces are evicted to relieve memory pressure on their node
+type ResourcePressureEvictionConfiguration struct {
+	// MinWorkspaces is the number of workspaces on a node which must be under memory pressure before one of them is evicted
+	MinWorkspaces int `json:"minWorkspaces"`
+	// GracePeriod is the time a workspace must be under memory pressure before it is considered for eviction
+	GracePeriod util.Duration `json:"gracePeriod"`
 }
 
 type WorkspaceClass struct {
@@ -253,6 +265,15 @@ func (c *Configuration) Validate() error {
 		return err
 	}
 
+	if c.ResourcePressureEviction != nil {
+		err = ozzo.ValidateStruct(c.ResourcePressureEviction,
+			ozzo.Field(&c.ResourcePressureEviction.MinWorkspaces, ozzo.Required, ozzo.Min(1)),
+		)
+		if err != nil {
+			return xerrors.Errorf("resourcePressureEviction: %w", err)
+		}
+	}
+
 	if _, ok := c.WorkspaceClasses[DefaultWorkspaceClass]; !ok {
 		return xerrors.Errorf("missing default workspace class (\"%s\")", DefaultWorkspaceClass)
 	}
@@ -393,6 +414,19 @@ var validResourceLimitConfig = ozzo.By(func(o interface{}) error {
 			return xerrors.Errorf("cannot parse Storage quantity: %w", err)
 		}
 	}
+	if rc.MaxStorage != "" {
+		maxStorage, err := resource.ParseQuantity(rc.MaxStorage)
+		if err != nil {
+			return xerrors.Errorf("cannot parse MaxStorage quantity: %w", err)
+		}
+		storage, _ := rc.StorageQuantity()
+		if maxStorage.Cmp(storage) < 0 {
+			return xerrors.Errorf("MaxStorage must not be smaller than Storage")
+		}
+	}
+	if _, _, err := rc.Bandwidth.BytesPerSecond(); err != nil {
+		return err
+	}
 	return nil
 })
 
@@ -525,6 +559,9 @@ type ResourceLimitConfiguration struct {
 	Memory           string            `json:"memory"`
 	EphemeralStorage string            `json:"ephemeral-storage"`
 	Storage          string            `json:"storage,omitempty"`
+	// MaxStorage is the size the disk of a running workspace can be resized to. If empty, the disk cannot be resized.
+	MaxStorage string          `json:"maxStorage,omitempty"`
+	Bandwidth  *BandwidthLimit `json:"bandwidth,omitempty"`
 }
 
 func (r *ResourceLimitConfiguration) ResourceList() (corev1.ResourceList, error) {
@@ -567,11 +604,58 @@ func (r *ResourceLimitConfiguration) StorageQuantity() (resource.Quantity, error
 	return resource.ParseQuantity(r.Storage)
 }
 
+// MaxStorageQuantity returns the size the disk of a running workspace can be resized to. Zero means the disk cannot be resized.
+func (r *ResourceLimitConfiguration) MaxStorageQuantity() (resource.Quantity, error) {
+	if r == nil || r.MaxStorage == "" {
+		res := resource.NewQuantity(0, resource.BinarySI)
+		return *res, nil
+	}
+	return resource.ParseQuantity(r.MaxStorage)
+}
+
 type CpuResourceLimit struct {
 	MinLimit   string `json:"min"`
 	BurstLimit string `json:"burst"`
 }
 
+// BandwidthLimit limits the network bandwidth of a workspace. The limits are quantities
+// in bytes per second, e.g. 50Mi. An empty limit means unlimited.
+type BandwidthLimit struct {
+	// Ingress limits the traffic the workspace receives
+	Ingress string `json:"ingress,omitempty"`
+	// Egress limits the traffic the workspace sends
+	Egress string `json:"egress,omitempty"`
+}
+
+// BytesPerSecond returns the ingress and egress limit in bytes per second, where zero means unlimited
+func (b *BandwidthLimit) BytesPerSecond() (ingress, egress int64, err error) {
+	if b == nil {
+		return 0, 0, nil
+	}
+	parse := func(name, v string) (int64, error) {
+		if v == "" {
+			return 0, nil
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse %s bandwidth quantity: %w", name, err)
+		}
+		if q.Sign() < 0 {
+			return 0, xerrors.Errorf("%s bandwidth must not be negative", name)
+		}
+		return q.Value(), nil
+	}
+	ingress, err = parse("ingress", b.Ingress)
+	if err != nil {
+		return 0, 0, err
+	}
+	egress, err = parse("egress", b.Egress)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ingress, egress, nil
+}
+
 type MaintenanceConfig struct {
 	EnabledUntil *time.Time `json:"enabledUntil"`
 }
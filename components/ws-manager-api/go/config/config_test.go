@@ -65,6 +65,59 @@ func TestValidate(t *testing.T) {
 			}),
 			Expectation: `workspace class name "not/a/valid/name" is invalid: [a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
 		},
+		{
+			Name: "invalid bandwidth limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:       &CpuResourceLimit{},
+					Bandwidth: &BandwidthLimit{Ingress: "50Mi", Egress: "fast"},
+				}
+			}),
+			Expectation: `workspace class g1-standard: limits: cannot parse egress bandwidth quantity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.`,
+		},
+		{
+			Name: "valid bandwidth limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:       &CpuResourceLimit{},
+					Bandwidth: &BandwidthLimit{Ingress: "50Mi"},
+				}
+			}),
+		},
+		{
+			Name: "max storage smaller than storage",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:        &CpuResourceLimit{},
+					Storage:    "30Gi",
+					MaxStorage: "20Gi",
+				}
+			}),
+			Expectation: `workspace class g1-standard: limits: MaxStorage must not be smaller than Storage.`,
+		},
+		{
+			Name: "valid max storage",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:        &CpuResourceLimit{},
+					Storage:    "30Gi",
+					MaxStorage: "100Gi",
+				}
+			}),
+		},
+		{
+			Name: "invalid resource pressure eviction",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.ResourcePressureEviction = &ResourcePressureEvictionConfiguration{}
+			}),
+			Expectation: "resourcePressureEviction: minWorkspaces: cannot be blank.",
+		},
+		{
+			Name: "valid resource pressure eviction",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.ResourcePressureEviction = &ResourcePressureEvictionConfiguration{MinWorkspaces: 2, GracePeriod: util.Duration(time.Minute)}
+			}),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -81,3 +134,37 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestBandwidthLimitBytesPerSecond(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Limit   *BandwidthLimit
+		Ingress int64
+		Egress  int64
+		Error   string
+	}{
+		{Name: "no limit"},
+		{Name: "empty limit", Limit: &BandwidthLimit{}},
+		{Name: "binary units", Limit: &BandwidthLimit{Ingress: "50Mi", Egress: "1Gi"}, Ingress: 50 * 1024 * 1024, Egress: 1024 * 1024 * 1024},
+		{Name: "decimal units", Limit: &BandwidthLimit{Egress: "12.5M"}, Egress: 12500000},
+		{Name: "beyond 4 GiB/s", Limit: &BandwidthLimit{Ingress: "10Gi"}, Ingress: 10 * 1024 * 1024 * 1024},
+		{Name: "negative", Limit: &BandwidthLimit{Ingress: "-1Mi"}, Error: "ingress bandwidth must not be negative"},
+		{Name: "invalid", Limit: &BandwidthLimit{Egress: "fast"}, Error: "cannot parse egress bandwidth quantity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ingress, egress, err := test.Limit.BytesPerSecond()
+
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != test.Error {
+				t.Fatalf("unexpected error: expect \"%s\", got \"%s\"", test.Error, errMsg)
+			}
+			if ingress != test.Ingress || egress != test.Egress {
+				t.Errorf("unexpected limits: expect %d/%d, got %d/%d", test.Ingress, test.Egress, ingress, egress)
+			}
+		})
+	}
+}
@@ -54,6 +54,8 @@ type WorkspaceManagerClient interface {
 	UpdateSSHKey(ctx context.Context, in *UpdateSSHKeyRequest, opts ...grpc.CallOption) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
+	// resizeWorkspaceDisk grows the disk quota of a running workspace up to the maximum of its workspace class
+	ResizeWorkspaceDisk(ctx context.Context, in *ResizeWorkspaceDiskRequest, opts ...grpc.CallOption) (*ResizeWorkspaceDiskResponse, error)
 }
 
 type workspaceManagerClient struct {
@@ -213,6 +215,15 @@ func (c *workspaceManagerClient) DescribeCluster(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *workspaceManagerClient) ResizeWorkspaceDisk(ctx context.Context, in *ResizeWorkspaceDiskRequest, opts ...grpc.CallOption) (*ResizeWorkspaceDiskResponse, error) {
+	out := new(ResizeWorkspaceDiskResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/ResizeWorkspaceDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceManagerServer is the server API for WorkspaceManager service.
 // All implementations must embed UnimplementedWorkspaceManagerServer
 // for forward compatibility
@@ -245,6 +256,8 @@ type WorkspaceManagerServer interface {
 	UpdateSSHKey(context.Context, *UpdateSSHKeyRequest) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	// resizeWorkspaceDisk grows the disk quota of a running workspace up to the maximum of its workspace class
+	ResizeWorkspaceDisk(context.Context, *ResizeWorkspaceDiskRequest) (*ResizeWorkspaceDiskResponse, error)
 	mustEmbedUnimplementedWorkspaceManagerServer()
 }
 
@@ -294,6 +307,9 @@ func (UnimplementedWorkspaceManagerServer) UpdateSSHKey(context.Context, *Update
 func (UnimplementedWorkspaceManagerServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
+func (UnimplementedWorkspaceManagerServer) ResizeWorkspaceDisk(context.Context, *ResizeWorkspaceDiskRequest) (*ResizeWorkspaceDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeWorkspaceDisk not implemented")
+}
 func (UnimplementedWorkspaceManagerServer) mustEmbedUnimplementedWorkspaceManagerServer() {}
 
 // UnsafeWorkspaceManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -562,6 +578,24 @@ func _WorkspaceManager_DescribeCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceManager_ResizeWorkspaceDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeWorkspaceDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).ResizeWorkspaceDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/ResizeWorkspaceDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).ResizeWorkspaceDisk(ctx, req.(*ResizeWorkspaceDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceManager_ServiceDesc is the grpc.ServiceDesc for WorkspaceManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -621,6 +655,10 @@ var WorkspaceManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCluster",
 			Handler:    _WorkspaceManager_DescribeCluster_Handler,
 		},
+		{
+			MethodName: "ResizeWorkspaceDisk",
+			Handler:    _WorkspaceManager_ResizeWorkspaceDisk_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
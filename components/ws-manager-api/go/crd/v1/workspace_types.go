@@ -22,6 +22,16 @@ const (
 	// ReasonInitializationFailure is a Reason for the WorkspaceConditionContentReady condition,
 	// indicating that content init failed. The condition's message will contain the failure details.
 	ReasonInitializationFailure = "InitializationFailure"
+
+	// ReasonMemoryPressure is a Reason for the WorkspaceConditionResourcePressure condition,
+	// indicating that workspace processes stall because the workspace is short of memory.
+	ReasonMemoryPressure = "MemoryPressure"
+	// ReasonIOPressure is a Reason for the WorkspaceConditionResourcePressure condition,
+	// indicating that workspace processes stall waiting for IO.
+	ReasonIOPressure = "IOPressure"
+	// ReasonNoPressure is a Reason for the WorkspaceConditionResourcePressure condition,
+	// indicating that the workspace recovered from resource pressure.
+	ReasonNoPressure = "NoPressure"
 )
 
 // WorkspaceSpec defines the desired state of Workspace
@@ -63,6 +73,11 @@ type WorkspaceSpec struct {
 	// the XFS quota to enforce on the workspace's /workspace folder
 	StorageQuota int `json:"storageQuota,omitempty"`
 
+	// the bandwidth in bytes per second the workspace may receive, zero means unlimited
+	IngressBandwidth int64 `json:"ingressBandwidth,omitempty"`
+	// the bandwidth in bytes per second the workspace may send, zero means unlimited
+	EgressBandwidth int64 `json:"egressBandwidth,omitempty"`
+
 	SSHGatewayCAPublicKey string `json:"sshGatewayCAPublicKey,omitempty"`
 }
 
@@ -123,20 +138,27 @@ type AdmissionSpec struct {
 	Level AdmissionLevel `json:"level"`
 }
 
-// +kubebuilder:validation:Enum=Owner;Everyone
+// +kubebuilder:validation:Enum=Owner;Everyone;Organization
 type AdmissionLevel string
 
 const (
 	AdmissionLevelOwner    AdmissionLevel = "Owner"
 	AdmissionLevelEveryone AdmissionLevel = "Everyone"
+	// AdmissionLevelOrganization admits every member of the organization which owns the workspace.
+	// It's only supported for ports.
+	AdmissionLevelOrganization AdmissionLevel = "Organization"
 )
 
-// +kubebuilder:validation:Enum=Http;Https
+// +kubebuilder:validation:Enum=Http;Https;Tcp;Udp
 type PortProtocol string
 
 const (
 	PortProtocolHttp  PortProtocol = "Http"
 	PortProtocolHttps PortProtocol = "Https"
+	// PortProtocolTcp exposes the port as raw TCP stream through the ws-proxy port tunnel
+	PortProtocolTcp PortProtocol = "Tcp"
+	// PortProtocolUdp exposes the port as UDP through the ws-proxy port tunnel
+	PortProtocolUdp PortProtocol = "Udp"
 )
 
 type PortSpec struct {
@@ -150,6 +172,10 @@ type PortSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:default=Http
 	Protocol PortProtocol `json:"protocol"`
+
+	// ShareID identifies the share links which grant access to this port. Changing it revokes all links issued before.
+	// +kubebuilder:validation:Optional
+	ShareID string `json:"shareID,omitempty"`
 }
 
 func (ps PortSpec) Equal(other PortSpec) bool {
@@ -165,6 +191,10 @@ func (ps PortSpec) Equal(other PortSpec) bool {
 		return false
 	}
 
+	if ps.ShareID != other.ShareID {
+		return false
+	}
+
 	return true
 }
 
@@ -204,6 +234,10 @@ type InitializerMetrics struct {
 	// Composite contains metrics for the composite initializer step
 	// +kubebuilder:validation:Optional
 	Composite *InitializerStepMetric `json:"composite"`
+
+	// GitLFS contains metrics for fetching the Git LFS objects after the clone
+	// +kubebuilder:validation:Optional
+	GitLFS *InitializerStepMetric `json:"gitLfs"`
 }
 
 type InitializerStepMetric struct {
@@ -212,6 +246,10 @@ type InitializerStepMetric struct {
 
 	// +kubebuilder:validation:Optional
 	Size uint64 `json:"size"`
+
+	// BytesFetched is the amount of data fetched from a remote, e.g. a Git repository
+	// +kubebuilder:validation:Optional
+	BytesFetched uint64 `json:"bytesFetched,omitempty"`
 }
 
 // WorkspaceStatus defines the observed state of Workspace
@@ -253,6 +291,22 @@ type WorkspaceStatus struct {
 
 	// +kubebuilder:validation:Optional
 	InitializerMetrics *InitializerMetrics `json:"initializerMetrics,omitempty"`
+
+	// ResourcePressure is the resource pressure ws-daemon last observed while the workspace was under pressure
+	// +kubebuilder:validation:Optional
+	ResourcePressure *ResourcePressure `json:"resourcePressure,omitempty"`
+
+	// StorageQuota is the XFS quota ws-daemon enforces on the workspace's /workspace folder. ws-daemon grows
+	// the quota of a running workspace when the spec asks for a larger one.
+	// +kubebuilder:validation:Optional
+	StorageQuota int `json:"storageQuota,omitempty"`
+}
+
+type ResourcePressure struct {
+	// Memory is the share of time in percent some workspace processes stalled on memory during the last ten seconds
+	Memory int32 `json:"memory"`
+	// IO is the share of time in percent some workspace processes stalled on IO during the last ten seconds
+	IO int32 `json:"io"`
 }
 
 func (s *WorkspaceStatus) SetCondition(cond metav1.Condition) {
@@ -265,7 +319,7 @@ type StorageStatus struct {
 	MountPath      string `json:"mountPath"`
 }
 
-// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted
+// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted;ResourcePressure;Evicted
 type WorkspaceCondition string
 
 const (
@@ -332,6 +386,13 @@ const (
 
 	// WorkspaceConditionForceKilledTask is true if we send a SIGKILL to the task
 	WorkspaceConditionForceKilledTask WorkspaceCondition = "ForceKilledTask"
+
+	// WorkspaceConditionResourcePressure is true while ws-daemon observes workspace processes stalling on memory or IO.
+	// The condition's reason names the resource under pressure.
+	WorkspaceConditionResourcePressure WorkspaceCondition = "ResourcePressure"
+
+	// WorkspaceConditionEvicted is true if the workspace was stopped to relieve memory pressure on its node
+	WorkspaceConditionEvicted WorkspaceCondition = "Evicted"
 )
 
 func NewWorkspaceConditionDeployed() metav1.Condition {
@@ -494,6 +555,26 @@ func NewWorkspaceConditionForceKilledTask() metav1.Condition {
 	}
 }
 
+func NewWorkspaceConditionResourcePressure(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionResourcePressure),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionEvicted(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionEvicted),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "ResourcePressure",
+		Message:            message,
+	}
+}
+
 // +kubebuilder:validation:Enum:=Unknown;Pending;Imagebuild;Creating;Initializing;Running;Stopping;Stopped
 type WorkspacePhase string
 
@@ -124,6 +124,21 @@ func (in *PortSpec) DeepCopy() *PortSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePressure) DeepCopyInto(out *ResourcePressure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePressure.
+func (in *ResourcePressure) DeepCopy() *ResourcePressure {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePressure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Snapshot) DeepCopyInto(out *Snapshot) {
 	*out = *in
@@ -475,6 +490,11 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 		*out = new(WorkspaceImageInfo)
 		**out = **in
 	}
+	if in.ResourcePressure != nil {
+		in, out := &in.ResourcePressure, &out.ResourcePressure
+		*out = new(ResourcePressure)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
@@ -280,6 +280,21 @@ func (mr *MockWorkspaceManagerServerMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).MarkActive), arg0, arg1)
 }
 
+// ResizeWorkspaceDisk mocks base method.
+func (m *MockWorkspaceManagerServer) ResizeWorkspaceDisk(arg0 context.Context, arg1 *api.ResizeWorkspaceDiskRequest) (*api.ResizeWorkspaceDiskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeWorkspaceDisk", arg0, arg1)
+	ret0, _ := ret[0].(*api.ResizeWorkspaceDiskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeWorkspaceDisk indicates an expected call of ResizeWorkspaceDisk.
+func (mr *MockWorkspaceManagerServerMockRecorder) ResizeWorkspaceDisk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeWorkspaceDisk", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).ResizeWorkspaceDisk), arg0, arg1)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerServer) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -687,6 +702,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MarkActive), varargs...)
 }
 
+// ResizeWorkspaceDisk mocks base method.
+func (m *MockWorkspaceManagerClient) ResizeWorkspaceDisk(arg0 context.Context, arg1 *api.ResizeWorkspaceDiskRequest, arg2 ...grpc.CallOption) (*api.ResizeWorkspaceDiskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResizeWorkspaceDisk", varargs...)
+	ret0, _ := ret[0].(*api.ResizeWorkspaceDiskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeWorkspaceDisk indicates an expected call of ResizeWorkspaceDisk.
+func (mr *MockWorkspaceManagerClientMockRecorder) ResizeWorkspaceDisk(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeWorkspaceDisk", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).ResizeWorkspaceDisk), varargs...)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerClient) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest, arg2 ...grpc.CallOption) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ interface IWorkspaceManagerService extends grpc.ServiceDefinition<grpc.UntypedSe
     deleteVolumeSnapshot: IWorkspaceManagerService_IDeleteVolumeSnapshot;
     updateSSHKey: IWorkspaceManagerService_IUpdateSSHKey;
     describeCluster: IWorkspaceManagerService_IDescribeCluster;
+    resizeWorkspaceDisk: IWorkspaceManagerService_IResizeWorkspaceDisk;
 }
 
 interface IWorkspaceManagerService_IGetWorkspaces extends grpc.MethodDefinition<core_pb.GetWorkspacesRequest, core_pb.GetWorkspacesResponse> {
@@ -159,6 +160,15 @@ interface IWorkspaceManagerService_IDescribeCluster extends grpc.MethodDefinitio
     responseSerialize: grpc.serialize<core_pb.DescribeClusterResponse>;
     responseDeserialize: grpc.deserialize<core_pb.DescribeClusterResponse>;
 }
+interface IWorkspaceManagerService_IResizeWorkspaceDisk extends grpc.MethodDefinition<core_pb.ResizeWorkspaceDiskRequest, core_pb.ResizeWorkspaceDiskResponse> {
+    path: "/wsman.WorkspaceManager/ResizeWorkspaceDisk";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.ResizeWorkspaceDiskRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.ResizeWorkspaceDiskRequest>;
+    responseSerialize: grpc.serialize<core_pb.ResizeWorkspaceDiskResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.ResizeWorkspaceDiskResponse>;
+}
 
 export const WorkspaceManagerService: IWorkspaceManagerService;
 
@@ -177,6 +187,7 @@ export interface IWorkspaceManagerServer extends grpc.UntypedServiceImplementati
     deleteVolumeSnapshot: grpc.handleUnaryCall<core_pb.DeleteVolumeSnapshotRequest, core_pb.DeleteVolumeSnapshotResponse>;
     updateSSHKey: grpc.handleUnaryCall<core_pb.UpdateSSHKeyRequest, core_pb.UpdateSSHKeyResponse>;
     describeCluster: grpc.handleUnaryCall<core_pb.DescribeClusterRequest, core_pb.DescribeClusterResponse>;
+    resizeWorkspaceDisk: grpc.handleUnaryCall<core_pb.ResizeWorkspaceDiskRequest, core_pb.ResizeWorkspaceDiskResponse>;
 }
 
 export interface IWorkspaceManagerClient {
@@ -221,6 +232,9 @@ export interface IWorkspaceManagerClient {
     describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceManagerClient {
@@ -266,4 +280,7 @@ export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceMan
     public describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspaceDisk(request: core_pb.ResizeWorkspaceDiskRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceDiskResponse) => void): grpc.ClientUnaryCall;
 }
@@ -189,6 +189,28 @@ function deserialize_wsman_MarkActiveResponse(buffer_arg) {
   return core_pb.MarkActiveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_ResizeWorkspaceDiskRequest(arg) {
+  if (!(arg instanceof core_pb.ResizeWorkspaceDiskRequest)) {
+    throw new Error('Expected argument of type wsman.ResizeWorkspaceDiskRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ResizeWorkspaceDiskRequest(buffer_arg) {
+  return core_pb.ResizeWorkspaceDiskRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ResizeWorkspaceDiskResponse(arg) {
+  if (!(arg instanceof core_pb.ResizeWorkspaceDiskResponse)) {
+    throw new Error('Expected argument of type wsman.ResizeWorkspaceDiskResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ResizeWorkspaceDiskResponse(buffer_arg) {
+  return core_pb.ResizeWorkspaceDiskResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SetTimeoutRequest(arg) {
   if (!(arg instanceof core_pb.SetTimeoutRequest)) {
     throw new Error('Expected argument of type wsman.SetTimeoutRequest');
@@ -491,6 +513,18 @@ describeCluster: {
     responseSerialize: serialize_wsman_DescribeClusterResponse,
     responseDeserialize: deserialize_wsman_DescribeClusterResponse,
   },
+  // resizeWorkspaceDisk grows the disk quota of a running workspace up to the maximum of its workspace class
+resizeWorkspaceDisk: {
+    path: '/wsman.WorkspaceManager/ResizeWorkspaceDisk',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.ResizeWorkspaceDiskRequest,
+    responseType: core_pb.ResizeWorkspaceDiskResponse,
+    requestSerialize: serialize_wsman_ResizeWorkspaceDiskRequest,
+    requestDeserialize: deserialize_wsman_ResizeWorkspaceDiskRequest,
+    responseSerialize: serialize_wsman_ResizeWorkspaceDiskResponse,
+    responseDeserialize: deserialize_wsman_ResizeWorkspaceDiskResponse,
+  },
 };
 
 exports.WorkspaceManagerClient = grpc.makeGenericClientConstructor(WorkspaceManagerService);
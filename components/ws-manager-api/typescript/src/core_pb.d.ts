@@ -632,6 +632,46 @@ export namespace UpdateSSHKeyResponse {
     }
 }
 
+export class ResizeWorkspaceDiskRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): ResizeWorkspaceDiskRequest;
+    getSize(): string;
+    setSize(value: string): ResizeWorkspaceDiskRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResizeWorkspaceDiskRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ResizeWorkspaceDiskRequest): ResizeWorkspaceDiskRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResizeWorkspaceDiskRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResizeWorkspaceDiskRequest;
+    static deserializeBinaryFromReader(message: ResizeWorkspaceDiskRequest, reader: jspb.BinaryReader): ResizeWorkspaceDiskRequest;
+}
+
+export namespace ResizeWorkspaceDiskRequest {
+    export type AsObject = {
+        id: string,
+        size: string,
+    }
+}
+
+export class ResizeWorkspaceDiskResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResizeWorkspaceDiskResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ResizeWorkspaceDiskResponse): ResizeWorkspaceDiskResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResizeWorkspaceDiskResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResizeWorkspaceDiskResponse;
+    static deserializeBinaryFromReader(message: ResizeWorkspaceDiskResponse, reader: jspb.BinaryReader): ResizeWorkspaceDiskResponse;
+}
+
+export namespace ResizeWorkspaceDiskResponse {
+    export type AsObject = {
+    }
+}
+
 export class WorkspaceStatus extends jspb.Message {
     getId(): string;
     setId(value: string): WorkspaceStatus;
@@ -789,6 +829,8 @@ export class PortSpec extends jspb.Message {
     setUrl(value: string): PortSpec;
     getProtocol(): PortProtocol;
     setProtocol(value: PortProtocol): PortSpec;
+    getShareId(): string;
+    setShareId(value: string): PortSpec;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): PortSpec.AsObject;
@@ -806,6 +848,7 @@ export namespace PortSpec {
         visibility: PortVisibility,
         url: string,
         protocol: PortProtocol,
+        shareId: string,
     }
 }
 
@@ -1326,6 +1369,8 @@ export class InitializerMetric extends jspb.Message {
     setDuration(value?: google_protobuf_duration_pb.Duration): InitializerMetric;
     getSize(): number;
     setSize(value: number): InitializerMetric;
+    getBytesFetched(): number;
+    setBytesFetched(value: number): InitializerMetric;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): InitializerMetric.AsObject;
@@ -1341,6 +1386,7 @@ export namespace InitializerMetric {
     export type AsObject = {
         duration?: google_protobuf_duration_pb.Duration.AsObject,
         size: number,
+        bytesFetched: number,
     }
 }
 
@@ -1376,6 +1422,11 @@ export class InitializerMetrics extends jspb.Message {
     getComposite(): InitializerMetric | undefined;
     setComposite(value?: InitializerMetric): InitializerMetrics;
 
+    hasGitLfs(): boolean;
+    clearGitLfs(): void;
+    getGitLfs(): InitializerMetric | undefined;
+    setGitLfs(value?: InitializerMetric): InitializerMetrics;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): InitializerMetrics.AsObject;
     static toObject(includeInstance: boolean, msg: InitializerMetrics): InitializerMetrics.AsObject;
@@ -1394,6 +1445,7 @@ export namespace InitializerMetrics {
         backup?: InitializerMetric.AsObject,
         prebuild?: InitializerMetric.AsObject,
         composite?: InitializerMetric.AsObject,
+        gitLfs?: InitializerMetric.AsObject,
     }
 }
 
@@ -1416,11 +1468,14 @@ export enum AdmissionLevel {
 export enum PortVisibility {
     PORT_VISIBILITY_PRIVATE = 0,
     PORT_VISIBILITY_PUBLIC = 1,
+    PORT_VISIBILITY_ORGANIZATION = 2,
 }
 
 export enum PortProtocol {
     PORT_PROTOCOL_HTTP = 0,
     PORT_PROTOCOL_HTTPS = 1,
+    PORT_PROTOCOL_TCP = 2,
+    PORT_PROTOCOL_UDP = 3,
 }
 
 export enum WorkspaceConditionBool {
@@ -55,6 +55,8 @@ goog.exportSymbol('proto.wsman.MetadataFilter', null, global);
 goog.exportSymbol('proto.wsman.PortProtocol', null, global);
 goog.exportSymbol('proto.wsman.PortSpec', null, global);
 goog.exportSymbol('proto.wsman.PortVisibility', null, global);
+goog.exportSymbol('proto.wsman.ResizeWorkspaceDiskRequest', null, global);
+goog.exportSymbol('proto.wsman.ResizeWorkspaceDiskResponse', null, global);
 goog.exportSymbol('proto.wsman.SSHPublicKeys', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutRequest', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutResponse', null, global);
@@ -652,6 +654,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.UpdateSSHKeyResponse.displayName = 'proto.wsman.UpdateSSHKeyResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ResizeWorkspaceDiskRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ResizeWorkspaceDiskRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ResizeWorkspaceDiskRequest.displayName = 'proto.wsman.ResizeWorkspaceDiskRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ResizeWorkspaceDiskResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ResizeWorkspaceDiskResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ResizeWorkspaceDiskResponse.displayName = 'proto.wsman.ResizeWorkspaceDiskResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -5322,6 +5366,267 @@ proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter = function(message, wri
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ResizeWorkspaceDiskRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ResizeWorkspaceDiskRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ResizeWorkspaceDiskRequest}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ResizeWorkspaceDiskRequest;
+  return proto.wsman.ResizeWorkspaceDiskRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ResizeWorkspaceDiskRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ResizeWorkspaceDiskRequest}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ResizeWorkspaceDiskRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ResizeWorkspaceDiskRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ResizeWorkspaceDiskRequest} returns this
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string size = 2;
+ * @return {string}
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.getSize = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ResizeWorkspaceDiskRequest} returns this
+ */
+proto.wsman.ResizeWorkspaceDiskRequest.prototype.setSize = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ResizeWorkspaceDiskResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ResizeWorkspaceDiskResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ResizeWorkspaceDiskResponse}
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ResizeWorkspaceDiskResponse;
+  return proto.wsman.ResizeWorkspaceDiskResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ResizeWorkspaceDiskResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ResizeWorkspaceDiskResponse}
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ResizeWorkspaceDiskResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ResizeWorkspaceDiskResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceDiskResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -6561,7 +6866,8 @@ proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
     port: jspb.Message.getFieldWithDefault(msg, 1, 0),
     visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
     url: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    shareId: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -6614,6 +6920,10 @@ proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
       msg.setProtocol(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShareId(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6671,6 +6981,13 @@ proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getShareId();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -6746,6 +7063,24 @@ proto.wsman.PortSpec.prototype.setProtocol = function(value) {
 };
 
 
+/**
+ * optional string share_id = 6;
+ * @return {string}
+ */
+proto.wsman.PortSpec.prototype.getShareId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.PortSpec} returns this
+ */
+proto.wsman.PortSpec.prototype.setShareId = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
 
 
 
@@ -10629,7 +10964,8 @@ proto.wsman.InitializerMetric.prototype.toObject = function(opt_includeInstance)
 proto.wsman.InitializerMetric.toObject = function(includeInstance, msg) {
   var f, obj = {
     duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
-    size: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    bytesFetched: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -10675,6 +11011,10 @@ proto.wsman.InitializerMetric.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {number} */ (reader.readUint64());
       msg.setSize(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBytesFetched(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10719,6 +11059,13 @@ proto.wsman.InitializerMetric.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getBytesFetched();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -10777,6 +11124,24 @@ proto.wsman.InitializerMetric.prototype.setSize = function(value) {
 };
 
 
+/**
+ * optional uint64 bytes_fetched = 3;
+ * @return {number}
+ */
+proto.wsman.InitializerMetric.prototype.getBytesFetched = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.InitializerMetric} returns this
+ */
+proto.wsman.InitializerMetric.prototype.setBytesFetched = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -10814,7 +11179,8 @@ proto.wsman.InitializerMetrics.toObject = function(includeInstance, msg) {
     snapshot: (f = msg.getSnapshot()) && proto.wsman.InitializerMetric.toObject(includeInstance, f),
     backup: (f = msg.getBackup()) && proto.wsman.InitializerMetric.toObject(includeInstance, f),
     prebuild: (f = msg.getPrebuild()) && proto.wsman.InitializerMetric.toObject(includeInstance, f),
-    composite: (f = msg.getComposite()) && proto.wsman.InitializerMetric.toObject(includeInstance, f)
+    composite: (f = msg.getComposite()) && proto.wsman.InitializerMetric.toObject(includeInstance, f),
+    gitLfs: (f = msg.getGitLfs()) && proto.wsman.InitializerMetric.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -10881,6 +11247,11 @@ proto.wsman.InitializerMetrics.deserializeBinaryFromReader = function(msg, reade
       reader.readMessage(value,proto.wsman.InitializerMetric.deserializeBinaryFromReader);
       msg.setComposite(value);
       break;
+    case 7:
+      var value = new proto.wsman.InitializerMetric;
+      reader.readMessage(value,proto.wsman.InitializerMetric.deserializeBinaryFromReader);
+      msg.setGitLfs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10958,6 +11329,14 @@ proto.wsman.InitializerMetrics.serializeBinaryToWriter = function(message, write
       proto.wsman.InitializerMetric.serializeBinaryToWriter
     );
   }
+  f = message.getGitLfs();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.wsman.InitializerMetric.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -11183,6 +11562,43 @@ proto.wsman.InitializerMetrics.prototype.hasComposite = function() {
 };
 
 
+/**
+ * optional InitializerMetric git_lfs = 7;
+ * @return {?proto.wsman.InitializerMetric}
+ */
+proto.wsman.InitializerMetrics.prototype.getGitLfs = function() {
+  return /** @type{?proto.wsman.InitializerMetric} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.InitializerMetric, 7));
+};
+
+
+/**
+ * @param {?proto.wsman.InitializerMetric|undefined} value
+ * @return {!proto.wsman.InitializerMetrics} returns this
+*/
+proto.wsman.InitializerMetrics.prototype.setGitLfs = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.InitializerMetrics} returns this
+ */
+proto.wsman.InitializerMetrics.prototype.clearGitLfs = function() {
+  return this.setGitLfs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.InitializerMetrics.prototype.hasGitLfs = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
 /**
  * @enum {number}
  */
@@ -11213,7 +11629,8 @@ proto.wsman.AdmissionLevel = {
  */
 proto.wsman.PortVisibility = {
   PORT_VISIBILITY_PRIVATE: 0,
-  PORT_VISIBILITY_PUBLIC: 1
+  PORT_VISIBILITY_PUBLIC: 1,
+  PORT_VISIBILITY_ORGANIZATION: 2
 };
 
 /**
@@ -11221,7 +11638,9 @@ proto.wsman.PortVisibility = {
  */
 proto.wsman.PortProtocol = {
   PORT_PROTOCOL_HTTP: 0,
-  PORT_PROTOCOL_HTTPS: 1
+  PORT_PROTOCOL_HTTPS: 1,
+  PORT_PROTOCOL_TCP: 2,
+  PORT_PROTOCOL_UDP: 3
 };
 
 /**
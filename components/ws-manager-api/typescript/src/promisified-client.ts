@@ -32,6 +32,8 @@ import {
     UpdateSSHKeyResponse,
     DescribeClusterRequest,
     DescribeClusterResponse,
+    ResizeWorkspaceDiskRequest,
+    ResizeWorkspaceDiskResponse,
 } from "./core_pb";
 import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
 import * as opentracing from "opentracing";
@@ -283,6 +285,33 @@ export class PromisifiedWorkspaceManagerClient implements Disposable {
         );
     }
 
+    public resizeWorkspaceDisk(
+        ctx: TraceContext,
+        request: ResizeWorkspaceDiskRequest,
+    ): Promise<ResizeWorkspaceDiskResponse> {
+        return this.retryIfUnavailable(
+            (attempt: number) =>
+                new Promise<ResizeWorkspaceDiskResponse>((resolve, reject) => {
+                    const span = TraceContext.startSpan(`/ws-manager/resizeWorkspaceDisk`, ctx);
+                    span.log({ attempt });
+                    this.client.resizeWorkspaceDisk(
+                        request,
+                        withTracing({ span }),
+                        this.getDefaultUnaryOptions(),
+                        (err, resp) => {
+                            span.finish();
+                            if (err) {
+                                TraceContext.setError(ctx, err);
+                                reject(err);
+                            } else {
+                                resolve(resp);
+                            }
+                        },
+                    );
+                }),
+        );
+    }
+
     public getWorkspaces(ctx: TraceContext, request: GetWorkspacesRequest): Promise<GetWorkspacesResponse> {
         return this.retryIfUnavailable(
             (attempt: number) =>
@@ -292,6 +292,7 @@ export class WorkspaceManagerBridge implements Disposable {
                         visibility: mapPortVisibility(p.visibility),
                         protocol: mapPortProtocol(p.protocol),
                         url: p.url,
+                        shareId: p.shareId || undefined,
                     };
                 });
             }
@@ -463,6 +464,8 @@ const mapPortVisibility = (visibility: WsManPortVisibility | undefined): PortVis
             return "private";
         case WsManPortVisibility.PORT_VISIBILITY_PUBLIC:
             return "public";
+        case WsManPortVisibility.PORT_VISIBILITY_ORGANIZATION:
+            return "organization";
     }
 };
 
@@ -470,6 +473,10 @@ const mapPortProtocol = (protocol: WsManPortProtocol): PortProtocol => {
     switch (protocol) {
         case WsManPortProtocol.PORT_PROTOCOL_HTTPS:
             return "https";
+        case WsManPortProtocol.PORT_PROTOCOL_TCP:
+            return "tcp";
+        case WsManPortProtocol.PORT_PROTOCOL_UDP:
+            return "udp";
         default:
             return "http";
     }
@@ -561,6 +568,9 @@ function mapInitializerMetrics(metrics: InitializerMetrics.AsObject): protocol.I
     if (metrics.composite) {
         result.composite = mapInitializerMetric(metrics.composite);
     }
+    if (metrics.gitLfs) {
+        result.gitLfs = mapInitializerMetric(metrics.gitLfs);
+    }
 
     return result;
 }
@@ -573,5 +583,6 @@ function mapInitializerMetric(metric: InitializerMetric.AsObject | undefined): p
     return {
         duration: metric.duration.seconds * 1000 + metric.duration.nanos / 1000000,
         size: metric.size,
+        bytesFetched: metric.bytesFetched || undefined,
     };
 }
@@ -698,18 +698,25 @@ func newStartWorkspaceContext(ctx context.Context, cfg *config.Configuration, ws
 	span, _ := tracing.FromContext(ctx, "newStartWorkspaceContext")
 	defer tracing.FinishSpan(span, &err)
 
+	labels := map[string]string{
+		"app":                         "gitpod",
+		"component":                   "workspace",
+		wsk8s.MetaIDLabel:             ws.Spec.Ownership.WorkspaceID,
+		wsk8s.WorkspaceIDLabel:        ws.Name,
+		wsk8s.OwnerLabel:              ws.Spec.Ownership.Owner,
+		wsk8s.TypeLabel:               strings.ToLower(string(ws.Spec.Type)),
+		wsk8s.WorkspaceClassLabel:     ws.Spec.Class,
+		wsk8s.WorkspaceManagedByLabel: constants.ManagedBy,
+		instanceIDLabel:               ws.Name,
+		headlessLabel:                 strconv.FormatBool(ws.IsHeadless()),
+	}
+	// ws-daemon selects the egress policy of a workspace by its organization and class
+	if ws.Spec.Ownership.Team != "" {
+		labels[wsk8s.TeamLabel] = ws.Spec.Ownership.Team
+	}
+
 	return &startWorkspaceContext{
-		Labels: map[string]string{
-			"app":                         "gitpod",
-			"component":                   "workspace",
-			wsk8s.MetaIDLabel:             ws.Spec.Ownership.WorkspaceID,
-			wsk8s.WorkspaceIDLabel:        ws.Name,
-			wsk8s.OwnerLabel:              ws.Spec.Ownership.Owner,
-			wsk8s.TypeLabel:               strings.ToLower(string(ws.Spec.Type)),
-			wsk8s.WorkspaceManagedByLabel: constants.ManagedBy,
-			instanceIDLabel:               ws.Name,
-			headlessLabel:                 strconv.FormatBool(ws.IsHeadless()),
-		},
+		Labels:         labels,
 		Config:         cfg,
 		Workspace:      ws,
 		IDEPort:        23000,
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// checkResourcePressure evicts the workspace if it is the noisiest of the workspaces under memory pressure on its node.
+// ws-daemon reports the pressure using the ResourcePressure condition. Evicting the workspace which stalls the most on
+// memory frees up the node before the OOM killer picks processes of arbitrary workspaces.
+// If the workspace is under pressure but not yet eligible for eviction, this function returns when to check again.
+func (r *WorkspaceReconciler) checkResourcePressure(ctx context.Context, workspace *workspacev1.Workspace) (requeueAfter time.Duration, err error) {
+	cfg := r.Config.ResourcePressureEviction
+	if cfg == nil {
+		return 0, nil
+	}
+	if workspace.Status.Phase != workspacev1.WorkspacePhaseRunning || workspace.IsConditionTrue(workspacev1.WorkspaceConditionEvicted) {
+		return 0, nil
+	}
+	if workspace.Status.Runtime == nil || workspace.Status.Runtime.NodeName == "" {
+		return 0, nil
+	}
+
+	gracePeriod := time.Duration(cfg.GracePeriod)
+	underPressure, since := memoryPressureSince(workspace)
+	if !underPressure {
+		return 0, nil
+	}
+	if wait := time.Until(since.Add(gracePeriod)); wait > 0 {
+		return wait, nil
+	}
+
+	span, ctx := tracing.FromContext(ctx, "checkResourcePressure")
+	defer tracing.FinishSpan(span, &err)
+
+	// the node index makes sure we only look at the workspaces on the same node, from the cache
+	var workspaces workspacev1.WorkspaceList
+	err = r.List(ctx, &workspaces, client.InNamespace(workspace.Namespace), client.MatchingFields{wsNodeKey: workspace.Status.Runtime.NodeName})
+	if err != nil {
+		return 0, fmt.Errorf("cannot list workspaces: %w", err)
+	}
+
+	// the workspace we're reconciling is a candidate itself
+	candidates := 1
+	noisiest := workspace
+	for i := range workspaces.Items {
+		ws := &workspaces.Items[i]
+		if ws.Name == workspace.Name {
+			continue
+		}
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionEvicted) && ws.Status.Phase != workspacev1.WorkspacePhaseStopped {
+			// give the node a chance to recover from the eviction that is already under way
+			return gracePeriod, nil
+		}
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+			continue
+		}
+		if pressure, since := memoryPressureSince(ws); !pressure || time.Since(since) < gracePeriod {
+			continue
+		}
+
+		candidates++
+		if noisier(ws, noisiest) {
+			noisiest = ws
+		}
+	}
+
+	if candidates < cfg.MinWorkspaces || noisiest != workspace {
+		return gracePeriod, nil
+	}
+
+	var memory int32
+	if workspace.Status.ResourcePressure != nil {
+		memory = workspace.Status.ResourcePressure.Memory
+	}
+	msg := fmt.Sprintf("workspace was evicted because it stalled the most on memory (%d%%) of the %d workspaces under memory pressure on node %s", memory, candidates, workspace.Status.Runtime.NodeName)
+	log.FromContext(ctx).Info("evicting workspace under memory pressure", "node", workspace.Status.Runtime.NodeName, "memoryPressure", memory, "candidates", candidates)
+	workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionEvicted(msg))
+	r.Recorder.Event(workspace, corev1.EventTypeWarning, "Evicted", msg)
+
+	return 0, nil
+}
+
+// memoryPressureSince returns whether the workspace is under memory pressure, and since when
+func memoryPressureSince(ws *workspacev1.Workspace) (bool, time.Time) {
+	c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionResourcePressure))
+	if c == nil || c.Status != metav1.ConditionTrue || c.Reason != workspacev1.ReasonMemoryPressure {
+		return false, time.Time{}
+	}
+	return true, c.LastTransitionTime.Time
+}
+
+// noisier returns true if a suffers from more memory pressure than b. Ties are broken by name to make sure
+// all reconciles on a node agree on the workspace to evict.
+func noisier(a, b *workspacev1.Workspace) bool {
+	var pa, pb int32
+	if a.Status.ResourcePressure != nil {
+		pa = a.Status.ResourcePressure.Memory
+	}
+	if b.Status.ResourcePressure != nil {
+		pb = b.Status.ResourcePressure.Memory
+	}
+	if pa != pb {
+		return pa > pb
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckResourcePressure(t *testing.T) {
+	const gracePeriod = time.Minute
+	cfg := &config.ResourcePressureEvictionConfiguration{MinWorkspaces: 2, GracePeriod: util.Duration(gracePeriod)}
+
+	type Expectation struct {
+		Evicted bool
+		// Requeue is the expected requeue duration, which is compared with a tolerance of a second
+		Requeue time.Duration
+	}
+	tests := []struct {
+		Name        string
+		Config      *config.ResourcePressureEvictionConfiguration
+		Workspace   *workspacev1.Workspace
+		Others      []*workspacev1.Workspace
+		Expectation Expectation
+	}{
+		{
+			Name:      "eviction disabled",
+			Workspace: pressuredWorkspace("ws-1", "node-a", 80, 2*time.Minute),
+			Others:    []*workspacev1.Workspace{pressuredWorkspace("ws-2", "node-a", 20, 2*time.Minute)},
+		},
+		{
+			Name:      "no pressure",
+			Config:    cfg,
+			Workspace: runningWorkspace("ws-1", "node-a"),
+			Others:    []*workspacev1.Workspace{pressuredWorkspace("ws-2", "node-a", 20, 2*time.Minute)},
+		},
+		{
+			Name:        "within grace period",
+			Config:      cfg,
+			Workspace:   pressuredWorkspace("ws-1", "node-a", 80, 20*time.Second),
+			Others:      []*workspacev1.Workspace{pressuredWorkspace("ws-2", "node-a", 20, 2*time.Minute)},
+			Expectation: Expectation{Requeue: 40 * time.Second},
+		},
+		{
+			Name:        "noisiest workspace",
+			Config:      cfg,
+			Workspace:   pressuredWorkspace("ws-1", "node-a", 80, 2*time.Minute),
+			Others:      []*workspacev1.Workspace{pressuredWorkspace("ws-2", "node-a", 20, 2*time.Minute)},
+			Expectation: Expectation{Evicted: true},
+		},
+		{
+			Name:        "not the noisiest workspace",
+			Config:      cfg,
+			Workspace:   pressuredWorkspace("ws-1", "node-a", 20, 2*time.Minute),
+			Others:      []*workspacev1.Workspace{pressuredWorkspace("ws-2", "node-a", 80, 2*time.Minute)},
+			Expectation: Expectation{Requeue: gracePeriod},
+		},
+		{
+			Name:      "too few workspaces under pressure",
+			Config:    cfg,
+			Workspace: pressuredWorkspace("ws-1", "node-a", 80, 2*time.Minute),
+			Others: []*workspacev1.Workspace{
+				runningWorkspace("ws-2", "node-a"),
+				pressuredWorkspace("ws-3", "node-a", 20, 10*time.Second),
+			},
+			Expectation: Expectation{Requeue: gracePeriod},
+		},
+		{
+			Name:      "workspaces on other nodes",
+			Config:    cfg,
+			Workspace: pressuredWorkspace("ws-1", "node-a", 20, 2*time.Minute),
+			Others: []*workspacev1.Workspace{
+				pressuredWorkspace("ws-2", "node-a", 10, 2*time.Minute),
+				pressuredWorkspace("ws-3", "node-b", 80, 2*time.Minute),
+			},
+			Expectation: Expectation{Evicted: true},
+		},
+		{
+			Name:      "eviction under way",
+			Config:    cfg,
+			Workspace: pressuredWorkspace("ws-1", "node-a", 80, 2*time.Minute),
+			Others: []*workspacev1.Workspace{
+				pressuredWorkspace("ws-2", "node-a", 20, 2*time.Minute),
+				evicted(pressuredWorkspace("ws-3", "node-a", 90, 2*time.Minute)),
+			},
+			Expectation: Expectation{Requeue: gracePeriod},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			err := workspacev1.AddToScheme(scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			objs := make([]client.Object, 0, len(test.Others))
+			for _, ws := range test.Others {
+				objs = append(objs, ws)
+			}
+			r := &WorkspaceReconciler{
+				Client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(objs...).
+					WithIndex(&workspacev1.Workspace{}, wsNodeKey, workspaceNodeIndex).
+					Build(),
+				Config:   &config.Configuration{ResourcePressureEviction: test.Config},
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			requeue, err := r.checkResourcePressure(context.Background(), test.Workspace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if evicted := test.Workspace.IsConditionTrue(workspacev1.WorkspaceConditionEvicted); evicted != test.Expectation.Evicted {
+				t.Errorf("unexpected eviction: want %v, got %v", test.Expectation.Evicted, evicted)
+			}
+			if diff := test.Expectation.Requeue - requeue; diff < 0 || diff > time.Second {
+				t.Errorf("unexpected requeue: want %s, got %s", test.Expectation.Requeue, requeue)
+			}
+		})
+	}
+}
+
+func TestNoisier(t *testing.T) {
+	withPressure := func(name string, memory int32) *workspacev1.Workspace {
+		ws := runningWorkspace(name, "node-a")
+		ws.Status.ResourcePressure = &workspacev1.ResourcePressure{Memory: memory}
+		return ws
+	}
+
+	tests := []struct {
+		Name        string
+		A, B        *workspacev1.Workspace
+		Expectation bool
+	}{
+		{Name: "more pressure", A: withPressure("ws-b", 80), B: withPressure("ws-a", 20), Expectation: true},
+		{Name: "less pressure", A: withPressure("ws-a", 20), B: withPressure("ws-b", 80), Expectation: false},
+		{Name: "unknown pressure", A: runningWorkspace("ws-a", "node-a"), B: withPressure("ws-b", 1), Expectation: false},
+		{Name: "tie broken by name", A: withPressure("ws-a", 50), B: withPressure("ws-b", 50), Expectation: true},
+		{Name: "tie broken by name reversed", A: withPressure("ws-b", 50), B: withPressure("ws-a", 50), Expectation: false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := noisier(test.A, test.B); act != test.Expectation {
+				t.Errorf("unexpected result: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func runningWorkspace(name, node string) *workspacev1.Workspace {
+	return &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: workspacev1.WorkspaceStatus{
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: node},
+		},
+	}
+}
+
+// pressuredWorkspace returns a running workspace which has been under memory pressure for the given duration
+func pressuredWorkspace(name, node string, memory int32, since time.Duration) *workspacev1.Workspace {
+	ws := runningWorkspace(name, node)
+	ws.Status.ResourcePressure = &workspacev1.ResourcePressure{Memory: memory}
+	cond := workspacev1.NewWorkspaceConditionResourcePressure(metav1.ConditionTrue, workspacev1.ReasonMemoryPressure, "")
+	cond.LastTransitionTime = metav1.NewTime(time.Now().Add(-since))
+	ws.Status.SetCondition(cond)
+	return ws
+}
+
+func evicted(ws *workspacev1.Workspace) *workspacev1.Workspace {
+	ws.Status.SetCondition(workspacev1.NewWorkspaceConditionEvicted(""))
+	return ws
+}
@@ -142,6 +142,11 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to compute latest workspace status: %w", err)
 	}
 
+	pressureRequeue, err := r.checkResourcePressure(ctx, &workspace)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check resource pressure: %w", err)
+	}
+
 	r.updateMetrics(ctx, &workspace)
 	r.emitPhaseEvents(ctx, &workspace, oldStatus)
 
@@ -164,6 +169,11 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return errorResultLogConflict(log, fmt.Errorf("failed to act on status: %w", err))
 	}
 
+	if pressureRequeue > 0 && result.IsZero() {
+		// check again once the workspace could be evicted
+		result.RequeueAfter = pressureRequeue
+	}
+
 	return result, nil
 }
 
@@ -340,6 +350,10 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) && !isPodBeingDeleted(pod):
 		return r.deleteWorkspacePod(ctx, pod, "timed out")
 
+	// if the workspace was evicted because of memory pressure, delete it
+	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionEvicted) && !isPodBeingDeleted(pod):
+		return r.deleteWorkspacePod(ctx, pod, "evicted")
+
 	// if the content initialization failed, delete the pod
 	case wsk8s.ConditionWithStatusAndReason(workspace.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady), false, workspacev1.ReasonInitializationFailure) && !isPodBeingDeleted(pod):
 		return r.deleteWorkspacePod(ctx, pod, "init failed")
@@ -580,6 +594,7 @@ func errorResultLogConflict(log logr.Logger, err error) (ctrl.Result, error) {
 
 var (
 	wsOwnerKey = ".metadata.controller"
+	wsNodeKey  = ".status.runtime.nodeName"
 	apiGVStr   = workspacev1.GroupVersion.String()
 )
 
@@ -676,7 +691,21 @@ func SetupIndexer(mgr ctrl.Manager) error {
 		}
 
 		err = mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, wsOwnerKey, idx)
+		if err != nil {
+			return
+		}
+
+		err = mgr.GetFieldIndexer().IndexField(context.Background(), &workspacev1.Workspace{}, wsNodeKey, workspaceNodeIndex)
 	})
 
 	return err
 }
+
+// workspaceNodeIndex indexes workspaces by the node they run on
+func workspaceNodeIndex(rawObj client.Object) []string {
+	ws := rawObj.(*workspacev1.Workspace)
+	if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return nil
+	}
+	return []string{ws.Status.Runtime.NodeName}
+}
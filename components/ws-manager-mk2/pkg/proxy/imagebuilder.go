@@ -55,6 +55,10 @@ func (p ImageBuilder) ListBuilds(ctx context.Context, req *api.ListBuildsRequest
 	return p.D.ListBuilds(ctx, req)
 }
 
+func (p ImageBuilder) RefreshImages(ctx context.Context, req *api.RefreshImagesRequest) (*api.RefreshImagesResponse, error) {
+	return p.D.RefreshImages(ctx, req)
+}
+
 type ProtoMessage interface {
 	proto.Message
 	comparable
@@ -164,18 +164,10 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 
 	ports := make([]workspacev1.PortSpec, 0, len(req.Spec.Ports))
 	for _, p := range req.Spec.Ports {
-		v := workspacev1.AdmissionLevelOwner
-		if p.Visibility == wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC {
-			v = workspacev1.AdmissionLevelEveryone
-		}
-		protocol := workspacev1.PortProtocolHttp
-		if p.Protocol == wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS {
-			protocol = workspacev1.PortProtocolHttps
-		}
 		ports = append(ports, workspacev1.PortSpec{
 			Port:       p.Port,
-			Visibility: v,
-			Protocol:   protocol,
+			Visibility: portVisibilityToCRD(p.Visibility),
+			Protocol:   portProtocolToCRD(p.Protocol),
 		})
 	}
 
@@ -198,6 +190,15 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		return nil, status.Errorf(codes.InvalidArgument, "%s", msg)
 	}
 
+	var ingressBandwidth, egressBandwidth int64
+	if class.Container.Limits != nil {
+		ingressBandwidth, egressBandwidth, err = class.Container.Limits.Bandwidth.BytesPerSecond()
+		if err != nil {
+			msg := fmt.Sprintf("workspace class %s has invalid bandwidth limit: %v", class.Name, err)
+			return nil, status.Errorf(codes.InvalidArgument, "%s", msg)
+		}
+	}
+
 	annotations := make(map[string]string)
 	for k, v := range req.Metadata.Annotations {
 		annotations[k] = v
@@ -255,6 +256,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			Ownership: workspacev1.Ownership{
 				Owner:       req.Metadata.Owner,
 				WorkspaceID: req.Metadata.MetaId,
+				Team:        req.Metadata.GetTeam(),
 			},
 			Type:  workspaceType,
 			Class: classID,
@@ -284,6 +286,8 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			Ports:                 ports,
 			SshPublicKeys:         req.Spec.SshPublicKeys,
 			StorageQuota:          int(storage.Value()),
+			IngressBandwidth:      ingressBandwidth,
+			EgressBandwidth:       egressBandwidth,
 			SSHGatewayCAPublicKey: sshGatewayCAPublicKey,
 		},
 	}
@@ -612,18 +616,11 @@ func (wsm *WorkspaceManagerServer) ControlPort(ctx context.Context, req *wsmanap
 		ws.Spec.Ports = ws.Spec.Ports[:n]
 
 		if req.Expose {
-			visibility := workspacev1.AdmissionLevelOwner
-			protocol := workspacev1.PortProtocolHttp
-			if req.Spec.Visibility == wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC {
-				visibility = workspacev1.AdmissionLevelEveryone
-			}
-			if req.Spec.Protocol == wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS {
-				protocol = workspacev1.PortProtocolHttps
-			}
 			ws.Spec.Ports = append(ws.Spec.Ports, workspacev1.PortSpec{
 				Port:       port,
-				Visibility: visibility,
-				Protocol:   protocol,
+				Visibility: portVisibilityToCRD(req.Spec.Visibility),
+				Protocol:   portProtocolToCRD(req.Spec.Protocol),
+				ShareID:    req.Spec.ShareId,
 			})
 		}
 
@@ -767,6 +764,60 @@ func (wsm *WorkspaceManagerServer) UpdateSSHKey(ctx context.Context, req *wsmana
 	return &wsmanapi.UpdateSSHKeyResponse{}, err
 }
 
+func (wsm *WorkspaceManagerServer) ResizeWorkspaceDisk(ctx context.Context, req *wsmanapi.ResizeWorkspaceDiskRequest) (res *wsmanapi.ResizeWorkspaceDiskResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "ResizeWorkspaceDisk")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	size, err := resource.ParseQuantity(req.Size)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid size: %v", err)
+	}
+
+	err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+			return status.Errorf(codes.FailedPrecondition, "workspace is not running")
+		}
+		class, ok := wsm.Config.WorkspaceClasses[ws.Spec.Class]
+		if !ok {
+			return status.Errorf(codes.FailedPrecondition, "workspace class \"%s\" is unknown", ws.Spec.Class)
+		}
+		if err := validateDiskResize(class, ws.Spec.StorageQuota, ws.Status.StorageQuota, size); err != nil {
+			return err
+		}
+
+		ws.Spec.StorageQuota = int(size.Value())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsmanapi.ResizeWorkspaceDiskResponse{}, nil
+}
+
+// validateDiskResize checks that a workspace of the class may grow its disk from the current quota to size.
+// enforced is the quota ws-daemon reports to enforce, ws-daemon only grows quotas it has installed.
+func validateDiskResize(class *config.WorkspaceClass, current, enforced int, size resource.Quantity) error {
+	maxStorage, err := class.Container.Limits.MaxStorageQuantity()
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "workspace class %s has invalid max storage quantity: %v", class.Name, err)
+	}
+	if maxStorage.IsZero() {
+		return status.Errorf(codes.FailedPrecondition, "workspace class %s does not permit resizing the disk", class.Name)
+	}
+	if enforced == 0 {
+		return status.Errorf(codes.FailedPrecondition, "workspace has no disk quota that could be resized")
+	}
+	if size.Cmp(maxStorage) > 0 {
+		return status.Errorf(codes.OutOfRange, "size %s exceeds the maximum of %s", size.String(), maxStorage.String())
+	}
+	if size.Value() <= int64(current) {
+		return status.Errorf(codes.InvalidArgument, "size %s must be larger than the current disk quota of %s", size.String(), resource.NewQuantity(int64(current), resource.BinarySI).String())
+	}
+	return nil
+}
+
 func (wsm *WorkspaceManagerServer) DescribeCluster(ctx context.Context, req *wsmanapi.DescribeClusterRequest) (res *wsmanapi.DescribeClusterResponse, err error) {
 	//nolint:ineffassign
 	span, ctx := tracing.FromContext(ctx, "DescribeCluster")
@@ -1079,14 +1130,6 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 
 	ports := make([]*wsmanapi.PortSpec, 0, len(ws.Spec.Ports))
 	for _, p := range ws.Spec.Ports {
-		v := wsmanapi.PortVisibility_PORT_VISIBILITY_PRIVATE
-		if p.Visibility == workspacev1.AdmissionLevelEveryone {
-			v = wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC
-		}
-		protocol := wsmanapi.PortProtocol_PORT_PROTOCOL_HTTP
-		if p.Protocol == workspacev1.PortProtocolHttps {
-			protocol = wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS
-		}
 		url, err := config.RenderWorkspacePortURL(wsm.Config.WorkspacePortURLTemplate, config.PortURLContext{
 			Host:          wsm.Config.GitpodHostURL,
 			ID:            ws.Name,
@@ -1100,9 +1143,10 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		}
 		ports = append(ports, &wsmanapi.PortSpec{
 			Port:       p.Port,
-			Visibility: v,
+			Visibility: portVisibilityFromCRD(p.Visibility),
 			Url:        url,
-			Protocol:   protocol,
+			Protocol:   portProtocolFromCRD(p.Protocol),
+			ShareId:    p.ShareID,
 		})
 	}
 
@@ -1175,8 +1219,9 @@ func mapInitializerMetrics(in *workspacev1.InitializerMetrics) *wsmanapi.Initial
 	// Convert Git metrics
 	if in.Git != nil {
 		result.Git = &wsmanapi.InitializerMetric{
-			Duration: durationToProto(in.Git.Duration),
-			Size:     uint64(in.Git.Size),
+			Duration:     durationToProto(in.Git.Duration),
+			Size:         uint64(in.Git.Size),
+			BytesFetched: in.Git.BytesFetched,
 		}
 	}
 
@@ -1220,6 +1265,15 @@ func mapInitializerMetrics(in *workspacev1.InitializerMetrics) *wsmanapi.Initial
 		}
 	}
 
+	// Convert Git LFS metrics
+	if in.GitLFS != nil {
+		result.GitLfs = &wsmanapi.InitializerMetric{
+			Duration:     durationToProto(in.GitLFS.Duration),
+			Size:         uint64(in.GitLFS.Size),
+			BytesFetched: in.GitLFS.BytesFetched,
+		}
+	}
+
 	return result
 }
 
@@ -1255,6 +1309,54 @@ func convertGitStatus(gs *workspacev1.GitStatus) *csapi.GitStatus {
 	}
 }
 
+func portVisibilityToCRD(visibility wsmanapi.PortVisibility) workspacev1.AdmissionLevel {
+	switch visibility {
+	case wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC:
+		return workspacev1.AdmissionLevelEveryone
+	case wsmanapi.PortVisibility_PORT_VISIBILITY_ORGANIZATION:
+		return workspacev1.AdmissionLevelOrganization
+	default:
+		return workspacev1.AdmissionLevelOwner
+	}
+}
+
+func portVisibilityFromCRD(visibility workspacev1.AdmissionLevel) wsmanapi.PortVisibility {
+	switch visibility {
+	case workspacev1.AdmissionLevelEveryone:
+		return wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC
+	case workspacev1.AdmissionLevelOrganization:
+		return wsmanapi.PortVisibility_PORT_VISIBILITY_ORGANIZATION
+	default:
+		return wsmanapi.PortVisibility_PORT_VISIBILITY_PRIVATE
+	}
+}
+
+func portProtocolToCRD(protocol wsmanapi.PortProtocol) workspacev1.PortProtocol {
+	switch protocol {
+	case wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS:
+		return workspacev1.PortProtocolHttps
+	case wsmanapi.PortProtocol_PORT_PROTOCOL_TCP:
+		return workspacev1.PortProtocolTcp
+	case wsmanapi.PortProtocol_PORT_PROTOCOL_UDP:
+		return workspacev1.PortProtocolUdp
+	default:
+		return workspacev1.PortProtocolHttp
+	}
+}
+
+func portProtocolFromCRD(protocol workspacev1.PortProtocol) wsmanapi.PortProtocol {
+	switch protocol {
+	case workspacev1.PortProtocolHttps:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS
+	case workspacev1.PortProtocolTcp:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_TCP
+	case workspacev1.PortProtocolUdp:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_UDP
+	default:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+}
+
 func convertCondition(conds []metav1.Condition, tpe string) wsmanapi.WorkspaceConditionBool {
 	res := wsk8s.GetCondition(conds, tpe)
 	if res == nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestDescribeCluster(t *testing.T) {
@@ -93,3 +94,98 @@ func TestDescribeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDiskResize(t *testing.T) {
+	class := &config.WorkspaceClass{
+		Name: "default",
+		Container: config.ContainerConfiguration{
+			Limits: &config.ResourceLimitConfiguration{
+				Storage:    "30Gi",
+				MaxStorage: "100Gi",
+			},
+		},
+	}
+	tests := []struct {
+		Name        string
+		Class       *config.WorkspaceClass
+		Current     int
+		Enforced    int
+		Size        string
+		Expectation string
+	}{
+		{
+			Name:     "grow",
+			Class:    class,
+			Current:  30 * 1024 * 1024 * 1024,
+			Enforced: 30 * 1024 * 1024 * 1024,
+			Size:     "50Gi",
+		},
+		{
+			Name:     "grow to max",
+			Class:    class,
+			Current:  30 * 1024 * 1024 * 1024,
+			Enforced: 30 * 1024 * 1024 * 1024,
+			Size:     "100Gi",
+		},
+		{
+			Name:        "exceeds max",
+			Class:       class,
+			Current:     30 * 1024 * 1024 * 1024,
+			Enforced:    30 * 1024 * 1024 * 1024,
+			Size:        "101Gi",
+			Expectation: "rpc error: code = OutOfRange desc = size 101Gi exceeds the maximum of 100Gi",
+		},
+		{
+			Name:        "shrink",
+			Class:       class,
+			Current:     30 * 1024 * 1024 * 1024,
+			Enforced:    30 * 1024 * 1024 * 1024,
+			Size:        "20Gi",
+			Expectation: "rpc error: code = InvalidArgument desc = size 20Gi must be larger than the current disk quota of 30Gi",
+		},
+		{
+			Name:        "no max storage",
+			Class:       &config.WorkspaceClass{Name: "small"},
+			Current:     30 * 1024 * 1024 * 1024,
+			Enforced:    30 * 1024 * 1024 * 1024,
+			Size:        "50Gi",
+			Expectation: "rpc error: code = FailedPrecondition desc = workspace class small does not permit resizing the disk",
+		},
+		{
+			Name:        "no enforced quota",
+			Class:       class,
+			Current:     30 * 1024 * 1024 * 1024,
+			Size:        "50Gi",
+			Expectation: "rpc error: code = FailedPrecondition desc = workspace has no disk quota that could be resized",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act string
+			err := validateDiskResize(test.Class, test.Current, test.Enforced, resource.MustParse(test.Size))
+			if err != nil {
+				act = err.Error()
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected error: want %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestPortProtocolConversion(t *testing.T) {
+	for _, protocol := range []api.PortProtocol{
+		api.PortProtocol_PORT_PROTOCOL_HTTP,
+		api.PortProtocol_PORT_PROTOCOL_HTTPS,
+		api.PortProtocol_PORT_PROTOCOL_TCP,
+		api.PortProtocol_PORT_PROTOCOL_UDP,
+	} {
+		t.Run(protocol.String(), func(t *testing.T) {
+			act := portProtocolFromCRD(portProtocolToCRD(protocol))
+			if act != protocol {
+				t.Errorf("unexpected protocol after round trip: %v", act)
+			}
+		})
+	}
+}
@@ -175,6 +175,13 @@ var runCmd = &cobra.Command{
 			}
 			if len(signers) > 0 {
 				sshGatewayServer = sshproxy.New(signers, infoprov, heartbeat, caKey)
+				if cfg.Proxy.SSHGatewayAudit != nil {
+					sshGatewayServer.Auditor, err = sshproxy.NewAuditor(cfg.Proxy.SSHGatewayAudit)
+					if err != nil {
+						log.WithError(err).Fatal("cannot set up SSH Gateway audit log")
+					}
+					log.Info("SSHGateway audit log enabled")
+				}
 				l, err := net.Listen("tcp", ":2200")
 				if err != nil {
 					panic(err)
@@ -197,6 +204,9 @@ var runCmd = &cobra.Command{
 		}
 
 		log.Info("Received SIGINT - shutting down")
+		if sshGatewayServer != nil && sshGatewayServer.Auditor != nil {
+			sshGatewayServer.Auditor.Close()
+		}
 	},
 }
 
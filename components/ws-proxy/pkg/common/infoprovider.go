@@ -45,10 +45,20 @@ type WorkspaceInfoProvider interface {
 	// WorkspaceInfo returns the workspace information of a workspace using it's workspace ID
 	WorkspaceInfo(workspaceID string) *WorkspaceInfo
 
-	AcquireContext(ctx context.Context, workspaceID, port string) (context.Context, string, error)
+	// AcquireContext returns a context for a connection which was granted access to a port by the given grant.
+	// The context is canceled once the grant no longer applies.
+	AcquireContext(ctx context.Context, workspaceID, port string, grant PortGrant) (context.Context, string, error)
 	ReleaseContext(id string)
 }
 
+// PortGrant describes why a connection which is not authenticated as the workspace owner may access a port.
+type PortGrant struct {
+	// Visibility is the port visibility which granted access
+	Visibility api.PortVisibility
+	// ShareID identifies the share link which granted access, if any
+	ShareID string
+}
+
 // WorkspaceInfo is all the infos ws-proxy needs to know about a workspace.
 type WorkspaceInfo struct {
 	WorkspaceID string
@@ -68,9 +78,11 @@ type WorkspaceInfo struct {
 	Auth      *wsapi.WorkspaceAuthentication
 	StartedAt time.Time
 
-	OwnerUserId   string
-	SSHPublicKeys []string
-	IsRunning     bool
+	OwnerUserId string
+	// OrganizationID is the ID of the organization which owns the workspace
+	OrganizationID string
+	SSHPublicKeys  []string
+	IsRunning      bool
 
 	IsEnabledSSHCA bool
 	IsManagedByMk2 bool
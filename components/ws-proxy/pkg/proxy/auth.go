@@ -5,6 +5,7 @@
 package proxy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -22,16 +23,32 @@ var (
 	ErrTokenNotFound = fmt.Errorf("no owner cookie present")
 	ErrTokenMismatch = fmt.Errorf("owner token mismatch")
 	ErrTokenDecode   = fmt.Errorf("cannot decode owner token")
+
+	ErrSessionNotFound = fmt.Errorf("no workspace session cookie present")
+	ErrSessionInvalid  = fmt.Errorf("invalid workspace session")
+	ErrNotMember       = fmt.Errorf("not a member of the workspace's organization")
+
+	ErrShareNotFound = fmt.Errorf("no share token present")
+	ErrShareInvalid  = fmt.Errorf("invalid share token")
 )
 
+// ShareTokenParam is the query parameter share links carry their token in
+const ShareTokenParam = "gitpod_share"
+
 // WorkspaceAuthHandler rejects requests which are not authenticated or authorized to access a workspace.
-func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.MiddlewareFunc {
+// Ports with organization visibility are accessible to members of the organization which owns the workspace,
+// who authenticate using a workspace session token. Without a session verifier such ports are treated as private.
+// Share links grant access to a single port regardless of its visibility until they expire or are revoked.
+// Their token is moved from the query into a cookie scoped to the port's host.
+func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider, sessions *SessionVerifier) mux.MiddlewareFunc {
 	return func(h http.Handler) http.Handler {
 		cookiePrefix := domain
 		for _, c := range []string{" ", "-", "."} {
 			cookiePrefix = strings.ReplaceAll(cookiePrefix, c, "_")
 		}
 		cookiePrefix = "_" + cookiePrefix + "_ws_"
+		sessionCookie := cookiePrefix + "session_"
+		shareCookie := cookiePrefix + "share_"
 
 		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 			var (
@@ -54,23 +71,31 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 				return
 			}
 
-			isPublic := false
-			if ws.Auth != nil && ws.Auth.Admission == api.AdmissionLevel_ADMIT_EVERYONE {
-				isPublic = true
-			} else if port != "" {
+			var portSpec *api.PortSpec
+			if port != "" {
 				prt, err := strconv.ParseUint(port, 10, 16)
 				if err != nil {
 					log.WithField("port", port).WithError(err).Error("cannot convert port to int")
 				} else {
 					for _, p := range ws.Ports {
 						if p.Port == uint32(prt) {
-							isPublic = p.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC
+							portSpec = p
 							break
 						}
 					}
 				}
 			}
 
+			visibility := api.PortVisibility_PORT_VISIBILITY_PRIVATE
+			if ws.Auth != nil && ws.Auth.Admission == api.AdmissionLevel_ADMIT_EVERYONE {
+				visibility = api.PortVisibility_PORT_VISIBILITY_PUBLIC
+			} else if portSpec != nil {
+				visibility = portSpec.Visibility
+			}
+			if visibility == api.PortVisibility_PORT_VISIBILITY_ORGANIZATION && sessions == nil {
+				visibility = api.PortVisibility_PORT_VISIBILITY_PRIVATE
+			}
+
 			authenticate := func() (bool, error) {
 				tkn := req.Header.Get("x-gitpod-owner-token")
 				if tkn == "" {
@@ -92,41 +117,153 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 				return true, nil
 			}
 
-			authenticated, err := authenticate()
-			if !authenticated && !isPublic {
+			authorizeMember := func() error {
+				c, err := req.Cookie(sessionCookie)
 				if err != nil {
-					if errors.Is(err, ErrTokenNotFound) {
-						resp.WriteHeader(http.StatusUnauthorized)
-						return
-					}
-					if errors.Is(err, ErrTokenMismatch) {
-						log.Warn("owner token mismatch")
-						resp.WriteHeader(http.StatusForbidden)
-						return
-					}
-					if errors.Is(err, ErrTokenDecode) {
-						log.Warn("cannot decode owner token")
-						resp.WriteHeader(http.StatusBadRequest)
-						return
+					return ErrSessionNotFound
+				}
+				claims, err := sessions.Verify(c.Value)
+				if err != nil {
+					log.WithError(err).Debug("cannot verify workspace session")
+					return ErrSessionInvalid
+				}
+				if !claims.IsMember(ws.OrganizationID) {
+					return ErrNotMember
+				}
+				return nil
+			}
+
+			authorizeShare := func() (claims *ShareClaims, fromQuery bool, err error) {
+				tkn := req.URL.Query().Get(ShareTokenParam)
+				fromQuery = tkn != ""
+				if !fromQuery {
+					c, err := req.Cookie(shareCookie)
+					if err != nil {
+						return nil, false, ErrShareNotFound
 					}
+					tkn = c.Value
 				}
-				log.WithError(err).Error("cannot authenticate")
-				resp.WriteHeader(http.StatusInternalServerError)
-				return
+				if sessions == nil || portSpec == nil || portSpec.ShareId == "" {
+					return nil, fromQuery, ErrShareInvalid
+				}
+				claims, err = sessions.VerifyShare(tkn)
+				if err != nil {
+					log.WithError(err).Debug("cannot verify share token")
+					return nil, fromQuery, ErrShareInvalid
+				}
+				if claims.Subject != wsID || claims.Port != portSpec.Port || claims.ID != portSpec.ShareId {
+					// the link was issued for another port or has been revoked
+					return nil, fromQuery, ErrShareInvalid
+				}
+				return claims, fromQuery, nil
 			}
 
-			if !authenticated && isPublic {
-				ctx, id, err := info.AcquireContext(req.Context(), wsID, port)
+			serveShared := func(grant common.PortGrant) {
+				ctx, id, err := info.AcquireContext(req.Context(), wsID, port, grant)
 				if err != nil {
 					log.WithError(err).Error("cannot acquire context")
 					resp.WriteHeader(http.StatusInternalServerError)
 					return
 				}
 				defer info.ReleaseContext(id)
+				h.ServeHTTP(resp, req.WithContext(ctx))
+			}
+
+			authenticated, err := authenticate()
+			if authenticated {
+				h.ServeHTTP(resp, req)
+				return
+			}
+
+			if visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC {
+				serveShared(common.PortGrant{Visibility: visibility})
+				return
+			}
+
+			shareClaims, fromQuery, shareErr := authorizeShare()
+			if shareErr == nil {
+				if fromQuery {
+					http.SetCookie(resp, &http.Cookie{
+						Name:     shareCookie,
+						Value:    req.URL.Query().Get(ShareTokenParam),
+						Path:     "/",
+						Expires:  shareClaims.ExpiresAt.Time,
+						HttpOnly: true,
+						Secure:   true,
+						SameSite: http.SameSiteLaxMode,
+					})
+					q := req.URL.Query()
+					q.Del(ShareTokenParam)
+					req.URL.RawQuery = q.Encode()
+					if req.Method == http.MethodGet {
+						// keep the token out of the browser history and away from the application
+						http.Redirect(resp, req, req.URL.RequestURI(), http.StatusFound)
+						return
+					}
+				}
+
+				ctx, cancel := context.WithDeadline(req.Context(), shareClaims.ExpiresAt.Time)
+				defer cancel()
 				req = req.WithContext(ctx)
+				serveShared(common.PortGrant{Visibility: visibility, ShareID: shareClaims.ID})
+				return
+			}
+			if fromQuery {
+				// the link has expired, was revoked or was issued for another port
+				resp.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			switch visibility {
+			case api.PortVisibility_PORT_VISIBILITY_ORGANIZATION:
+				err := authorizeMember()
+				if err == nil {
+					serveShared(common.PortGrant{Visibility: visibility})
+					return
+				}
+				if errors.Is(err, ErrSessionNotFound) && isNavigation(req) {
+					// let the user sign in and come back with a session
+					returnTo := "https://" + req.Host + req.URL.RequestURI()
+					http.Redirect(resp, req, fmt.Sprintf("https://%s/api/auth/workspace-session?returnTo=%s", domain, url.QueryEscape(returnTo)), http.StatusFound)
+					return
+				}
+				if errors.Is(err, ErrNotMember) {
+					resp.WriteHeader(http.StatusForbidden)
+					return
+				}
+				resp.WriteHeader(http.StatusUnauthorized)
+				return
 			}
 
-			h.ServeHTTP(resp, req)
+			if err != nil {
+				if errors.Is(err, ErrTokenNotFound) {
+					resp.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if errors.Is(err, ErrTokenMismatch) {
+					log.Warn("owner token mismatch")
+					resp.WriteHeader(http.StatusForbidden)
+					return
+				}
+				if errors.Is(err, ErrTokenDecode) {
+					log.Warn("cannot decode owner token")
+					resp.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			}
+			log.WithError(err).Error("cannot authenticate")
+			resp.WriteHeader(http.StatusInternalServerError)
 		})
 	}
 }
+
+// isNavigation returns true if the request was made by a browser navigating to a page
+func isNavigation(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	if mode := req.Header.Get("Sec-Fetch-Mode"); mode != "" {
+		return mode == "navigate"
+	}
+	return strings.Contains(req.Header.Get("Accept"), "text/html")
+}
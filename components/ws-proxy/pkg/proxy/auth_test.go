@@ -11,7 +11,9 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -24,8 +26,10 @@ import (
 func TestWorkspaceAuthHandler(t *testing.T) {
 	log.Log.Logger.SetLevel(logrus.PanicLevel)
 	type testResult struct {
-		HandlerCalled bool
-		StatusCode    int
+		HandlerCalled  bool
+		StatusCode     int
+		Location       string
+		ShareCookieSet bool
 	}
 
 	const (
@@ -65,14 +69,78 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				Auth:        &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_EVERYONE},
 			},
 		}
+		orgPortInfos = []common.WorkspaceInfo{
+			{
+				WorkspaceID:    workspaceID,
+				InstanceID:     instanceID,
+				OrganizationID: "org-1",
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+				},
+				Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_ORGANIZATION}},
+			},
+		}
+		sharedPortInfos = []common.WorkspaceInfo{
+			{
+				WorkspaceID: workspaceID,
+				InstanceID:  instanceID,
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+				},
+				Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE, ShareId: "share-1"}},
+			},
+		}
+
+		sessionKey = generateSessionKey(t)
+		sessions   = newTestSessionVerifier(t, sessionKey)
+		session    = func(orgs ...string) string {
+			return signSessionToken(t, sessionKey, testSessionKeyID, &SessionClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    testSessionIssuer,
+					Audience:  jwt.ClaimStrings{SessionTokenAudience},
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				},
+				Organizations: orgs,
+			})
+		}
+		share = func(modify func(c *ShareClaims)) string {
+			claims := &ShareClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    testSessionIssuer,
+					Subject:   workspaceID,
+					Audience:  jwt.ClaimStrings{ShareTokenAudience},
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+					ID:        "share-1",
+				},
+				Port: testPort,
+			}
+			if modify != nil {
+				modify(claims)
+			}
+			tkn := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tkn.Header["kid"] = testSessionKeyID
+			res, err := tkn.SignedString(sessionKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return res
+		}
 	)
 	tests := []struct {
-		Name        string
-		Infos       []common.WorkspaceInfo
-		OwnerCookie string
-		WorkspaceID string
-		Port        string
-		Expected    testResult
+		Name          string
+		Infos         []common.WorkspaceInfo
+		OwnerCookie   string
+		SessionCookie string
+		ShareToken    string
+		ShareCookie   string
+		Method        string
+		Accept        string
+		NoSessions    bool
+		WorkspaceID   string
+		Port          string
+		Expected      testResult
 	}{
 		{
 			Name:        "workspace not found",
@@ -210,6 +278,233 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				StatusCode:    http.StatusOK,
 			},
 		},
+		{
+			Name:        "organization port with owner cookie",
+			Infos:       orgPortInfos,
+			WorkspaceID: workspaceID,
+			OwnerCookie: ownerToken,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:          "organization port with member session",
+			Infos:         orgPortInfos,
+			WorkspaceID:   workspaceID,
+			SessionCookie: session("org-2", "org-1"),
+			Port:          strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:          "organization port with member session and wrong owner cookie",
+			Infos:         orgPortInfos,
+			WorkspaceID:   workspaceID,
+			OwnerCookie:   ownerToken + "-this-is-wrong",
+			SessionCookie: session("org-1"),
+			Port:          strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:          "organization port with non-member session",
+			Infos:         orgPortInfos,
+			WorkspaceID:   workspaceID,
+			SessionCookie: session("org-2"),
+			Port:          strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:          "organization port with invalid session",
+			Infos:         orgPortInfos,
+			WorkspaceID:   workspaceID,
+			SessionCookie: "not-a-jwt",
+			Port:          strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "organization port without session",
+			Infos:       orgPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "organization port without session from browser",
+			Infos:       orgPortInfos,
+			WorkspaceID: workspaceID,
+			Accept:      "text/html,application/xhtml+xml",
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusFound,
+				Location:      "https://test-domain.com/api/auth/workspace-session?returnTo=https%3A%2F%2Ftest-domain.com%2Fpath%3Fq%3D1",
+			},
+		},
+		{
+			Name:          "organization port without session verifier",
+			Infos:         orgPortInfos,
+			WorkspaceID:   workspaceID,
+			SessionCookie: session("org-1"),
+			NoSessions:    true,
+			Port:          strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "share link",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(nil),
+			Expected: testResult{
+				HandlerCalled:  false,
+				StatusCode:     http.StatusFound,
+				Location:       "/path?q=1",
+				ShareCookieSet: true,
+			},
+		},
+		{
+			Name:        "share link with POST request",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(nil),
+			Method:      http.MethodPost,
+			Expected: testResult{
+				HandlerCalled:  true,
+				StatusCode:     http.StatusOK,
+				ShareCookieSet: true,
+			},
+		},
+		{
+			Name:        "share cookie",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareCookie: share(nil),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:        "revoked share link",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(func(c *ShareClaims) { c.ID = "share-0" }),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "expired share link",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(func(c *ShareClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) }),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "share link for another port",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(func(c *ShareClaims) { c.Port = testPort + 1 }),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "share link for another workspace",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(func(c *ShareClaims) { c.Subject = "another-workspace" }),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "session token as share link",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  session("org-1"),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "share link for port which is not shared",
+			Infos:       ownerOnlyInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(nil),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "share link without session verifier",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(nil),
+			NoSessions:  true,
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:        "revoked share cookie",
+			Infos:       sharedPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareCookie: share(func(c *ShareClaims) { c.ID = "share-0" }),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "share link on public port",
+			Infos:       publicPortInfos,
+			WorkspaceID: workspaceID,
+			Port:        strconv.Itoa(testPort),
+			ShareToken:  share(func(c *ShareClaims) { c.ID = "share-0" }),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
 		{
 			Name:        "broken port without cookie",
 			Infos:       publicPortInfos,
@@ -225,16 +520,38 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var res testResult
-			handler := WorkspaceAuthHandler(domain, &fakeWsInfoProvider{infos: test.Infos})(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			verifier := sessions
+			if test.NoSessions {
+				verifier = nil
+			}
+			handler := WorkspaceAuthHandler(domain, &fakeWsInfoProvider{infos: test.Infos}, verifier)(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 				res.HandlerCalled = true
 				resp.WriteHeader(http.StatusOK)
 			}))
 
+			method := http.MethodGet
+			if test.Method != "" {
+				method = test.Method
+			}
+			target := fmt.Sprintf("http://%s/path?q=1", domain)
+			if test.ShareToken != "" {
+				target += "&" + ShareTokenParam + "=" + test.ShareToken
+			}
+
 			rr := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/", domain), nil)
+			req := httptest.NewRequest(method, target, nil)
 			if test.OwnerCookie != "" {
 				setOwnerTokenCookie(req, domain, instanceID, test.OwnerCookie)
 			}
+			if test.SessionCookie != "" {
+				req.AddCookie(sessionTokenCookie(domain, test.SessionCookie))
+			}
+			if test.ShareCookie != "" {
+				req.AddCookie(shareTokenCookie(domain, test.ShareCookie))
+			}
+			if test.Accept != "" {
+				req.Header.Set("Accept", test.Accept)
+			}
 			vars := map[string]string{
 				common.WorkspaceIDIdentifier: test.WorkspaceID,
 			}
@@ -245,6 +562,8 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 
 			handler.ServeHTTP(rr, req)
 			res.StatusCode = rr.Code
+			res.Location = rr.Header().Get("Location")
+			res.ShareCookieSet = strings.Contains(rr.Header().Get("Set-Cookie"), "_ws_share_=")
 
 			if diff := cmp.Diff(test.Expected, res); diff != "" {
 				t.Errorf("unexpected response (-want +got):\n%s", diff)
@@ -263,3 +582,15 @@ func ownerTokenCookie(domain, instanceID, token string) *http.Cookie {
 	domainPart = strings.ReplaceAll(domainPart, "-", "_")
 	return &http.Cookie{Name: "_" + domainPart + "_ws_" + instanceID + "_owner_", Value: token}
 }
+
+func sessionTokenCookie(domain, token string) *http.Cookie {
+	domainPart := strings.ReplaceAll(domain, ".", "_")
+	domainPart = strings.ReplaceAll(domainPart, "-", "_")
+	return &http.Cookie{Name: "_" + domainPart + "_ws_session_", Value: token}
+}
+
+func shareTokenCookie(domain, token string) *http.Cookie {
+	domainPart := strings.ReplaceAll(domain, ".", "_")
+	domainPart = strings.ReplaceAll(domainPart, "-", "_")
+	return &http.Cookie{Name: "_" + domainPart + "_ws_share_", Value: token}
+}
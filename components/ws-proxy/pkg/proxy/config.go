@@ -13,6 +13,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/sshproxy"
 )
 
 // Config is the configuration for a WorkspaceProxy.
@@ -29,6 +30,12 @@ type Config struct {
 
 	BuiltinPages        BuiltinPagesConfig `json:"builtinPages"`
 	SSHGatewayCAKeyFile string             `json:"sshCAKeyFile"`
+
+	// SSHGatewayAudit enables the audit log of the SSH gateway. If nil, connections are not audited.
+	SSHGatewayAudit *sshproxy.AuditConfig `json:"sshGatewayAudit,omitempty"`
+
+	// WorkspaceSession enables ports with organization visibility. If nil, such ports are treated as private.
+	WorkspaceSession *SessionTokenConfig `json:"workspaceSession,omitempty"`
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime.
@@ -47,6 +54,18 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	if c.WorkspaceSession != nil {
+		err := c.WorkspaceSession.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if c.SSHGatewayAudit != nil {
+		err := c.SSHGatewayAudit.Validate()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -56,6 +75,8 @@ type HostBasedIngressConfig struct {
 	HTTPAddress  string `json:"httpAddress"`
 	HTTPSAddress string `json:"httpsAddress"`
 	Header       string `json:"header"`
+	// TunnelAddress is the address the port tunnel for tcp and udp ports listens on. The tunnel is disabled if empty.
+	TunnelAddress string `json:"tunnelAddress,omitempty"`
 }
 
 // Validate validates this config.
@@ -55,6 +55,7 @@ func getPortStr(urlStr string) string {
 type ConnectionContext struct {
 	WorkspaceID string
 	Port        string
+	Grant       common.PortGrant
 	UUID        string
 	CancelFunc  context.CancelCauseFunc
 }
@@ -175,7 +176,7 @@ func (r *CRDWorkspaceInfoProvider) workspacesInfoByIPAddress(ipAddress string) (
 	return workspaces, nil
 }
 
-func (r *CRDWorkspaceInfoProvider) AcquireContext(ctx context.Context, workspaceID string, port string) (context.Context, string, error) {
+func (r *CRDWorkspaceInfoProvider) AcquireContext(ctx context.Context, workspaceID string, port string, grant common.PortGrant) (context.Context, string, error) {
 	ws := r.WorkspaceInfo(workspaceID)
 	if ws == nil {
 		return ctx, "", xerrors.Errorf("workspace %s not found", workspaceID)
@@ -185,6 +186,7 @@ func (r *CRDWorkspaceInfoProvider) AcquireContext(ctx context.Context, workspace
 	connCtx := &ConnectionContext{
 		WorkspaceID: workspaceID,
 		Port:        port,
+		Grant:       grant,
 		CancelFunc:  cancel,
 		UUID:        id,
 	}
@@ -217,16 +219,25 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 	for _, p := range ws.Spec.Ports {
 		v := wsapi.PortVisibility_PORT_VISIBILITY_PRIVATE
 		protocol := wsapi.PortProtocol_PORT_PROTOCOL_HTTP
-		if p.Visibility == workspacev1.AdmissionLevelEveryone {
+		switch p.Visibility {
+		case workspacev1.AdmissionLevelEveryone:
 			v = wsapi.PortVisibility_PORT_VISIBILITY_PUBLIC
+		case workspacev1.AdmissionLevelOrganization:
+			v = wsapi.PortVisibility_PORT_VISIBILITY_ORGANIZATION
 		}
-		if p.Protocol == workspacev1.PortProtocolHttps {
+		switch p.Protocol {
+		case workspacev1.PortProtocolHttps:
 			protocol = wsapi.PortProtocol_PORT_PROTOCOL_HTTPS
+		case workspacev1.PortProtocolTcp:
+			protocol = wsapi.PortProtocol_PORT_PROTOCOL_TCP
+		case workspacev1.PortProtocolUdp:
+			protocol = wsapi.PortProtocol_PORT_PROTOCOL_UDP
 		}
 		ports = append(ports, &wsapi.PortSpec{
 			Port:       p.Port,
 			Visibility: v,
 			Protocol:   protocol,
+			ShareId:    p.ShareID,
 		})
 	}
 
@@ -251,6 +262,7 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 		Auth:            &wsapi.WorkspaceAuthentication{Admission: admission, OwnerToken: ws.Status.OwnerToken},
 		StartedAt:       ws.CreationTimestamp.Time,
 		OwnerUserId:     ws.Spec.Ownership.Owner,
+		OrganizationID:  ws.Spec.Ownership.Team,
 		SSHPublicKeys:   ws.Spec.SshPublicKeys,
 		IsRunning:       ws.Status.Phase == workspacev1.WorkspacePhaseRunning,
 		IsEnabledSSHCA:  ws.Spec.SSHGatewayCAPublicKey != "",
@@ -276,11 +288,9 @@ func (r *CRDWorkspaceInfoProvider) invalidateConnectionContext(ws *common.Worksp
 	if ws.Auth != nil && ws.Auth.Admission == wsapi.AdmissionLevel_ADMIT_EVERYONE {
 		return
 	}
-	publicPorts := make(map[string]struct{})
+	ports := make(map[string]*api.PortSpec)
 	for _, p := range ws.Ports {
-		if p.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC {
-			publicPorts[strconv.FormatUint(uint64(p.Port), 10)] = struct{}{}
-		}
+		ports[strconv.FormatUint(uint64(p.Port), 10)] = p
 	}
 
 	for _, _connCtx := range connCtxs {
@@ -288,14 +298,26 @@ func (r *CRDWorkspaceInfoProvider) invalidateConnectionContext(ws *common.Worksp
 		if !ok {
 			continue
 		}
-		if _, ok := publicPorts[connCtx.Port]; ok {
+		if p, ok := ports[connCtx.Port]; ok && grantApplies(connCtx.Grant, p) {
 			continue
 		}
-		connCtx.CancelFunc(xerrors.Errorf("workspace %s is no longer public", ws.WorkspaceID))
+		connCtx.CancelFunc(xerrors.Errorf("port %s of workspace %s is no longer shared", connCtx.Port, ws.WorkspaceID))
 		r.contextStore.Delete(connCtx.UUID)
 	}
 }
 
+// grantApplies returns true if a connection granted access by grant may still access the port.
+// Connections granted to organization members or by a share link may stay open while the port is public.
+func grantApplies(grant common.PortGrant, port *api.PortSpec) bool {
+	if port.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC {
+		return true
+	}
+	if grant.ShareID != "" {
+		return grant.ShareID == port.ShareId
+	}
+	return grant.Visibility == port.Visibility
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *CRDWorkspaceInfoProvider) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -0,0 +1,390 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/components/scrubber"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+const (
+	// maxCapturedBodySize is the number of bytes of a request or response body which are captured
+	maxCapturedBodySize = 64 * 1024
+	// inspectedPortsTTL is how long we cache the ports of a workspace which are inspected
+	inspectedPortsTTL = 10 * time.Second
+	// maxPendingCaptures limits the number of captures which are sent to supervisors concurrently
+	maxPendingCaptures = 64
+)
+
+// redactedHeaders are never captured verbatim, because they carry credentials
+var redactedHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+	"Cookie":              {},
+	"Set-Cookie":          {},
+}
+
+// portInspector captures the HTTP requests of ports the workspace owner inspects and sends them to supervisor.
+// Supervisor decides which ports are inspected and keeps the captured requests.
+type portInspector struct {
+	supervisorPort uint16
+	client         *http.Client
+	pending        chan struct{}
+
+	mu    sync.Mutex
+	ports map[string]inspectedPorts
+}
+
+type inspectedPorts struct {
+	Ports      map[uint32]struct{}
+	Expires    time.Time
+	Refreshing bool
+}
+
+func newPortInspector(supervisorPort uint16) *portInspector {
+	return &portInspector{
+		supervisorPort: supervisorPort,
+		client:         &http.Client{Timeout: time.Second},
+		pending:        make(chan struct{}, maxPendingCaptures),
+		ports:          make(map[string]inspectedPorts),
+	}
+}
+
+// Handler captures requests to a workspace port if supervisor inspects the port.
+func (p *portInspector) Handler(infoProvider common.WorkspaceInfoProvider) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			coords := getWorkspaceCoords(req)
+			if coords.Debug || websocket.IsWebSocketUpgrade(req) {
+				h.ServeHTTP(resp, req)
+				return
+			}
+			port, err := strconv.ParseUint(coords.Port, 10, 16)
+			if err != nil {
+				h.ServeHTTP(resp, req)
+				return
+			}
+			info := infoProvider.WorkspaceInfo(coords.ID)
+			if info == nil || info.IPAddress == "" || !p.isInspected(coords.ID, info.IPAddress, uint32(port)) {
+				h.ServeHTTP(resp, req)
+				return
+			}
+
+			exchange := &supervisor.PortExchange{
+				Time:    timestamppb.Now(),
+				Request: captureRequest(req),
+			}
+			rw := &capturingResponseWriter{ResponseWriter: resp}
+			start := time.Now()
+			h.ServeHTTP(rw, req)
+			exchange.Duration = durationpb.New(time.Since(start))
+			exchange.Response = rw.captured()
+
+			p.record(info.IPAddress, uint32(port), exchange)
+		})
+	}
+}
+
+// isInspected answers from the cache and never waits for supervisor. If the cached ports of a workspace are missing or
+// expired, they are refreshed in the background, and until then the stale ports are used. Concurrent requests share a
+// single refresh. Hence, requests are only captured once supervisor told us that the port is inspected.
+func (p *portInspector) isInspected(workspaceID, ip string, port uint32) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cached, ok := p.ports[workspaceID]
+	if (!ok || time.Now().After(cached.Expires)) && !cached.Refreshing {
+		cached.Refreshing = true
+		p.ports[workspaceID] = cached
+		go p.refreshInspectedPorts(workspaceID, ip)
+	}
+
+	_, inspected := cached.Ports[port]
+	return inspected
+}
+
+// refreshInspectedPorts fetches the inspected ports of a workspace and caches them
+func (p *portInspector) refreshInspectedPorts(workspaceID, ip string) {
+	ports := p.fetchInspectedPorts(context.Background(), ip)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for id, c := range p.ports {
+		// stale ports are kept for a while, so that workspaces in use don't lose them between two refreshes
+		if !c.Refreshing && now.After(c.Expires.Add(inspectedPortsTTL)) {
+			delete(p.ports, id)
+		}
+	}
+	p.ports[workspaceID] = inspectedPorts{
+		Ports:   ports,
+		Expires: now.Add(inspectedPortsTTL),
+	}
+}
+
+// fetchInspectedPorts asks supervisor which ports are inspected. Any error is treated as if no port was inspected.
+func (p *portInspector) fetchInspectedPorts(ctx context.Context, ip string) map[uint32]struct{} {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.supervisorURL(ip, "/_supervisor/v1/port/inspect"), nil)
+	if err != nil {
+		return nil
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		log.WithError(err).Debug("cannot fetch inspected ports")
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil
+	}
+	var res supervisor.ListInspectedPortsResponse
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, &res)
+	if err != nil {
+		log.WithError(err).Debug("cannot parse inspected ports")
+		return nil
+	}
+
+	ports := make(map[uint32]struct{}, len(res.Ports))
+	for _, port := range res.Ports {
+		ports[port] = struct{}{}
+	}
+	return ports
+}
+
+// record sends a capture to supervisor without blocking the request. Captures are dropped if too many are pending.
+func (p *portInspector) record(ip string, port uint32, exchange *supervisor.PortExchange) {
+	select {
+	case p.pending <- struct{}{}:
+	default:
+		log.WithField("port", port).Debug("dropping captured request, too many are pending")
+		return
+	}
+
+	go func() {
+		defer func() { <-p.pending }()
+
+		err := p.sendCapture(ip, port, exchange)
+		if err != nil {
+			log.WithError(err).WithField("port", port).Debug("cannot record captured request")
+		}
+	}()
+}
+
+func (p *portInspector) sendCapture(ip string, port uint32, exchange *supervisor.PortExchange) error {
+	body, err := protojson.Marshal(&supervisor.RecordPortRequestRequest{Port: port, Exchange: exchange})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.supervisorURL(ip, fmt.Sprintf("/_supervisor/v1/port/inspect/%d/requests", port)), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("supervisor responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *portInspector) supervisorURL(ip, path string) string {
+	return (&url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(ip, strconv.Itoa(int(p.supervisorPort))),
+		Path:   path,
+	}).String()
+}
+
+// captureRequest captures the method, URI, scrubbed headers and the beginning of the scrubbed body of a request.
+// The request body is replaced so that it can still be read by the upstream server.
+func captureRequest(req *http.Request) *supervisor.CapturedRequest {
+	res := &supervisor.CapturedRequest{
+		Method:  req.Method,
+		Uri:     req.URL.RequestURI(),
+		Headers: scrubHeaders(req.Header),
+	}
+	res.Headers = append([]*supervisor.HTTPHeader{{Name: "Host", Values: []string{req.Host}}}, res.Headers...)
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return res
+	}
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, io.LimitReader(req.Body, maxCapturedBodySize+1))
+	body := buf.Bytes()
+	req.Body = &replayedBody{Reader: io.MultiReader(bytes.NewReader(body), req.Body), Closer: req.Body}
+	if err != nil {
+		// the upstream server will see the same error when reading the body
+		return res
+	}
+	if len(body) > maxCapturedBodySize {
+		body = body[:maxCapturedBodySize]
+		res.BodyTruncated = true
+	}
+	var captured bool
+	res.Body, captured = scrubBody(req.Header.Get("Content-Type"), body)
+	res.BodyTruncated = res.BodyTruncated || !captured
+	return res
+}
+
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// capturingResponseWriter captures the status, headers and beginning of the body of a response
+type capturingResponseWriter struct {
+	http.ResponseWriter
+
+	status    int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (w *capturingResponseWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *capturingResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if remaining := maxCapturedBodySize - w.body.Len(); remaining > 0 {
+		if len(b) > remaining {
+			w.body.Write(b[:remaining])
+			w.truncated = true
+		} else {
+			w.body.Write(b)
+		}
+	} else if len(b) > 0 {
+		w.truncated = true
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *capturingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *capturingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, xerrors.Errorf("response writer does not support hijacking")
+	}
+	return h.Hijack()
+}
+
+func (w *capturingResponseWriter) captured() *supervisor.CapturedResponse {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := w.Header()
+	body, captured := scrubBody(header.Get("Content-Type"), w.body.Bytes())
+	return &supervisor.CapturedResponse{
+		StatusCode:    uint32(status),
+		Headers:       scrubHeaders(header),
+		Body:          body,
+		BodyTruncated: w.truncated || !captured,
+	}
+}
+
+func scrubHeaders(header http.Header) []*supervisor.HTTPHeader {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]*supervisor.HTTPHeader, 0, len(names))
+	for _, name := range names {
+		values := make([]string, len(header[name]))
+		for i, v := range header[name] {
+			if _, redact := redactedHeaders[http.CanonicalHeaderKey(name)]; redact {
+				values[i] = scrubber.SanitiseRedact(v)
+				continue
+			}
+			values[i] = scrubber.Default.Value(scrubber.Default.KeyValue(name, v))
+		}
+		res = append(res, &supervisor.HTTPHeader{Name: name, Values: values})
+	}
+	return res
+}
+
+// scrubBody scrubs JSON, form and text bodies. Other bodies are not captured as they could contain anything,
+// in which case captured is false.
+func scrubBody(contentType string, body []byte) (scrubbed []byte, captured bool) {
+	if len(body) == 0 {
+		return nil, true
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		res, err := scrubber.Default.JSON(json.RawMessage(body))
+		if err != nil {
+			// most likely the body was truncated
+			return []byte(scrubber.Default.Value(string(body))), true
+		}
+		return res, true
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []byte(scrubber.Default.Value(string(body))), true
+		}
+		for key, vs := range values {
+			for i, v := range vs {
+				vs[i] = scrubber.Default.KeyValue(key, v)
+			}
+		}
+		return []byte(values.Encode()), true
+	case strings.HasPrefix(mediaType, "text/"), mediaType == "application/xml", mediaType == "application/javascript", mediaType == "":
+		return []byte(scrubber.Default.Value(string(body))), true
+	default:
+		return nil, false
+	}
+}
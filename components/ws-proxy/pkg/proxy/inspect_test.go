@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func TestPortInspector(t *testing.T) {
+	recorded := make(chan *supervisor.RecordPortRequestRequest, 10)
+	supervisorSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/_supervisor/v1/port/inspect":
+			_, _ = w.Write([]byte(`{"ports":[3000]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/_supervisor/v1/port/inspect/3000/requests":
+			body, _ := io.ReadAll(r.Body)
+			var req supervisor.RecordPortRequestRequest
+			err := protojson.Unmarshal(body, &req)
+			if err != nil {
+				t.Errorf("cannot unmarshal recorded request: %v", err)
+			}
+			recorded <- &req
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer supervisorSrv.Close()
+	_, p, _ := net.SplitHostPort(supervisorSrv.Listener.Addr().String())
+	supervisorPort, _ := strconv.ParseUint(p, 10, 16)
+
+	inspector := newPortInspector(uint16(supervisorPort))
+	infoProvider := &fakeWsInfoProvider{infos: []common.WorkspaceInfo{{WorkspaceID: "ws-1", IPAddress: "127.0.0.1"}}}
+	handler := inspector.Handler(infoProvider)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"echo":` + strconv.Quote(string(body)) + `}`))
+	}))
+
+	serve := func(port string, body string) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "http://3000-ws-1.ws.gitpod.io/hook?id=1", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer secret-token")
+		req = mux.SetURLVars(req, map[string]string{
+			common.WorkspaceIDIdentifier:   "ws-1",
+			common.WorkspacePortIdentifier: port,
+		})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+
+	// the inspected ports are fetched in the background
+	waitForInspected(t, inspector, "ws-1", "127.0.0.1", 3000)
+
+	resp := serve("3000", `{"event":"push","password":"hunter2"}`)
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusAccepted || !strings.Contains(string(respBody), "hunter2") {
+		t.Fatalf("request was not forwarded unchanged: %d %s", resp.StatusCode, respBody)
+	}
+
+	var rec *supervisor.RecordPortRequestRequest
+	select {
+	case rec = <-recorded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not recorded")
+	}
+	exchange := rec.Exchange
+	if rec.Port != 3000 || exchange.Time == nil || exchange.Duration == nil {
+		t.Errorf("unexpected exchange: %v", rec)
+	}
+	expectedRequest := &supervisor.CapturedRequest{
+		Method: http.MethodPost,
+		Uri:    "/hook?id=1",
+		Headers: []*supervisor.HTTPHeader{
+			{Name: "Host", Values: []string{"3000-ws-1.ws.gitpod.io"}},
+			{Name: "Authorization", Values: []string{"[redacted]"}},
+			{Name: "Content-Type", Values: []string{"application/json"}},
+		},
+		Body: []byte(`{"event":"push","password":"[redacted]"}`),
+	}
+	if diff := cmp.Diff(expectedRequest, exchange.Request, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected captured request (-want +got):\n%s", diff)
+	}
+	if exchange.Response.StatusCode != http.StatusAccepted {
+		t.Errorf("unexpected captured status code %d", exchange.Response.StatusCode)
+	}
+	for _, h := range exchange.Response.Headers {
+		if h.Name == "Set-Cookie" && h.Values[0] != "[redacted]" {
+			t.Errorf("response cookies were not redacted: %v", h.Values)
+		}
+	}
+
+	resp = serve("8080", `{}`)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("unexpected status code %d", resp.StatusCode)
+	}
+	select {
+	case rec = <-recorded:
+		t.Errorf("request to a port which is not inspected was recorded: %v", rec)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPortInspectorRefreshesInBackground(t *testing.T) {
+	var (
+		fetches int32
+		release = make(chan struct{})
+	)
+	supervisorSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"ports":[3000]}`))
+	}))
+	defer supervisorSrv.Close()
+	_, p, _ := net.SplitHostPort(supervisorSrv.Listener.Addr().String())
+	supervisorPort, _ := strconv.ParseUint(p, 10, 16)
+
+	inspector := newPortInspector(uint16(supervisorPort))
+	for i := 0; i < 10; i++ {
+		if inspector.isInspected("ws-1", "127.0.0.1", 3000) {
+			t.Fatal("port is inspected before supervisor responded")
+		}
+	}
+	close(release)
+	waitForInspected(t, inspector, "ws-1", "127.0.0.1", 3000)
+
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Errorf("expected a single fetch of the inspected ports, got %d", n)
+	}
+}
+
+func TestCaptureRequestDropsBinaryBodies(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "http://3000-ws-1.ws.gitpod.io/upload", strings.NewReader("\x00\x01"))
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	captured := captureRequest(req)
+	if captured.Body != nil || !captured.BodyTruncated {
+		t.Errorf("unexpected capture: body=%q truncated=%v", captured.Body, captured.BodyTruncated)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if string(body) != "\x00\x01" {
+		t.Errorf("request body was not preserved: %q", body)
+	}
+}
+
+// waitForInspected waits until the inspector knows that the port is inspected
+func waitForInspected(t *testing.T, inspector *portInspector, workspaceID, ip string, port uint32) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !inspector.isInspected(workspaceID, ip, port) {
+		if time.Now().After(deadline) {
+			t.Fatalf("port %d was not inspected in time", port)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCapturingResponseWriterTruncates(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := &capturingResponseWriter{ResponseWriter: rec}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(strings.Repeat("a", maxCapturedBodySize-1)))
+	_, _ = w.Write([]byte("bb"))
+
+	captured := w.captured()
+	if !captured.BodyTruncated || len(captured.Body) != maxCapturedBodySize || captured.StatusCode != http.StatusOK {
+		t.Errorf("unexpected capture: truncated=%v len=%d status=%d", captured.BodyTruncated, len(captured.Body), captured.StatusCode)
+	}
+	if rec.Body.Len() != maxCapturedBodySize+1 {
+		t.Errorf("response was not written entirely: %d bytes", rec.Body.Len())
+	}
+}
@@ -110,6 +110,34 @@ func (p *WorkspaceProxy) MustServe(ctx context.Context) {
 		}
 	}()
 
+	if p.Ingress.TunnelAddress != "" {
+		cert, err := tls.LoadX509KeyPair(crt, key)
+		if err != nil {
+			log.WithError(err).Fatal("cannot load port tunnel certificate")
+		}
+		l, err := tls.Listen("tcp", p.Ingress.TunnelAddress, &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		})
+		if err != nil {
+			log.WithError(err).Fatal("cannot start port tunnel")
+		}
+		defer l.Close()
+
+		sessions, err := NewSessionVerifier(p.Config.WorkspaceSession)
+		if err != nil {
+			log.WithError(err).Fatal("cannot load workspace session keys")
+		}
+		tunnel := NewPortTunnel(p.Config.GitpodInstallation.WorkspaceHostSuffix, p.WorkspaceInfoProvider, sessions, time.Duration(p.Config.TransportConfig.ConnectTimeout))
+		go func() {
+			log.WithField("address", p.Ingress.TunnelAddress).Info("starting port tunnel")
+			err := tunnel.Serve(l)
+			if err != nil {
+				log.WithError(err).Fatal("port tunnel failed")
+			}
+		}()
+	}
+
 	<-ctx.Done()
 
 	shutDownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
@@ -134,8 +162,13 @@ func (p *WorkspaceProxy) Handler() (http.Handler, error) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	sessions, err := NewSessionVerifier(p.Config.WorkspaceSession)
+	if err != nil {
+		return nil, err
+	}
+
 	// install routes
-	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider))
+	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider, sessions))
 	if err != nil {
 		return nil, err
 	}
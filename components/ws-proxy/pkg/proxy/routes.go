@@ -50,10 +50,10 @@ type RouteHandlerConfig struct {
 // RouteHandlerConfigOpt modifies the router handler config.
 type RouteHandlerConfigOpt func(*Config, *RouteHandlerConfig)
 
-// WithDefaultAuth enables workspace access authentication.
-func WithDefaultAuth(infoprov common.WorkspaceInfoProvider) RouteHandlerConfigOpt {
+// WithDefaultAuth enables workspace access authentication. sessions may be nil if organization visibility is disabled.
+func WithDefaultAuth(infoprov common.WorkspaceInfoProvider, sessions *SessionVerifier) RouteHandlerConfigOpt {
 	return func(config *Config, c *RouteHandlerConfig) {
-		c.WorkspaceAuthHandler = WorkspaceAuthHandler(config.GitpodInstallation.HostName, infoprov)
+		c.WorkspaceAuthHandler = WorkspaceAuthHandler(config.GitpodInstallation.HostName, infoprov, sessions)
 	}
 }
 
@@ -537,6 +537,8 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 	r.Use(config.WorkspaceAuthHandler)
 	// filter all session cookies
 	r.Use(sensitiveCookieHandler(config.Config.GitpodInstallation.HostName))
+	// capture requests to ports the owner inspects using `gp ports inspect`
+	r.Use(newPortInspector(config.Config.WorkspacePodConfig.SupervisorPort).Handler(infoProvider))
 
 	// forward request to workspace port
 	r.NewRoute().HandlerFunc(
@@ -656,6 +658,9 @@ func buildWorkspacePodURL(protocol api.PortProtocol, ipAddress string, port stri
 		portProtocol = "http"
 	case api.PortProtocol_PORT_PROTOCOL_HTTPS:
 		portProtocol = "https"
+	case api.PortProtocol_PORT_PROTOCOL_TCP, api.PortProtocol_PORT_PROTOCOL_UDP:
+		// those ports are only reachable through the port tunnel
+		return nil, xerrors.Errorf("port %s is exposed as %s and cannot be proxied over HTTP", port, protocol)
 	default:
 		return nil, xerrors.Errorf("protocol not supported")
 	}
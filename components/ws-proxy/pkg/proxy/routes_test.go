@@ -861,7 +861,7 @@ func (p *fakeWsInfoProvider) WorkspaceInfo(workspaceID string) *common.Workspace
 	return nil
 }
 
-func (p *fakeWsInfoProvider) AcquireContext(ctx context.Context, workspaceID string, port string) (context.Context, string, error) {
+func (p *fakeWsInfoProvider) AcquireContext(ctx context.Context, workspaceID string, port string, grant common.PortGrant) (context.Context, string, error) {
 	return ctx, "", nil
 }
 func (p *fakeWsInfoProvider) ReleaseContext(id string) {
@@ -991,6 +991,7 @@ func TestRemoveSensitiveCookies(t *testing.T) {
 		portAuthCookie          = &http.Cookie{Domain: domain, Name: "_test_domain_com_ws_77f6b236_3456_4b88_8284_81ca543a9d65_port_auth_", Value: "some-token"}
 		ownerCookie             = &http.Cookie{Domain: domain, Name: "_test_domain_com_ws_77f6b236_3456_4b88_8284_81ca543a9d65_owner_", Value: "some-other-token"}
 		ownerCookieGen          = ownerTokenCookie(domain, "77f6b236_3456_4b88_8284_81ca543a9d65", "owner-token-gen")
+		workspaceSessionCookie  = sessionTokenCookie(domain, "session-token")
 		miscCookie              = &http.Cookie{Domain: domain, Name: "some-other-cookie", Value: "I like cookies"}
 		invalidCookieName       = &http.Cookie{Domain: domain, Name: "foobar[0]", Value: "violates RFC6266"}
 	)
@@ -1007,6 +1008,7 @@ func TestRemoveSensitiveCookies(t *testing.T) {
 		{Name: "portAuth cookie", Input: []*http.Cookie{portAuthCookie, miscCookie}, Expected: []*http.Cookie{miscCookie}},
 		{Name: "owner cookie", Input: []*http.Cookie{ownerCookie, miscCookie}, Expected: []*http.Cookie{miscCookie}},
 		{Name: "owner cookie generated", Input: []*http.Cookie{ownerCookieGen, miscCookie}, Expected: []*http.Cookie{miscCookie}},
+		{Name: "workspace session cookie", Input: []*http.Cookie{workspaceSessionCookie, miscCookie}, Expected: []*http.Cookie{miscCookie}},
 		{Name: "misc cookie", Input: []*http.Cookie{miscCookie}, Expected: []*http.Cookie{miscCookie}},
 		{Name: "invalid cookie name", Input: []*http.Cookie{invalidCookieName}, Expected: []*http.Cookie{invalidCookieName}},
 	}
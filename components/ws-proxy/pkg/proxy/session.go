@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// SessionTokenAudience is the audience of workspace session tokens. Tokens for other audiences,
+	// e.g. regular Gitpod sessions, are rejected.
+	SessionTokenAudience = "workspace-ports"
+
+	// ShareTokenAudience is the audience of port share tokens, which are embedded in share links.
+	ShareTokenAudience = "workspace-port-share"
+)
+
+// SessionTokenConfig configures the verification of workspace session and port share tokens. Session tokens are issued
+// by server and authenticate members of the organization which owns a workspace. Share tokens are issued by
+// public-api-server and grant access to a single port until they expire or the owner revokes them.
+type SessionTokenConfig struct {
+	// Issuer is the expected issuer of session tokens
+	Issuer string `json:"issuer"`
+	// Keys are the keys session tokens may be signed with
+	Keys []SessionTokenKey `json:"keys"`
+}
+
+// SessionTokenKey is a public key which session tokens may be signed with
+type SessionTokenKey struct {
+	// ID is the key ID tokens refer to in their header
+	ID string `json:"id"`
+	// PublicKeyPath points to a PEM encoded RSA public key or certificate
+	PublicKeyPath string `json:"publicKeyPath"`
+}
+
+// Validate validates the configuration.
+func (c *SessionTokenConfig) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Issuer, validation.Required),
+		validation.Field(&c.Keys, validation.Required),
+	)
+}
+
+// SessionClaims are the claims of a workspace session token
+type SessionClaims struct {
+	jwt.RegisteredClaims
+
+	// Organizations lists the IDs of all organizations the user is a member of
+	Organizations []string `json:"orgs"`
+}
+
+// IsMember returns true if the user is a member of the given organization
+func (c *SessionClaims) IsMember(organizationID string) bool {
+	if organizationID == "" {
+		return false
+	}
+	for _, org := range c.Organizations {
+		if org == organizationID {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareClaims are the claims of a port share token. The subject is the workspace ID and the token ID
+// must match the share ID of the port for the token to be valid.
+type ShareClaims struct {
+	jwt.RegisteredClaims
+
+	// Port is the workspace port the token grants access to
+	Port uint32 `json:"port"`
+}
+
+// SessionVerifier verifies workspace session and port share tokens
+type SessionVerifier struct {
+	issuer string
+	keys   map[string]*rsa.PublicKey
+}
+
+// NewSessionVerifier loads the keys of the configuration. It returns nil if cfg is nil.
+func NewSessionVerifier(cfg *SessionTokenConfig) (*SessionVerifier, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(cfg.Keys))
+	for _, k := range cfg.Keys {
+		if _, exists := keys[k.ID]; exists {
+			return nil, xerrors.Errorf("duplicate session token key %s", k.ID)
+		}
+		key, err := readPublicKey(k.PublicKeyPath)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read session token key %s: %w", k.ID, err)
+		}
+		keys[k.ID] = key
+	}
+
+	return &SessionVerifier{
+		issuer: cfg.Issuer,
+		keys:   keys,
+	}, nil
+}
+
+// Verify checks the signature, audience, issuer and expiry of a session token and returns its claims.
+func (v *SessionVerifier) Verify(token string) (*SessionClaims, error) {
+	var claims SessionClaims
+	err := v.verify(token, &claims, SessionTokenAudience)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid session token: %w", err)
+	}
+	return &claims, nil
+}
+
+// VerifyShare checks the signature, audience, issuer and expiry of a port share token and returns its claims.
+// Callers must check that the claims match the workspace, port and share ID.
+func (v *SessionVerifier) VerifyShare(token string) (*ShareClaims, error) {
+	var claims ShareClaims
+	err := v.verify(token, &claims, ShareTokenAudience)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid share token: %w", err)
+	}
+	return &claims, nil
+}
+
+func (v *SessionVerifier) verify(token string, claims jwt.Claims, audience string) error {
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, xerrors.Errorf("token has no key ID")
+		}
+		key, ok := v.keys[kid]
+		if !ok {
+			return nil, xerrors.Errorf("token was signed with unknown key %s", kid)
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithAudience(audience),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	return err
+}
+
+func readPublicKey(fn string) (*rsa.PublicKey, error) {
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, xerrors.Errorf("%s does not contain a PEM block", fn)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = cert.PublicKey
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, xerrors.Errorf("%s does not contain an RSA public key", fn)
+	}
+	return res, nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testSessionIssuer = "https://test-domain.com"
+	testSessionKeyID  = "0001"
+)
+
+func TestSessionVerifier(t *testing.T) {
+	key := generateSessionKey(t)
+	otherKey := generateSessionKey(t)
+	verifier := newTestSessionVerifier(t, key)
+
+	validClaims := func() *SessionClaims {
+		return &SessionClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testSessionIssuer,
+				Subject:   "user-id",
+				Audience:  jwt.ClaimStrings{SessionTokenAudience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Organizations: []string{"org-1", "org-2"},
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Token   func() string
+		Error   string
+		Members []string
+	}{
+		{
+			Name:    "valid",
+			Token:   func() string { return signSessionToken(t, key, testSessionKeyID, validClaims()) },
+			Members: []string{"org-1", "org-2"},
+		},
+		{
+			Name: "expired",
+			Token: func() string {
+				c := validClaims()
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+				return signSessionToken(t, key, testSessionKeyID, c)
+			},
+			Error: "token is expired",
+		},
+		{
+			Name: "no expiry",
+			Token: func() string {
+				c := validClaims()
+				c.ExpiresAt = nil
+				return signSessionToken(t, key, testSessionKeyID, c)
+			},
+			Error: "token is missing required claim",
+		},
+		{
+			Name: "wrong audience",
+			Token: func() string {
+				c := validClaims()
+				c.Audience = jwt.ClaimStrings{"gitpod"}
+				return signSessionToken(t, key, testSessionKeyID, c)
+			},
+			Error: "token has invalid audience",
+		},
+		{
+			Name: "wrong issuer",
+			Token: func() string {
+				c := validClaims()
+				c.Issuer = "https://evil.com"
+				return signSessionToken(t, key, testSessionKeyID, c)
+			},
+			Error: "token has invalid issuer",
+		},
+		{
+			Name:  "unknown key",
+			Token: func() string { return signSessionToken(t, key, "0002", validClaims()) },
+			Error: "token was signed with unknown key 0002",
+		},
+		{
+			Name:  "wrong key",
+			Token: func() string { return signSessionToken(t, otherKey, testSessionKeyID, validClaims()) },
+			Error: "token signature is invalid",
+		},
+		{
+			Name: "wrong algorithm",
+			Token: func() string {
+				tkn := jwt.NewWithClaims(jwt.SigningMethodRS512, validClaims())
+				tkn.Header["kid"] = testSessionKeyID
+				res, err := tkn.SignedString(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return res
+			},
+			Error: "token signature is invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			claims, err := verifier.Verify(test.Token())
+			if test.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), test.Error) {
+					t.Fatalf("expected error containing %q, got %v", test.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, org := range test.Members {
+				if !claims.IsMember(org) {
+					t.Errorf("expected user to be a member of %s", org)
+				}
+			}
+			if claims.IsMember("") || claims.IsMember("org-3") {
+				t.Errorf("unexpected membership")
+			}
+		})
+	}
+}
+
+func TestNewSessionVerifier(t *testing.T) {
+	key := generateSessionKey(t)
+
+	certFn := filepath.Join(t.TempDir(), "tls.crt")
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour)}
+	cert, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(certFn, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewSessionVerifier(&SessionTokenConfig{
+		Issuer: testSessionIssuer,
+		Keys:   []SessionTokenKey{{ID: testSessionKeyID, PublicKeyPath: certFn}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = verifier.Verify(signSessionToken(t, key, testSessionKeyID, &SessionClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testSessionIssuer,
+			Audience:  jwt.ClaimStrings{SessionTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}))
+	if err != nil {
+		t.Errorf("cannot verify token using a key from a certificate: %v", err)
+	}
+
+	verifier, err = NewSessionVerifier(nil)
+	if verifier != nil || err != nil {
+		t.Errorf("expected no verifier without configuration, got %v, %v", verifier, err)
+	}
+}
+
+func generateSessionKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestSessionVerifier(t *testing.T, key *rsa.PrivateKey) *SessionVerifier {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := filepath.Join(t.TempDir(), "session.pub")
+	err = os.WriteFile(fn, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewSessionVerifier(&SessionTokenConfig{
+		Issuer: testSessionIssuer,
+		Keys:   []SessionTokenKey{{ID: testSessionKeyID, PublicKeyPath: fn}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return verifier
+}
+
+func signSessionToken(t *testing.T, key *rsa.PrivateKey, kid string, claims *SessionClaims) string {
+	tkn := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tkn.Header["kid"] = kid
+	res, err := tkn.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
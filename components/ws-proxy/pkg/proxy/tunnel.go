@@ -0,0 +1,335 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+const (
+	// tunnelHandshakeTimeout is the time a client has to complete the TLS handshake.
+	tunnelHandshakeTimeout = 10 * time.Second
+	// tunnelMaxDatagramSize is the largest UDP datagram forwarded through the tunnel.
+	tunnelMaxDatagramSize = 65507
+	// tunnelMaxTokenSize is the largest owner token a client may send.
+	tunnelMaxTokenSize = 1024
+)
+
+// PortTunnel forwards raw TCP streams and UDP datagrams to workspace ports which are exposed
+// with the tcp or udp protocol.
+//
+// Clients connect using TLS and pass the host name of the workspace port, e.g.
+// 5432-coral-dragon-ilr0r6eq.ws-eu10.gitpod.io, as server name (SNI). The server name
+// determines the workspace and port the connection is forwarded to.
+//
+// Right after the handshake the client sends a token, prefixed with its length as 16 bit
+// big-endian integer. Private ports require the owner token of the workspace. Ports with
+// organization visibility also accept the workspace session token of a member of the
+// organization which owns the workspace, which keeps the connection open until it expires.
+// Public ports and the ports of workspaces shared with everyone accept an empty token. For udp
+// ports every datagram is then prefixed with its length the same way.
+//
+// `gitpod workspace tunnel` of the local-app is a client for the tunnel.
+type PortTunnel struct {
+	InfoProvider   common.WorkspaceInfoProvider
+	ConnectTimeout time.Duration
+	// Sessions verifies the session tokens of organization members. Without it ports with
+	// organization visibility are treated as private.
+	Sessions *SessionVerifier
+
+	hostRegex *regexp.Regexp
+}
+
+// NewPortTunnel creates a new port tunnel for the given workspace host suffix.
+func NewPortTunnel(wsHostSuffix string, infoProvider common.WorkspaceInfoProvider, sessions *SessionVerifier, connectTimeout time.Duration) *PortTunnel {
+	return &PortTunnel{
+		InfoProvider:   infoProvider,
+		ConnectTimeout: connectTimeout,
+		Sessions:       sessions,
+		hostRegex:      regexp.MustCompile("^" + workspacePortRegex + workspaceIDRegex + wsHostSuffix + "$"),
+	}
+}
+
+// Serve accepts connections on the listener until it is closed. The listener is
+// expected to be a TLS listener, see tls.NewListener.
+func (t *PortTunnel) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		tlsConn, ok := conn.(*tls.Conn)
+		if !ok {
+			log.Error("port tunnel accepted a connection without TLS")
+			conn.Close()
+			continue
+		}
+		go t.handleConn(tlsConn)
+	}
+}
+
+func (t *PortTunnel) handleConn(conn *tls.Conn) {
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(tunnelHandshakeTimeout))
+	err := conn.Handshake()
+	if err != nil {
+		log.WithError(err).Debug("port tunnel TLS handshake failed")
+		return
+	}
+	token, err := readDatagram(conn, make([]byte, tunnelMaxTokenSize))
+	if err != nil {
+		log.WithError(err).Debug("port tunnel client did not send a token")
+		return
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	serverName := conn.ConnectionState().ServerName
+	workspaceID, port, ok := t.parseServerName(serverName)
+	if !ok {
+		log.WithField("serverName", serverName).Debug("port tunnel connection for unknown host")
+		return
+	}
+	logger := log.WithFields(log.OWI("", workspaceID, "")).WithField("port", port)
+
+	ws := t.InfoProvider.WorkspaceInfo(workspaceID)
+	if ws == nil {
+		logger.Debug("port tunnel connection for unknown workspace")
+		return
+	}
+	spec := tunnelPortSpec(ws, port)
+	if spec == nil {
+		logger.Debug("port tunnel connection for port which is not exposed as tcp or udp")
+		return
+	}
+	owner, visibility, expiry, ok := t.authorize(ws, spec, token)
+	if !ok {
+		logger.WithField("visibility", visibility.String()).Debug("port tunnel connection without access to the port")
+		return
+	}
+
+	ctx := context.Background()
+	if !owner {
+		var id string
+		ctx, id, err = t.InfoProvider.AcquireContext(ctx, workspaceID, strconv.FormatUint(uint64(port), 10), common.PortGrant{Visibility: visibility})
+		if err != nil {
+			logger.WithError(err).Error("cannot acquire context")
+			return
+		}
+		defer t.InfoProvider.ReleaseContext(id)
+	}
+	if !expiry.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, expiry)
+		defer cancel()
+	}
+
+	// for connections without owner token the context is cancelled once the port is no longer shared
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	target := net.JoinHostPort(ws.IPAddress, strconv.FormatUint(uint64(port), 10))
+	switch spec.Protocol {
+	case api.PortProtocol_PORT_PROTOCOL_TCP:
+		err = t.forwardTCP(ctx, conn, target)
+	case api.PortProtocol_PORT_PROTOCOL_UDP:
+		err = t.forwardUDP(ctx, conn, target)
+	}
+	if err != nil && ctx.Err() == nil {
+		logger.WithError(err).Debug("port tunnel connection failed")
+	}
+}
+
+// parseServerName returns the workspace ID and port of a workspace port host name.
+func (t *PortTunnel) parseServerName(serverName string) (workspaceID string, port uint32, ok bool) {
+	matches := t.hostRegex.FindStringSubmatch(serverName)
+	if matches == nil {
+		return "", 0, false
+	}
+	prt, err := strconv.ParseUint(matches[t.hostRegex.SubexpIndex(common.WorkspacePortIdentifier)], 10, 16)
+	if err != nil {
+		return "", 0, false
+	}
+	return matches[t.hostRegex.SubexpIndex(common.WorkspaceIDIdentifier)], uint32(prt), true
+}
+
+// tunnelPortSpec returns the spec of the port if it is exposed as tcp or udp.
+func tunnelPortSpec(ws *common.WorkspaceInfo, port uint32) *api.PortSpec {
+	for _, p := range ws.Ports {
+		if p.Port != port {
+			continue
+		}
+		if p.Protocol != api.PortProtocol_PORT_PROTOCOL_TCP && p.Protocol != api.PortProtocol_PORT_PROTOCOL_UDP {
+			return nil
+		}
+		return p
+	}
+	return nil
+}
+
+// authorize checks whether a client which sent token may access the port. Clients with the owner token
+// may access every port. Other clients are granted access with the visibility of the port, organization
+// members until their session token expires.
+func (t *PortTunnel) authorize(ws *common.WorkspaceInfo, spec *api.PortSpec, token []byte) (owner bool, visibility api.PortVisibility, expiry time.Time, ok bool) {
+	if len(token) > 0 && ws.Auth != nil && subtle.ConstantTimeCompare(token, []byte(ws.Auth.OwnerToken)) == 1 {
+		return true, spec.Visibility, time.Time{}, true
+	}
+
+	visibility = spec.Visibility
+	if ws.Auth != nil && ws.Auth.Admission == api.AdmissionLevel_ADMIT_EVERYONE {
+		visibility = api.PortVisibility_PORT_VISIBILITY_PUBLIC
+	}
+	if visibility == api.PortVisibility_PORT_VISIBILITY_ORGANIZATION && t.Sessions == nil {
+		visibility = api.PortVisibility_PORT_VISIBILITY_PRIVATE
+	}
+
+	switch visibility {
+	case api.PortVisibility_PORT_VISIBILITY_PUBLIC:
+		return false, visibility, time.Time{}, true
+	case api.PortVisibility_PORT_VISIBILITY_ORGANIZATION:
+		if len(token) == 0 {
+			return false, visibility, time.Time{}, false
+		}
+		claims, err := t.Sessions.Verify(string(token))
+		if err != nil {
+			log.WithError(err).Debug("cannot verify workspace session")
+			return false, visibility, time.Time{}, false
+		}
+		if !claims.IsMember(ws.OrganizationID) {
+			return false, visibility, time.Time{}, false
+		}
+		return false, visibility, claims.ExpiresAt.Time, true
+	default:
+		return false, visibility, time.Time{}, false
+	}
+}
+
+func (t *PortTunnel) forwardTCP(ctx context.Context, conn net.Conn, target string) error {
+	dialer := net.Dialer{Timeout: t.ConnectTimeout}
+	upstream, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return xerrors.Errorf("cannot connect to %s: %w", target, err)
+	}
+	defer upstream.Close()
+
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, 2)
+	)
+	pipe := func(dst, src net.Conn) {
+		defer wg.Done()
+		_, err := io.Copy(dst, src)
+		errs <- err
+		// propagate the end of the stream so that half-closed connections keep working
+		if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			_ = cw.CloseWrite()
+		}
+	}
+	wg.Add(2)
+	go pipe(upstream, conn)
+	go pipe(conn, upstream)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *PortTunnel) forwardUDP(ctx context.Context, conn net.Conn, target string) error {
+	dialer := net.Dialer{Timeout: t.ConnectTimeout}
+	upstream, err := dialer.DialContext(ctx, "udp", target)
+	if err != nil {
+		return xerrors.Errorf("cannot connect to %s: %w", target, err)
+	}
+	defer upstream.Close()
+
+	// responses are forwarded until the client closes the tunnel
+	go func() {
+		buf := make([]byte, tunnelMaxDatagramSize)
+		for {
+			n, err := upstream.Read(buf)
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					conn.Close()
+				}
+				return
+			}
+			err = writeDatagram(conn, buf[:n])
+			if err != nil {
+				upstream.Close()
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, tunnelMaxDatagramSize)
+	for {
+		datagram, err := readDatagram(conn, buf)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		_, err = upstream.Write(datagram)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readDatagram reads a length-prefixed datagram from r into buf.
+func readDatagram(r io.Reader, buf []byte) ([]byte, error) {
+	var size [2]byte
+	_, err := io.ReadFull(r, size[:])
+	if err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(size[:]))
+	if n > len(buf) {
+		return nil, xerrors.Errorf("datagram of %d bytes exceeds the maximum size", n)
+	}
+	_, err = io.ReadFull(r, buf[:n])
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// writeDatagram writes a length-prefixed datagram to w.
+func writeDatagram(w io.Writer, datagram []byte) error {
+	msg := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(msg, uint16(len(datagram)))
+	copy(msg[2:], datagram)
+	_, err := w.Write(msg)
+	return err
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func TestPortTunnelParseServerName(t *testing.T) {
+	type Expectation struct {
+		WorkspaceID string
+		Port        uint32
+		OK          bool
+	}
+	tests := []struct {
+		Name        string
+		ServerName  string
+		Expectation Expectation
+	}{
+		{
+			Name:        "workspace port",
+			ServerName:  "5432-amaranth-smelt-9ba20cc1.ws.test-domain.com",
+			Expectation: Expectation{WorkspaceID: "amaranth-smelt-9ba20cc1", Port: 5432, OK: true},
+		},
+		{
+			Name:       "workspace without port",
+			ServerName: "amaranth-smelt-9ba20cc1.ws.test-domain.com",
+		},
+		{
+			Name:       "other domain",
+			ServerName: "5432-amaranth-smelt-9ba20cc1.ws.test-domain.com.evil.com",
+		},
+		{
+			Name:       "invalid port",
+			ServerName: "99999-amaranth-smelt-9ba20cc1.ws.test-domain.com",
+		},
+	}
+
+	tunnel := NewPortTunnel(config.GitpodInstallation.WorkspaceHostSuffix, &fakeWsInfoProvider{}, nil, time.Second)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act Expectation
+			act.WorkspaceID, act.Port, act.OK = tunnel.parseServerName(test.ServerName)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPortTunnel(t *testing.T) {
+	tcpEcho, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcpEcho.Close()
+	go func() {
+		for {
+			conn, err := tcpEcho.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	udpEcho, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udpEcho.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := udpEcho.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = udpEcho.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	tcpPort := uint32(tcpEcho.Addr().(*net.TCPAddr).Port)
+	udpPort := uint32(udpEcho.LocalAddr().(*net.UDPAddr).Port)
+	infoProvider := &fakeWsInfoProvider{infos: []common.WorkspaceInfo{
+		{
+			WorkspaceID: "amaranth-smelt-9ba20cc1",
+			IPAddress:   "127.0.0.1",
+			Auth:        &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_OWNER_ONLY},
+			Ports: []*api.PortSpec{
+				{Port: tcpPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+				{Port: udpPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_UDP},
+			},
+		},
+		{
+			WorkspaceID: "blue-whale-2b3a4c5d",
+			IPAddress:   "127.0.0.1",
+			Auth:        &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_OWNER_ONLY, OwnerToken: "owner-token"},
+			Ports: []*api.PortSpec{
+				{Port: tcpPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+				{Port: udpPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC, Protocol: api.PortProtocol_PORT_PROTOCOL_HTTP},
+			},
+		},
+		{
+			WorkspaceID:    "coral-dragon-ilr0r6eq",
+			IPAddress:      "127.0.0.1",
+			OrganizationID: "org-1",
+			Auth:           &api.WorkspaceAuthentication{Admission: api.AdmissionLevel_ADMIT_OWNER_ONLY, OwnerToken: "owner-token"},
+			Ports: []*api.PortSpec{
+				{Port: tcpPort, Visibility: api.PortVisibility_PORT_VISIBILITY_ORGANIZATION, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+			},
+		},
+	}}
+
+	key := generateSessionKey(t)
+	sessionToken := func(orgs ...string) string {
+		return signSessionToken(t, key, testSessionKeyID, &SessionClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testSessionIssuer,
+				Subject:   "user-id",
+				Audience:  jwt.ClaimStrings{SessionTokenAudience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Organizations: orgs,
+		})
+	}
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{testCertificate(t)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		_ = NewPortTunnel(config.GitpodInstallation.WorkspaceHostSuffix, infoProvider, newTestSessionVerifier(t, key), time.Second).Serve(l)
+	}()
+
+	dial := func(t *testing.T, workspaceID string, port uint32, token string) *tls.Conn {
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+			ServerName:         fmt.Sprintf("%d-%s%s", port, workspaceID, config.GitpodInstallation.WorkspaceHostSuffix),
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+		err = writeDatagram(conn, []byte(token))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+
+	echoTCP := func(t *testing.T, conn *tls.Conn) {
+		msg := []byte("hello tcp")
+		_, err := conn.Write(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = conn.CloseWrite()
+		if err != nil {
+			t.Fatal(err)
+		}
+		act, err := io.ReadAll(conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(msg, act) {
+			t.Errorf("unexpected response: %q", act)
+		}
+	}
+
+	t.Run("tcp", func(t *testing.T) {
+		conn := dial(t, "amaranth-smelt-9ba20cc1", tcpPort, "")
+		defer conn.Close()
+		echoTCP(t, conn)
+	})
+
+	t.Run("private port with owner token", func(t *testing.T) {
+		conn := dial(t, "blue-whale-2b3a4c5d", tcpPort, "owner-token")
+		defer conn.Close()
+		echoTCP(t, conn)
+	})
+
+	t.Run("organization port with session token of a member", func(t *testing.T) {
+		conn := dial(t, "coral-dragon-ilr0r6eq", tcpPort, sessionToken("org-1"))
+		defer conn.Close()
+		echoTCP(t, conn)
+	})
+
+	t.Run("udp", func(t *testing.T) {
+		conn := dial(t, "amaranth-smelt-9ba20cc1", udpPort, "")
+		defer conn.Close()
+
+		for _, msg := range []string{"hello", "udp"} {
+			err := writeDatagram(conn, []byte(msg))
+			if err != nil {
+				t.Fatal(err)
+			}
+			act, err := readDatagram(conn, make([]byte, tunnelMaxDatagramSize))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(act) != msg {
+				t.Errorf("unexpected response: %q", act)
+			}
+		}
+	})
+
+	for _, test := range []struct {
+		Name        string
+		WorkspaceID string
+		Port        uint32
+		Token       string
+	}{
+		{Name: "private port", WorkspaceID: "blue-whale-2b3a4c5d", Port: tcpPort},
+		{Name: "private port with wrong token", WorkspaceID: "blue-whale-2b3a4c5d", Port: tcpPort, Token: "not-the-owner-token"},
+		{Name: "http port", WorkspaceID: "blue-whale-2b3a4c5d", Port: udpPort, Token: "owner-token"},
+		{Name: "unknown workspace", WorkspaceID: "green-frog-1a2b3c4d", Port: tcpPort},
+		{Name: "organization port", WorkspaceID: "coral-dragon-ilr0r6eq", Port: tcpPort},
+		{Name: "organization port with session token of another organization", WorkspaceID: "coral-dragon-ilr0r6eq", Port: tcpPort, Token: sessionToken("org-2")},
+		{Name: "private port with session token", WorkspaceID: "blue-whale-2b3a4c5d", Port: tcpPort, Token: sessionToken("org-1")},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			conn := dial(t, test.WorkspaceID, test.Port, test.Token)
+			defer conn.Close()
+
+			_, err := conn.Read(make([]byte, 1))
+			if err != io.EOF {
+				t.Errorf("expected the tunnel to close the connection, got %v", err)
+			}
+		})
+	}
+}
+
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "*" + config.GitpodInstallation.WorkspaceHostSuffix},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
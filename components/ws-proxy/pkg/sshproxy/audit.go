@@ -0,0 +1,473 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// AuditEventType describes what an audit event records
+type AuditEventType string
+
+const (
+	// AuditConnect is recorded when a user connected to a workspace
+	AuditConnect AuditEventType = "connect"
+	// AuditDisconnect is recorded when a connection was closed
+	AuditDisconnect AuditEventType = "disconnect"
+	// AuditShell is recorded when a user started a shell
+	AuditShell AuditEventType = "shell"
+	// AuditExec is recorded when a user executed a command
+	AuditExec AuditEventType = "exec"
+	// AuditSubsystem is recorded when a user started a subsystem, e.g. sftp
+	AuditSubsystem AuditEventType = "subsystem"
+	// AuditPortForward is recorded when a user forwarded a local port to the workspace
+	AuditPortForward AuditEventType = "port-forward"
+	// AuditRemotePortForward is recorded when a user forwarded a workspace port to their machine
+	AuditRemotePortForward AuditEventType = "remote-port-forward"
+)
+
+// AuditEvent is a single record of the SSH gateway audit log
+type AuditEvent struct {
+	Time         time.Time      `json:"time"`
+	Type         AuditEventType `json:"type"`
+	ConnectionID string         `json:"connectionId"`
+	WorkspaceID  string         `json:"workspaceId"`
+	InstanceID   string         `json:"instanceId"`
+	OwnerID      string         `json:"ownerId"`
+	RemoteAddr   string         `json:"remoteAddr,omitempty"`
+
+	// AuthMethod is how the user authenticated, e.g. publickey or password
+	AuthMethod string `json:"authMethod,omitempty"`
+	// PublicKeyFingerprint is the SHA256 fingerprint of the key the user authenticated with
+	PublicKeyFingerprint string `json:"publicKeyFingerprint,omitempty"`
+
+	// Channel numbers the channels of a connection in the order they were opened
+	Channel int `json:"channel,omitempty"`
+	// Command is the command of exec events and the name of subsystem events
+	Command string `json:"command,omitempty"`
+	// Target is the forwarded address of port forwarding events
+	Target string `json:"target,omitempty"`
+	// Recording is the name of the session recording of shell events
+	Recording string `json:"recording,omitempty"`
+}
+
+// AuditSink persists audit events and session recordings
+type AuditSink interface {
+	// Write persists an audit event.
+	Write(ctx context.Context, evt *AuditEvent) error
+	// OpenRecording creates the recording of the shell session evt started.
+	OpenRecording(ctx context.Context, evt *AuditEvent) (io.WriteCloser, error)
+}
+
+// AuditConfig configures the SSH gateway audit log
+type AuditConfig struct {
+	// RecordSessions captures the input and output of shell sessions in asciicast v2 format. Note that
+	// the input contains everything users type, including passwords they enter at prompts.
+	RecordSessions bool `json:"recordSessions"`
+
+	// File writes the audit log and session recordings to a directory
+	File *FileAuditSinkConfig `json:"file,omitempty"`
+	// ContentService uploads the audit log and session recordings as blobs of an operator-owned owner
+	ContentService *ContentServiceAuditSinkConfig `json:"contentService,omitempty"`
+}
+
+// Validate validates the configuration.
+func (c *AuditConfig) Validate() error {
+	if (c.File == nil) == (c.ContentService == nil) {
+		return xerrors.Errorf("SSH gateway audit needs exactly one sink")
+	}
+	if c.File != nil && c.File.Path == "" {
+		return xerrors.Errorf("SSH gateway audit file sink needs a path")
+	}
+	if c.ContentService != nil && c.ContentService.Addr == "" {
+		return xerrors.Errorf("SSH gateway audit content-service sink needs an address")
+	}
+	if c.ContentService != nil && c.ContentService.OwnerID == "" {
+		return xerrors.Errorf("SSH gateway audit content-service sink needs an owner")
+	}
+	return nil
+}
+
+// auditQueueSize is the number of audit events which can wait to be written to the sink
+const auditQueueSize = 1024
+
+// Auditor records who connected to which workspace and what they did. Events are written to the sink in the
+// background, so that a slow sink cannot stall SSH sessions.
+type Auditor struct {
+	Sink           AuditSink
+	RecordSessions bool
+
+	once   sync.Once
+	mu     sync.RWMutex
+	closed bool
+	queue  chan queuedAuditEvent
+	done   chan struct{}
+}
+
+type queuedAuditEvent struct {
+	session *Session
+	evt     *AuditEvent
+}
+
+// NewAuditor creates an auditor using the sink configured in cfg.
+func NewAuditor(cfg *AuditConfig) (*Auditor, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var sink AuditSink
+	switch {
+	case cfg.File != nil:
+		sink, err = NewFileAuditSink(cfg.File)
+	case cfg.ContentService != nil:
+		sink, err = NewContentServiceAuditSink(cfg.ContentService)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Auditor{Sink: sink, RecordSessions: cfg.RecordSessions}, nil
+}
+
+// newEvent creates an event of the session's connection
+func newEvent(session *Session, evt AuditEvent) *AuditEvent {
+	evt.Time = time.Now().UTC()
+	evt.ConnectionID = session.ConnectionID
+	evt.WorkspaceID = session.WorkspaceID
+	evt.InstanceID = session.InstanceID
+	evt.OwnerID = session.OwnerUserId
+	return &evt
+}
+
+// record writes an event of the session's connection. Errors are logged, but don't interrupt the connection.
+func (a *Auditor) record(session *Session, evt AuditEvent) {
+	a.write(session, newEvent(session, evt))
+}
+
+// write queues an event for writing. If the sink is so slow that the queue is full, the event is dropped.
+func (a *Auditor) write(session *Session, evt *AuditEvent) {
+	a.once.Do(a.start)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		log.WithFields(log.OWI(session.OwnerUserId, session.WorkspaceID, session.InstanceID)).WithField("event", evt.Type).Error("cannot write SSH audit event after the audit log was closed")
+		return
+	}
+	select {
+	case a.queue <- queuedAuditEvent{session: session, evt: evt}:
+	default:
+		log.WithFields(log.OWI(session.OwnerUserId, session.WorkspaceID, session.InstanceID)).WithField("event", evt.Type).Error("SSH audit queue is full - dropping event")
+	}
+}
+
+func (a *Auditor) start() {
+	a.queue = make(chan queuedAuditEvent, auditQueueSize)
+	a.done = make(chan struct{})
+	go func() {
+		defer close(a.done)
+		for e := range a.queue {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			err := a.Sink.Write(ctx, e.evt)
+			cancel()
+			if err != nil {
+				log.WithFields(log.OWI(e.session.OwnerUserId, e.session.WorkspaceID, e.session.InstanceID)).WithError(err).WithField("event", e.evt.Type).Error("cannot write SSH audit event")
+			}
+		}
+	}()
+}
+
+// Close writes the queued events to the sink. Events recorded afterwards are dropped.
+func (a *Auditor) Close() {
+	a.once.Do(a.start)
+
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mu.Unlock()
+	<-a.done
+}
+
+// channelAudit audits the requests of a channel a client opened
+type channelAudit struct {
+	auditor *Auditor
+	session *Session
+	channel int
+
+	recorder *sessionRecorder
+}
+
+func (a *Auditor) newChannelAudit(session *Session, channelType string, extraData []byte) *channelAudit {
+	res := &channelAudit{
+		auditor: a,
+		session: session,
+		channel: session.nextChannel(),
+	}
+	switch channelType {
+	case "session":
+		if a.RecordSessions {
+			res.recorder = &sessionRecorder{width: 80, height: 24}
+		}
+	case "direct-tcpip":
+		var (
+			host string
+			port uint32
+		)
+		target := "unknown"
+		if err := unmarshalSSH(extraData, &host, &port); err == nil {
+			target = net.JoinHostPort(host, strconv.Itoa(int(port)))
+		}
+		a.record(session, AuditEvent{Type: AuditPortForward, Channel: res.channel, Target: target})
+	}
+	return res
+}
+
+// request audits a request of a session channel before it is forwarded to the workspace
+func (c *channelAudit) request(reqType string, payload []byte) {
+	switch reqType {
+	case "pty-req":
+		var (
+			term       string
+			cols, rows uint32
+		)
+		if err := unmarshalSSH(payload, &term, &cols, &rows); err == nil && c.recorder != nil {
+			c.recorder.setSize(term, cols, rows)
+		}
+	case "window-change":
+		var cols, rows uint32
+		if err := unmarshalSSH(payload, &cols, &rows); err == nil && c.recorder != nil {
+			c.recorder.resize(cols, rows)
+		}
+	case "exec":
+		var cmd string
+		_ = unmarshalSSH(payload, &cmd)
+		c.auditor.record(c.session, AuditEvent{Type: AuditExec, Channel: c.channel, Command: cmd})
+	case "subsystem":
+		var name string
+		_ = unmarshalSSH(payload, &name)
+		c.auditor.record(c.session, AuditEvent{Type: AuditSubsystem, Channel: c.channel, Command: name})
+	case "shell":
+		evt := newEvent(c.session, AuditEvent{Type: AuditShell, Channel: c.channel})
+		if c.recorder != nil {
+			evt.Recording = fmt.Sprintf("%s-%d.cast", c.session.ConnectionID, c.channel)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			w, err := c.auditor.Sink.OpenRecording(ctx, evt)
+			cancel()
+			if err != nil {
+				log.WithFields(log.OWI(c.session.OwnerUserId, c.session.WorkspaceID, c.session.InstanceID)).WithError(err).Error("cannot record SSH session")
+				evt.Recording = ""
+			} else {
+				c.recorder.start(w)
+			}
+		}
+		c.auditor.write(c.session, evt)
+	}
+}
+
+// input returns the writer the input of the channel should be written to
+func (c *channelAudit) input(w io.Writer) io.Writer {
+	if c == nil || c.recorder == nil {
+		return w
+	}
+	return io.MultiWriter(w, recorderInput{c.recorder})
+}
+
+// output returns the writer the output of the channel should be written to
+func (c *channelAudit) output(w io.Writer) io.Writer {
+	if c == nil || c.recorder == nil {
+		return w
+	}
+	return io.MultiWriter(w, c.recorder)
+}
+
+func (c *channelAudit) close() {
+	if c == nil || c.recorder == nil {
+		return
+	}
+	err := c.recorder.Close()
+	if err != nil {
+		log.WithFields(log.OWI(c.session.OwnerUserId, c.session.WorkspaceID, c.session.InstanceID)).WithError(err).Error("cannot store SSH session recording")
+	}
+}
+
+// globalRequest audits a global request of the client
+func (a *Auditor) globalRequest(session *Session, reqType string, payload []byte) {
+	if reqType != "tcpip-forward" {
+		return
+	}
+	var (
+		addr string
+		port uint32
+	)
+	target := "unknown"
+	if err := unmarshalSSH(payload, &addr, &port); err == nil {
+		target = net.JoinHostPort(addr, strconv.Itoa(int(port)))
+	}
+	a.record(session, AuditEvent{Type: AuditRemotePortForward, Target: target})
+}
+
+// unmarshalSSH decodes the strings and uint32 of an SSH message payload, see RFC 4254.
+func unmarshalSSH(payload []byte, fields ...interface{}) error {
+	for _, f := range fields {
+		switch v := f.(type) {
+		case *string:
+			if len(payload) < 4 {
+				return xerrors.Errorf("payload too short")
+			}
+			l := binary.BigEndian.Uint32(payload)
+			payload = payload[4:]
+			if uint32(len(payload)) < l {
+				return xerrors.Errorf("payload too short")
+			}
+			*v = string(payload[:l])
+			payload = payload[l:]
+		case *uint32:
+			if len(payload) < 4 {
+				return xerrors.Errorf("payload too short")
+			}
+			*v = binary.BigEndian.Uint32(payload)
+			payload = payload[4:]
+		default:
+			return xerrors.Errorf("unsupported field type %T", f)
+		}
+	}
+	return nil
+}
+
+// sessionRecorder writes the input and output of a shell session in asciicast v2 format, see
+// https://docs.asciinema.org/manual/asciicast/v2/. It discards everything until the shell was started.
+type sessionRecorder struct {
+	mu      sync.Mutex
+	w       io.WriteCloser
+	enc     *json.Encoder
+	started time.Time
+	term    string
+	width   uint32
+	height  uint32
+	// pending holds the beginning of a UTF-8 sequence which was split across writes, by event code
+	pending map[string][]byte
+}
+
+func (r *sessionRecorder) setSize(term string, cols, rows uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.term = term
+	if cols > 0 && rows > 0 {
+		r.width, r.height = cols, rows
+	}
+}
+
+func (r *sessionRecorder) start(w io.WriteCloser) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.w = w
+	r.enc = json.NewEncoder(w)
+	r.started = time.Now()
+	header := map[string]interface{}{
+		"version":   2,
+		"width":     r.width,
+		"height":    r.height,
+		"timestamp": r.started.Unix(),
+	}
+	if r.term != "" {
+		header["env"] = map[string]string{"TERM": r.term}
+	}
+	_ = r.enc.Encode(header)
+}
+
+func (r *sessionRecorder) resize(cols, rows uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.w == nil {
+		r.width, r.height = cols, rows
+		return
+	}
+	_ = r.enc.Encode([]interface{}{r.elapsed(), "r", fmt.Sprintf("%dx%d", cols, rows)})
+}
+
+// Write records output of the session. It never fails so that recording cannot interrupt the session.
+func (r *sessionRecorder) Write(p []byte) (int, error) {
+	r.record("o", p)
+	return len(p), nil
+}
+
+// recorderInput records the input of a session
+type recorderInput struct {
+	r *sessionRecorder
+}
+
+func (w recorderInput) Write(p []byte) (int, error) {
+	w.r.record("i", p)
+	return len(p), nil
+}
+
+func (r *sessionRecorder) record(code string, p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.w == nil || len(p) == 0 {
+		return
+	}
+	data := append(r.pending[code], p...)
+	delete(r.pending, code)
+	// hold back an incomplete UTF-8 sequence at the end until the next write
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		c := data[len(data)-i]
+		if !utf8.RuneStart(c) {
+			continue
+		}
+		if !utf8.FullRune(data[len(data)-i:]) {
+			if r.pending == nil {
+				r.pending = make(map[string][]byte)
+			}
+			r.pending[code] = append([]byte(nil), data[len(data)-i:]...)
+			data = data[:len(data)-i]
+		}
+		break
+	}
+	if len(data) > 0 {
+		_ = r.enc.Encode([]interface{}{r.elapsed(), code, string(data)})
+	}
+}
+
+func (r *sessionRecorder) elapsed() float64 {
+	return float64(time.Since(r.started).Microseconds()) / 1e6
+}
+
+func (r *sessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.w == nil {
+		return nil
+	}
+	for _, code := range []string{"i", "o"} {
+		if len(r.pending[code]) > 0 {
+			_ = r.enc.Encode([]interface{}{r.elapsed(), code, string(r.pending[code])})
+		}
+	}
+	r.pending = nil
+	w := r.w
+	r.w = nil
+	return w.Close()
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+	closed bool
+}
+
+func (w *nopWriteCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestSessionRecorder(t *testing.T) {
+	var (
+		buf bytes.Buffer
+		w   = &nopWriteCloser{Writer: &buf}
+		rec = &sessionRecorder{width: 80, height: 24}
+	)
+
+	_, _ = rec.Write([]byte("output before the shell started"))
+	rec.setSize("xterm-256color", 120, 40)
+	rec.start(w)
+	input := recorderInput{rec}
+	_, _ = input.Write([]byte("echo h"))
+	_, _ = rec.Write([]byte("$ echo "))
+	// "ä" is split across writes, while input arrives in between
+	_, _ = input.Write([]byte{0xc3})
+	_, _ = rec.Write([]byte{'h', 0xc3})
+	_, _ = input.Write([]byte{0xa4, '\r'})
+	_, _ = rec.Write([]byte{0xa4, '\n'})
+	rec.resize(100, 30)
+	err := rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = rec.Write([]byte("output after the session ended"))
+	if !w.closed {
+		t.Error("recording was not closed")
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	if !scanner.Scan() {
+		t.Fatal("recording has no header")
+	}
+	var header map[string]interface{}
+	err = json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header["version"] != 2.0 || header["width"] != 120.0 || header["height"] != 40.0 {
+		t.Errorf("unexpected header: %v", header)
+	}
+
+	var events [][]string
+	for scanner.Scan() {
+		var evt []interface{}
+		err = json.Unmarshal(scanner.Bytes(), &evt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, []string{evt[1].(string), evt[2].(string)})
+	}
+	expected := [][]string{
+		{"i", "echo h"},
+		{"o", "$ echo "},
+		{"o", "h"},
+		{"i", "ä\r"},
+		{"o", "ä\n"},
+		{"r", "100x30"},
+	}
+	if diff := cmp.Diff(expected, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalSSH(t *testing.T) {
+	var payload []byte
+	payload = binary.BigEndian.AppendUint32(payload, 9)
+	payload = append(payload, "localhost"...)
+	payload = binary.BigEndian.AppendUint32(payload, 3000)
+
+	var (
+		host string
+		port uint32
+	)
+	err := unmarshalSSH(payload, &host, &port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host != "localhost" || port != 3000 {
+		t.Errorf("unexpected values %s:%d", host, port)
+	}
+
+	err = unmarshalSSH(payload[:6], &host)
+	if err == nil {
+		t.Error("expected an error for a truncated payload")
+	}
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileAuditSink(&FileAuditSinkConfig{Path: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	session := &Session{ConnectionID: "conn-1", WorkspaceID: "ws-1", InstanceID: "inst-1", OwnerUserId: "user-1"}
+	auditor := &Auditor{Sink: sink, RecordSessions: true}
+	auditor.record(session, AuditEvent{Type: AuditConnect, AuthMethod: "publickey", PublicKeyFingerprint: "SHA256:abc"})
+
+	audit := auditor.newChannelAudit(session, "session", nil)
+	var exec []byte
+	exec = binary.BigEndian.AppendUint32(exec, 2)
+	exec = append(exec, "ls"...)
+	audit.request("exec", exec)
+	audit.request("shell", nil)
+	_, _ = audit.input(io.Discard).Write([]byte("exit\r"))
+	_, _ = audit.output(io.Discard).Write([]byte("hello"))
+	audit.close()
+	auditor.Close()
+
+	b, err := os.ReadFile(filepath.Join(dir, "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []AuditEvent
+	for _, line := range bytes.Split(bytes.TrimSpace(b), []byte("\n")) {
+		var evt AuditEvent
+		err = json.Unmarshal(line, &evt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if evt.ConnectionID != "conn-1" || evt.WorkspaceID != "ws-1" || evt.OwnerID != "user-1" || evt.Time.IsZero() {
+			t.Errorf("event is missing connection details: %+v", evt)
+		}
+		events = append(events, AuditEvent{Type: evt.Type, Channel: evt.Channel, Command: evt.Command, Recording: evt.Recording, PublicKeyFingerprint: evt.PublicKeyFingerprint})
+	}
+	expected := []AuditEvent{
+		{Type: AuditConnect, PublicKeyFingerprint: "SHA256:abc"},
+		{Type: AuditExec, Channel: 1, Command: "ls"},
+		{Type: AuditShell, Channel: 1, Recording: "conn-1-1.cast"},
+	}
+	if diff := cmp.Diff(expected, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+
+	recording, err := os.ReadFile(filepath.Join(dir, "recordings", "ws-1", "conn-1-1.cast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(recording, []byte(`"o","hello"`)) {
+		t.Errorf("recording does not contain the session output: %s", recording)
+	}
+	if !bytes.Contains(recording, []byte(`"i","exit\r"`)) {
+		t.Errorf("recording does not contain the session input: %s", recording)
+	}
+}
+
+func TestContentServiceAuditSink(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		uploaded = make(map[string][]byte)
+	)
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		uploaded[strings.TrimPrefix(r.URL.Path, "/")] = body
+		mu.Unlock()
+	}))
+	defer storage.Close()
+
+	blobs := &fakeBlobService{url: storage.URL}
+	sink := &ContentServiceAuditSink{client: blobs, http: storage.Client(), ownerID: "ssh-audit"}
+	auditor := &Auditor{Sink: sink}
+	session := &Session{ConnectionID: "conn-1", WorkspaceID: "ws-1", InstanceID: "inst-1", OwnerUserId: "user-1"}
+
+	auditor.record(session, AuditEvent{Type: AuditConnect, AuthMethod: "publickey"})
+	auditor.record(session, AuditEvent{Type: AuditDisconnect})
+	auditor.Close()
+	if len(uploaded) != 2 {
+		t.Fatalf("expected the events to be uploaded when the auditor is closed, got %d uploads", len(uploaded))
+	}
+
+	if diff := cmp.Diff([]string{"ssh-audit", "ssh-audit"}, blobs.owners); diff != "" {
+		t.Errorf("unexpected blob owners (-want +got):\n%s", diff)
+	}
+	for name, body := range uploaded {
+		if !strings.HasPrefix(name, "ssh-audit/user-1/ws-1/conn-1/") {
+			t.Errorf("unexpected blob name %s", name)
+		}
+		var evt AuditEvent
+		err := json.Unmarshal(body, &evt)
+		if err != nil || !strings.HasSuffix(name, "-"+string(evt.Type)+".json") {
+			t.Errorf("unexpected event %s in %s: %v", body, name, err)
+		}
+	}
+}
+
+func TestAuditorDoesNotBlock(t *testing.T) {
+	var (
+		sink    = &blockingAuditSink{release: make(chan struct{})}
+		auditor = &Auditor{Sink: sink}
+		session = &Session{ConnectionID: "conn-1", WorkspaceID: "ws-1", InstanceID: "inst-1", OwnerUserId: "user-1"}
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < auditQueueSize+10; i++ {
+			auditor.record(session, AuditEvent{Type: AuditExec})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("recording events blocks while the sink is slow")
+	}
+
+	close(sink.release)
+	auditor.Close()
+	if sink.written == 0 || sink.written > auditQueueSize+1 {
+		t.Errorf("unexpected number of written events %d", sink.written)
+	}
+}
+
+// blockingAuditSink writes events only once it's released
+type blockingAuditSink struct {
+	AuditSink
+
+	release chan struct{}
+	written int
+}
+
+func (s *blockingAuditSink) Write(ctx context.Context, evt *AuditEvent) error {
+	<-s.release
+	s.written++
+	return nil
+}
+
+// fakeBlobService signs upload URLs of a storage server and remembers which owner they were requested for
+type fakeBlobService struct {
+	csapi.BlobServiceClient
+
+	url    string
+	owners []string
+}
+
+func (s *fakeBlobService) UploadUrl(ctx context.Context, in *csapi.UploadUrlRequest, opts ...grpc.CallOption) (*csapi.UploadUrlResponse, error) {
+	s.owners = append(s.owners, in.OwnerId)
+	return &csapi.UploadUrlResponse{Url: s.url + "/" + in.Name}, nil
+}
+
+func TestAuditConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Cfg   AuditConfig
+		Valid bool
+	}{
+		{Name: "no sink", Cfg: AuditConfig{}},
+		{Name: "file", Cfg: AuditConfig{File: &FileAuditSinkConfig{Path: "/audit"}}, Valid: true},
+		{Name: "file without path", Cfg: AuditConfig{File: &FileAuditSinkConfig{}}},
+		{Name: "content-service", Cfg: AuditConfig{ContentService: &ContentServiceAuditSinkConfig{Addr: "content-service:8080", OwnerID: "ssh-audit"}}, Valid: true},
+		{Name: "content-service without owner", Cfg: AuditConfig{ContentService: &ContentServiceAuditSinkConfig{Addr: "content-service:8080"}}},
+		{Name: "two sinks", Cfg: AuditConfig{File: &FileAuditSinkConfig{Path: "/audit"}, ContentService: &ContentServiceAuditSinkConfig{Addr: "content-service:8080", OwnerID: "ssh-audit"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Cfg.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("expected valid=%v, got %v", test.Valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+const auditBlobPrefix = "ssh-audit"
+
+// FileAuditSinkConfig configures the file audit sink
+type FileAuditSinkConfig struct {
+	// Path is the directory the audit log and session recordings are written to
+	Path string `json:"path"`
+}
+
+// FileAuditSink appends audit events as JSON lines to audit.log and writes session recordings
+// to recordings/<workspaceID>/ in a directory.
+type FileAuditSink struct {
+	path string
+
+	mu  sync.Mutex
+	log *os.File
+}
+
+// NewFileAuditSink creates a new file sink.
+func NewFileAuditSink(cfg *FileAuditSinkConfig) (*FileAuditSink, error) {
+	err := os.MkdirAll(cfg.Path, 0750)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create SSH audit directory: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(cfg.Path, "audit.log"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot open SSH audit log: %w", err)
+	}
+	return &FileAuditSink{path: cfg.Path, log: f}, nil
+}
+
+// Write appends evt to the audit log.
+func (s *FileAuditSink) Write(ctx context.Context, evt *AuditEvent) error {
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.log.Write(append(b, '\n'))
+	return err
+}
+
+// OpenRecording creates the recording file.
+func (s *FileAuditSink) OpenRecording(ctx context.Context, evt *AuditEvent) (io.WriteCloser, error) {
+	dir := filepath.Join(s.path, "recordings", filepath.Base(evt.WorkspaceID))
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(filepath.Join(dir, filepath.Base(evt.Recording)), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0640)
+}
+
+// Close closes the audit log.
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.log.Close()
+}
+
+// ContentServiceAuditSinkConfig configures the content-service audit sink
+type ContentServiceAuditSinkConfig struct {
+	// Addr is the address of content-service's gRPC API
+	Addr string `json:"addr"`
+	// OwnerID owns the storage the audit log and recordings are uploaded to. It must not be the ID of a user,
+	// so that users can neither read nor delete the audit log of their workspaces.
+	OwnerID string `json:"ownerId"`
+}
+
+// ContentServiceAuditSink uploads audit events and session recordings as blobs of an operator-owned owner, named
+// ssh-audit/<userID>/<workspaceID>/<connectionID>/<time>-<type>.json and ssh-audit/<userID>/<workspaceID>/<recording>.
+// Each event is uploaded when it is written, recordings when the session ends.
+type ContentServiceAuditSink struct {
+	client  csapi.BlobServiceClient
+	http    *http.Client
+	ownerID string
+}
+
+// NewContentServiceAuditSink creates a new content-service sink.
+func NewContentServiceAuditSink(cfg *ContentServiceAuditSinkConfig) (*ContentServiceAuditSink, error) {
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot connect to content-service: %w", err)
+	}
+	return &ContentServiceAuditSink{
+		client:  csapi.NewBlobServiceClient(conn),
+		http:    &http.Client{Timeout: 5 * time.Minute},
+		ownerID: cfg.OwnerID,
+	}, nil
+}
+
+// Write uploads evt right away, so that no event is lost if ws-proxy goes away before the connection is closed.
+func (s *ContentServiceAuditSink) Write(ctx context.Context, evt *AuditEvent) error {
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s/%s/%s-%s.json", auditBlobDir(evt), evt.ConnectionID, evt.Time.UTC().Format(auditBlobTimeFormat), evt.Type)
+	return s.upload(ctx, name, "application/json", bytes.NewReader(b), int64(len(b)))
+}
+
+// OpenRecording spools the recording to a temporary file, which is uploaded when the recording is closed.
+func (s *ContentServiceAuditSink) OpenRecording(ctx context.Context, evt *AuditEvent) (io.WriteCloser, error) {
+	f, err := os.CreateTemp("", "ssh-recording-*.cast")
+	if err != nil {
+		return nil, err
+	}
+	return &spooledRecording{File: f, sink: s, name: auditBlobDir(evt) + "/" + evt.Recording}, nil
+}
+
+// auditBlobTimeFormat sorts the events of a connection by time and only uses characters valid in blob names
+const auditBlobTimeFormat = "20060102T150405.000000000Z"
+
+// auditBlobDir returns the directory of the blobs of evt's workspace
+func auditBlobDir(evt *AuditEvent) string {
+	return evt.OwnerID + "/" + evt.WorkspaceID
+}
+
+func (s *ContentServiceAuditSink) upload(ctx context.Context, name, contentType string, body io.Reader, size int64) error {
+	resp, err := s.client.UploadUrl(ctx, &csapi.UploadUrlRequest{
+		OwnerId:     s.ownerID,
+		Name:        auditBlobPrefix + "/" + name,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot get upload URL: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, resp.Url, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	res, err := s.http.Do(req)
+	if err != nil {
+		return xerrors.Errorf("cannot upload %s: %w", name, err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return xerrors.Errorf("cannot upload %s: unexpected status %d", name, res.StatusCode)
+	}
+	return nil
+}
+
+type spooledRecording struct {
+	*os.File
+
+	sink *ContentServiceAuditSink
+	name string
+}
+
+// Close uploads the recording and removes the temporary file.
+func (r *spooledRecording) Close() error {
+	defer os.Remove(r.File.Name())
+	defer r.File.Close()
+
+	size, err := r.File.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	_, err = r.File.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	return r.sink.upload(ctx, r.name, "application/x-asciicast", r.File, size)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"strings"
+)
+
+const (
+	fileTransferSFTP  = "sftp"
+	fileTransferSCP   = "scp"
+	fileTransferRsync = "rsync"
+)
+
+// fileTransferProtocol returns the file transfer protocol a session channel request starts, or an empty string
+// if the request does not start a file transfer. sftp runs as subsystem, while scp and rsync start their
+// server side through exec requests. Recent scp clients use sftp unless they are started with -O.
+func fileTransferProtocol(reqType string, payload []byte) string {
+	var cmd string
+	if err := unmarshalSSH(payload, &cmd); err != nil {
+		return ""
+	}
+	switch reqType {
+	case "subsystem":
+		if cmd == "sftp" {
+			return fileTransferSFTP
+		}
+	case "exec":
+		fields := strings.Fields(cmd)
+		if len(fields) < 2 {
+			return ""
+		}
+		switch fields[0] {
+		case "scp":
+			// -t starts the sink (upload) and -f the source (download) mode of scp
+			for _, f := range fields[1:] {
+				if f == "--" || !strings.HasPrefix(f, "-") {
+					break
+				}
+				if strings.ContainsAny(f[1:], "tf") {
+					return fileTransferSCP
+				}
+			}
+		case "rsync":
+			if fields[1] == "--server" {
+				return fileTransferRsync
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/gitpod-io/golang-crypto/ssh"
+	"github.com/pkg/sftp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestFileTransferProtocol(t *testing.T) {
+	tests := []struct {
+		Type     string
+		Command  string
+		Expected string
+	}{
+		{Type: "subsystem", Command: "sftp", Expected: fileTransferSFTP},
+		{Type: "subsystem", Command: "x11"},
+		{Type: "exec", Command: "scp -t /workspace/repo", Expected: fileTransferSCP},
+		{Type: "exec", Command: "scp -v -r -p -f -- file.txt", Expected: fileTransferSCP},
+		{Type: "exec", Command: "scp -pt .", Expected: fileTransferSCP},
+		{Type: "exec", Command: "scp file.txt other-host:", Expected: ""},
+		{Type: "exec", Command: "rsync --server -logDtprze.iLsfxCIvu . /workspace", Expected: fileTransferRsync},
+		{Type: "exec", Command: "rsync -a src dst"},
+		{Type: "exec", Command: "ls -t"},
+		{Type: "shell"},
+	}
+	for _, test := range tests {
+		t.Run(test.Type+" "+test.Command, func(t *testing.T) {
+			var payload []byte
+			if test.Type != "shell" {
+				payload = binary.BigEndian.AppendUint32(payload, uint32(len(test.Command)))
+				payload = append(payload, test.Command...)
+			}
+			if act := fileTransferProtocol(test.Type, payload); act != test.Expected {
+				t.Errorf("expected %q, got %q", test.Expected, act)
+			}
+		})
+	}
+}
+
+func TestSFTPThroughGateway(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "existing.txt"), []byte("hello"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := testutil.ToFloat64(SSHFileTransferTotal.WithLabelValues(fileTransferSFTP))
+
+	client := startFileTransferGateway(t, dir)
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sftpClient, err := sftp.NewClientPipe(stdout, stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sftpClient.Close()
+
+	// larger than the SSH channel window, so that flow control is exercised
+	upload := make([]byte, 5<<20)
+	_, _ = rand.Read(upload)
+	f, err := sftpClient.Create("upload.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.Write(upload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "upload.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != string(upload) {
+		t.Errorf("uploaded file is corrupt: %d bytes, expected %d", len(b), len(upload))
+	}
+
+	f, err = sftpClient.Open("existing.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err = io.ReadAll(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "hello" {
+		t.Errorf("unexpected content of downloaded file: %q", b)
+	}
+
+	entries, err := sftpClient.ReadDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected two files in the directory listing, got %d", len(entries))
+	}
+
+	if after := testutil.ToFloat64(SSHFileTransferTotal.WithLabelValues(fileTransferSFTP)); after != before+1 {
+		t.Errorf("sftp transfer was not counted")
+	}
+}
+
+func TestSCPThroughGateway(t *testing.T) {
+	scp, err := exec.LookPath("scp")
+	if err != nil {
+		t.Skip("scp is not installed")
+	}
+	dir := t.TempDir()
+	client := startFileTransferGateway(t, dir)
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = session.Start(scp + " -t .")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the client side of the legacy scp protocol, every message is acknowledged with a zero byte
+	ack := func() {
+		t.Helper()
+		b := make([]byte, 1)
+		_, err := io.ReadFull(stdout, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b[0] != 0 {
+			t.Fatalf("scp failed with status %d", b[0])
+		}
+	}
+	ack()
+	_, _ = io.WriteString(stdin, "C0644 5 copied.txt\n")
+	ack()
+	_, _ = io.WriteString(stdin, "hello\x00")
+	ack()
+	stdin.Close()
+	err = session.Wait()
+	if err != nil {
+		t.Fatalf("scp did not exit successfully: %v", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "copied.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "hello" {
+		t.Errorf("unexpected content of copied file: %q", b)
+	}
+}
+
+// startFileTransferGateway starts a workspace SSH server which serves sftp and runs commands in dir,
+// and a gateway which forwards the channels of its clients to it. It returns a client connected to the gateway.
+func startFileTransferGateway(t *testing.T, dir string) *ssh.Client {
+	workspaceAddr := serveSSH(t, func(conn net.Conn) {
+		_, chans, reqs, err := ssh.NewServerConn(conn, testServerConfig(t))
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(reqs)
+		for newChannel := range chans {
+			go serveWorkspaceSession(dir, newChannel)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	gateway := &Server{Heartbeater: &noHeartbeat{}}
+	gatewayAddr := serveSSH(t, func(conn net.Conn) {
+		clientConn, clientChans, clientReqs, err := ssh.NewServerConn(conn, testServerConfig(t))
+		if err != nil {
+			return
+		}
+		defer clientConn.Close()
+		workspaceConn, err := ssh.Dial("tcp", workspaceAddr, &ssh.ClientConfig{User: "gitpod", HostKeyCallback: ssh.InsecureIgnoreHostKey()})
+		if err != nil {
+			t.Errorf("cannot connect to workspace: %v", err)
+			return
+		}
+		defer workspaceConn.Close()
+
+		session := &Session{Conn: clientConn, WorkspaceID: "ws-1", InstanceID: "inst-1"}
+		go ssh.DiscardRequests(clientReqs)
+		for newChannel := range clientChans {
+			go gateway.ChannelForward(ctx, session, workspaceConn, newChannel)
+		}
+	})
+
+	client, err := ssh.Dial("tcp", gatewayAddr, &ssh.ClientConfig{User: "gitpod", HostKeyCallback: ssh.InsecureIgnoreHostKey()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func serveWorkspaceSession(dir string, newChannel ssh.NewChannel) {
+	if newChannel.ChannelType() != "session" {
+		_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+		return
+	}
+	channel, reqs, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+
+	var once sync.Once
+	exit := func(code uint32) {
+		once.Do(func() {
+			_, _ = channel.SendRequest("exit-status", false, binary.BigEndian.AppendUint32(nil, code))
+			_ = channel.Close()
+		})
+	}
+	for req := range reqs {
+		var cmd string
+		_ = unmarshalSSH(req.Payload, &cmd)
+		switch {
+		case req.Type == "subsystem" && cmd == "sftp":
+			_ = req.Reply(true, nil)
+			go func() {
+				server, err := sftp.NewServer(channel, sftp.WithServerWorkingDirectory(dir))
+				if err != nil {
+					exit(1)
+					return
+				}
+				_ = server.Serve()
+				exit(0)
+			}()
+		case req.Type == "exec":
+			_ = req.Reply(true, nil)
+			go func() {
+				c := exec.Command("sh", "-c", cmd)
+				c.Dir = dir
+				c.Stdin = channel
+				c.Stdout = channel
+				c.Stderr = channel.Stderr()
+				err := c.Run()
+				if err != nil {
+					exit(1)
+					return
+				}
+				exit(0)
+			}()
+		default:
+			_ = req.Reply(false, nil)
+		}
+	}
+}
+
+func serveSSH(t *testing.T, handle func(net.Conn)) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go handle(conn)
+		}
+	}()
+	return l.Addr().String()
+}
+
+func testServerConfig(t *testing.T) *ssh.ServerConfig {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &ssh.ServerConfig{NoClientAuth: true}
+	cfg.AddHostKey(signer)
+	return cfg
+}
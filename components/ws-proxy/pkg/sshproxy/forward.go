@@ -35,6 +35,13 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 	}
 	defer originChan.Close()
 
+	// only channels the client opens are audited, not the ones the workspace opens, e.g. for remote port forwarding
+	var audit *channelAudit
+	if s.Auditor != nil && targetConn != session.Conn {
+		audit = s.Auditor.newChannelAudit(session, originChannel.ChannelType(), originChannel.ExtraData())
+		defer audit.close()
+	}
+
 	maskedReqs := make(chan *ssh.Request, 1)
 
 	go func() {
@@ -47,6 +54,19 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 					channel.requestedPty = true
 					channel.mux.Unlock()
 				}
+			case "subsystem", "exec":
+				if protocol := fileTransferProtocol(req.Type, req.Payload); protocol != "" {
+					log.WithFields(log.OWI("", session.WorkspaceID, session.InstanceID)).Debugf("forwarding %s file transfer", protocol)
+					SSHFileTransferTotal.WithLabelValues(protocol).Inc()
+					if channel, ok := originChan.(*heartbeatingChannel); ok {
+						channel.mux.Lock()
+						channel.fileTransfer = true
+						channel.mux.Unlock()
+					}
+				}
+			}
+			if audit != nil {
+				audit.request(req.Type, req.Payload)
 			}
 			maskedReqs <- req
 		}
@@ -63,7 +83,7 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 
 	go func() {
 		defer wg.Done()
-		_, _ = io.Copy(targetChan, originChan)
+		_, _ = io.Copy(audit.input(targetChan), originChan)
 		_ = targetChan.CloseWrite()
 		targetChannelWg.Done()
 		targetChannelWg.Wait()
@@ -72,7 +92,7 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 
 	go func() {
 		defer wg.Done()
-		_, _ = io.Copy(originChan, targetChan)
+		_, _ = io.Copy(audit.output(originChan), targetChan)
 		_ = originChan.CloseWrite()
 		originChannelWg.Done()
 		originChannelWg.Wait()
@@ -85,7 +105,7 @@ func (s *Server) ChannelForward(ctx context.Context, session *Session, targetCon
 	}()
 
 	go func() {
-		_, _ = io.Copy(originChan.Stderr(), targetChan.Stderr())
+		_, _ = io.Copy(audit.output(originChan.Stderr()), targetChan.Stderr())
 		originChannelWg.Done()
 	}()
 
@@ -139,7 +159,8 @@ func startHeartbeatingChannel(c ssh.Channel, heartbeat Heartbeat, session *Sessi
 			select {
 			case <-res.t.C:
 				res.mux.Lock()
-				if !res.sawActivity || !res.requestedPty {
+				// interactive sessions and file transfers keep the workspace alive, other channels, e.g. of IDEs, send their own heartbeats
+				if !res.sawActivity || !(res.requestedPty || res.fileTransfer) {
 					res.mux.Unlock()
 					continue
 				}
@@ -170,6 +191,7 @@ type heartbeatingChannel struct {
 	cancel context.CancelFunc
 
 	requestedPty bool
+	fileTransfer bool
 }
 
 // Read reads up to len(data) bytes from the channel.
@@ -183,6 +205,20 @@ func (c *heartbeatingChannel) Read(data []byte) (written int, err error) {
 	return
 }
 
+// Write writes data to the channel. Unlike for interactive sessions the output of file transfers counts as activity,
+// because downloads hardly send any data to the workspace.
+func (c *heartbeatingChannel) Write(data []byte) (written int, err error) {
+	written, err = c.Channel.Write(data)
+	if err == nil && written != 0 {
+		c.mux.Lock()
+		if c.fileTransfer {
+			c.sawActivity = true
+		}
+		c.mux.Unlock()
+	}
+	return
+}
+
 func (c *heartbeatingChannel) Close() error {
 	c.t.Stop()
 	c.cancel()
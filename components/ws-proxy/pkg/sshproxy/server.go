@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/analytics"
@@ -25,6 +26,7 @@ import (
 	tracker "github.com/gitpod-io/gitpod/ws-proxy/pkg/analytics"
 	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
 	"github.com/gitpod-io/golang-crypto/ssh"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
@@ -55,6 +57,11 @@ var (
 		Name: "gitpod_ws_proxy_ssh_tunnel_closed_total",
 		Help: "Total number of SSH tunnels closed by the ws-proxy",
 	}, []string{"code"})
+
+	SSHFileTransferTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gitpod_ws_proxy_ssh_file_transfer_total",
+		Help: "Total number of file transfers through SSH by protocol",
+	}, []string{"protocol"})
 )
 
 var (
@@ -99,16 +106,27 @@ func NewSSHErrorWithReject(shortName string, description string) SSHError {
 type Session struct {
 	Conn *ssh.ServerConn
 
-	WorkspaceID string
-	InstanceID  string
-	OwnerUserId string
+	// ConnectionID identifies the connection in the audit log
+	ConnectionID string
+	WorkspaceID  string
+	InstanceID   string
+	OwnerUserId  string
 
 	PublicKey           ssh.PublicKey
 	WorkspacePrivateKey ssh.Signer
+
+	channels atomic.Int32
+}
+
+// nextChannel returns the number of the next channel the client opens
+func (s *Session) nextChannel() int {
+	return int(s.channels.Add(1))
 }
 
 type Server struct {
 	Heartbeater Heartbeat
+	// Auditor records connections and the channels they open. Connections are not audited if nil.
+	Auditor *Auditor
 
 	HostKeys              []ssh.Signer
 	sshConfig             *ssh.ServerConfig
@@ -122,6 +140,7 @@ func init() {
 		SSHAttemptTotal,
 		SSHTunnelClosedTotal,
 		SSHTunnelOpenedTotal,
+		SSHFileTransferTotal,
 	)
 }
 
@@ -157,6 +176,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			Extensions: map[string]string{
 				"workspaceId":    workspaceId,
 				"debugWorkspace": info[common.DebugWorkspaceIdentifier],
+				"authMethod":     "websocket",
 			},
 		}, nil
 	}
@@ -191,6 +211,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 				Extensions: map[string]string{
 					"workspaceId":    workspaceId,
 					"debugWorkspace": debugWorkspace,
+					"authMethod":     "owner-token",
 				},
 			}, nil
 		},
@@ -215,6 +236,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 				Extensions: map[string]string{
 					"workspaceId":    workspaceId,
 					"debugWorkspace": debugWorkspace,
+					"authMethod":     "password",
 				},
 			}, nil
 		},
@@ -243,8 +265,10 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			}
 			return &ssh.Permissions{
 				Extensions: map[string]string{
-					"workspaceId":    workspaceId,
-					"debugWorkspace": debugWorkspace,
+					"workspaceId":          workspaceId,
+					"debugWorkspace":       debugWorkspace,
+					"authMethod":           "publickey",
+					"publicKeyFingerprint": ssh.FingerprintSHA256(pk),
 				},
 			}, nil
 		},
@@ -403,8 +427,22 @@ func (s *Server) HandleConn(c net.Conn) {
 	SSHConnectionCount.Inc()
 	ReportSSHAttemptMetrics(nil)
 
+	if s.Auditor != nil {
+		session.ConnectionID = uuid.NewString()
+		s.Auditor.record(session, AuditEvent{
+			Type:                 AuditConnect,
+			RemoteAddr:           clientConn.RemoteAddr().String(),
+			AuthMethod:           clientConn.Permissions.Extensions["authMethod"],
+			PublicKeyFingerprint: clientConn.Permissions.Extensions["publicKeyFingerprint"],
+		})
+		defer s.Auditor.record(session, AuditEvent{Type: AuditDisconnect})
+	}
+
 	forwardRequests := func(reqs <-chan *ssh.Request, targetConn ssh.Conn) {
 		for req := range reqs {
+			if s.Auditor != nil && targetConn == workspaceConn {
+				s.Auditor.globalRequest(session, req.Type, req.Payload)
+			}
 			result, payload, err := targetConn.SendRequest(req.Type, req.WantReply, req.Payload)
 			if err != nil {
 				continue
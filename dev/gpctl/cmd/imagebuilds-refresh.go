@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	builder "github.com/gitpod-io/gitpod/image-builder/api"
+)
+
+// imagebuildsRefreshCmd represents the refresh command
+var imagebuildsRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Re-resolves the base images of recently built workspace images and rebuilds the stale ones",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		conn, client, err := getImagebuildsClient(ctx)
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		resp, err := client.RefreshImages(ctx, &builder.RefreshImagesRequest{DryRun: dryRun})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		tpl := `REF	STALE REASON	REBUILDING
+{{- range .Images }}
+{{ .Ref }}	{{ .StaleReason }}	{{ .Rebuilding }}
+{{ end }}
+`
+		getOutputFormat(tpl, "{..ref}").Print(resp)
+	},
+}
+
+func init() {
+	imagebuildsCmd.AddCommand(imagebuildsRefreshCmd)
+	imagebuildsRefreshCmd.Flags().Bool("dry-run", false, "only list stale images without rebuilding them")
+}
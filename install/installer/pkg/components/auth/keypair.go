@@ -59,6 +59,20 @@ func keypair(ctx *common.RenderContext) ([]runtime.Object, error) {
 	}, nil
 }
 
+// pkiKey is a key pair of the auth PKI, stored in a TLS secret
+type pkiKey struct {
+	ID     string
+	Secret string
+}
+
+var (
+	// signingKey is always used to issue new auth tokens
+	signingKey = pkiKey{ID: "0001", Secret: common.AuthPKISecretName}
+
+	// validatingKeys are only used to check the validity of tokens, e.g. those issued before the signing key was rotated
+	validatingKeys []pkiKey
+)
+
 func getPKI() ([]corev1.Volume, []corev1.VolumeMount, PKIConfig) {
 	dir := "/secrets/auth-pki"
 	signingDir := path.Join(dir, "signing")
@@ -68,7 +82,7 @@ func getPKI() ([]corev1.Volume, []corev1.VolumeMount, PKIConfig) {
 			Name: "auth-pki-signing",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: common.AuthPKISecretName,
+					SecretName: signingKey.Secret,
 				},
 			},
 		},
@@ -84,14 +98,71 @@ func getPKI() ([]corev1.Volume, []corev1.VolumeMount, PKIConfig) {
 
 	cfg := PKIConfig{
 		Signing: KeyPair{
-			ID:             "0001",
+			ID:             signingKey.ID,
 			PrivateKeyPath: path.Join(signingDir, "tls.key"),
 			PublicKeyPath:  path.Join(signingDir, "tls.crt"),
 		},
 	}
+
+	for _, key := range validatingKeys {
+		name := fmt.Sprintf("auth-pki-validating-%s", key.ID)
+		validatingDir := path.Join(dir, "validating", key.ID)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: key.Secret,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: validatingDir,
+			ReadOnly:  true,
+		})
+		cfg.Validating = append(cfg.Validating, KeyPair{
+			ID:             key.ID,
+			PrivateKeyPath: path.Join(validatingDir, "tls.key"),
+			PublicKeyPath:  path.Join(validatingDir, "tls.crt"),
+		})
+	}
 	return volumes, mounts, cfg
 }
 
+// GetPublicKeys returns the volumes and mounts which provide the public keys of the auth PKI below dir, for components
+// which only verify tokens. The keys include the signing key and all validating keys, so that verifiers keep accepting
+// tokens while the signing key is rotated.
+func GetPublicKeys(dir string) ([]corev1.Volume, []corev1.VolumeMount, []KeyPair) {
+	var (
+		volumes []corev1.Volume
+		mounts  []corev1.VolumeMount
+		keys    []KeyPair
+	)
+	for _, key := range append([]pkiKey{signingKey}, validatingKeys...) {
+		name := fmt.Sprintf("auth-pki-%s", key.ID)
+		keyDir := path.Join(dir, key.ID)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: key.Secret,
+					Items:      []corev1.KeyToPath{{Key: "tls.crt", Path: "tls.crt"}},
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: keyDir,
+			ReadOnly:  true,
+		})
+		keys = append(keys, KeyPair{
+			ID:            key.ID,
+			PublicKeyPath: path.Join(keyDir, "tls.crt"),
+		})
+	}
+	return volumes, mounts, keys
+}
+
 type PKIConfig struct {
 	// Signing KeyPair is always used to issue new auth tokens
 	Signing KeyPair `json:"signing"`
@@ -50,6 +50,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil
 	})
 
+	var portShareLinks bool
+	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
+		portShareLinks = cfg.Workspace != nil && cfg.Workspace.WSProxy.PortShareLinks
+		return nil
+	})
+
 	_, _, databaseSecretMountPath := common.DatabaseEnvSecret(ctx.Config)
 
 	_, _, authCfg := auth.GetConfig(ctx)
@@ -86,6 +92,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				},
 			},
 		},
+		PortShareLinks: portShareLinks,
 		Server: &baseserver.Configuration{
 			Services: baseserver.ServicesConfiguration{
 				GRPC: &baseserver.ServerConfiguration{
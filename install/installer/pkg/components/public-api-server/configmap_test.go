@@ -5,6 +5,7 @@
 package public_api_server
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -104,3 +105,20 @@ func TestConfigMap(t *testing.T) {
 		t.Errorf("configMap mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestConfigMap_PortShareLinks(t *testing.T) {
+	ctx := renderContextWithPublicAPI(t)
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		ucfg.Workspace = &experimental.WorkspaceConfig{}
+		ucfg.Workspace.WSProxy.PortShareLinks = true
+		return nil
+	})
+
+	objs, err := configmap(ctx)
+	require.NoError(t, err)
+
+	var cfg config.Configuration
+	err = json.Unmarshal([]byte(objs[0].(*corev1.ConfigMap).Data[configJSONFilename]), &cfg)
+	require.NoError(t, err)
+	require.True(t, cfg.PortShareLinks, "port share links must be enabled")
+}
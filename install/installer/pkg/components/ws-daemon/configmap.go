@@ -59,6 +59,10 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		Tier2:   0,
 	}
 
+	resourcePressureConfig := cgroup.ResourcePressureConfig{
+		Enabled: false,
+	}
+
 	runtimeMapping := make(map[string]string)
 	// default runtime mapping
 	runtimeMapping[ctx.Config.Workspace.Runtime.ContainerDRuntimeDir] = "/mnt/node0"
@@ -68,6 +72,11 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	// default workspace network CIDR (and fallback)
 	workspaceCIDR := "10.0.5.0/30"
 
+	var (
+		chunkedBackups         bool
+		maxSnapshotChainLength int
+	)
+
 	ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
 			return nil
@@ -87,11 +96,18 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		networkLimitConfig.Enforce = ucfg.Workspace.NetworkLimits.Enforce
 		networkLimitConfig.ConnectionsPerMinute = ucfg.Workspace.NetworkLimits.ConnectionsPerMinute
 		networkLimitConfig.BucketSize = ucfg.Workspace.NetworkLimits.BucketSize
+		if ucfg.Workspace.NetworkLimits.Egress != nil {
+			networkLimitConfig.Egress = *ucfg.Workspace.NetworkLimits.Egress
+		}
 
 		oomScoreAdjConfig.Enabled = ucfg.Workspace.OOMScores.Enabled
 		oomScoreAdjConfig.Tier1 = ucfg.Workspace.OOMScores.Tier1
 		oomScoreAdjConfig.Tier2 = ucfg.Workspace.OOMScores.Tier2
 
+		if ucfg.Workspace.ResourcePressure != nil {
+			resourcePressureConfig = *ucfg.Workspace.ResourcePressure
+		}
+
 		if len(ucfg.Workspace.WSDaemon.Runtime.NodeToContainerMapping) > 0 {
 			// reset map
 			runtimeMapping = make(map[string]string)
@@ -102,6 +118,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 		procLimit = ucfg.Workspace.ProcLimit
 
+		chunkedBackups = ucfg.Workspace.WSDaemon.ChunkedBackups
+		maxSnapshotChainLength = ucfg.Workspace.WSDaemon.MaxSnapshotChainLength
+
 		wscontroller.MaxConcurrentReconciles = 15
 
 		if ucfg.Workspace.WorkspaceCIDR != "" {
@@ -138,8 +157,10 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				},
 				Storage: common.StorageConfig(ctx),
 				Backup: content.BackupConfig{
-					Timeout:  util.Duration(time.Minute * 5),
-					Attempts: 3,
+					Timeout:                util.Duration(time.Minute * 5),
+					Attempts:               3,
+					Chunked:                chunkedBackups,
+					MaxSnapshotChainLength: maxSnapshotChainLength,
 				},
 				Initializer: content.InitializerConfig{
 					Command: "/app/content-initializer",
@@ -156,11 +177,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Size:  70000,
 				}},
 			},
-			CPULimit:  cpuLimitConfig,
-			IOLimit:   ioLimitConfig,
-			ProcLimit: procLimit,
-			NetLimit:  networkLimitConfig,
-			OOMScores: oomScoreAdjConfig,
+			CPULimit:         cpuLimitConfig,
+			IOLimit:          ioLimitConfig,
+			ProcLimit:        procLimit,
+			NetLimit:         networkLimitConfig,
+			OOMScores:        oomScoreAdjConfig,
+			ResourcePressure: resourcePressureConfig,
 			DiskSpaceGuard: diskguard.Config{
 				Enabled:  true,
 				Interval: util.Duration(5 * time.Minute),
@@ -85,6 +85,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	hostWorkingArea := wsdaemon.HostWorkingAreaMk2
 
 	rateLimits := map[string]grpc.RateLimit{}
+	var resourcePressureEviction *config.ResourcePressureEvictionConfiguration
 
 	err = ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
@@ -117,10 +118,17 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 						Memory:           c.Resources.Limits.Memory,
 						EphemeralStorage: c.Resources.Limits.EphemeralStorage,
 						Storage:          c.Resources.Limits.Storage,
+						MaxStorage:       c.Resources.Limits.MaxStorage,
 					},
 				},
 				Templates: tplsCfg,
 			}
+			if bw := c.Resources.Limits.Bandwidth; bw != nil {
+				classes[k].Container.Limits.Bandwidth = &config.BandwidthLimit{
+					Ingress: bw.Ingress,
+					Egress:  bw.Egress,
+				}
+			}
 			for tmpl_n, tmpl_v := range ctpls {
 				if _, ok := tpls[tmpl_n]; ok {
 					return fmt.Errorf("duplicate workspace template %q in workspace class %q", tmpl_n, k)
@@ -151,6 +159,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			workspacePortURLTemplate = ucfg.Workspace.WorkspacePortURLTemplate
 		}
 		rateLimits = ucfg.Workspace.WSManagerRateLimits
+		resourcePressureEviction = ucfg.Workspace.ResourcePressureEviction
 
 		return nil
 	})
@@ -223,6 +232,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			RegistryFacadeHost:               fmt.Sprintf("reg.%s:%d", ctx.Config.Domain, common.RegistryFacadeServicePort),
 			WorkspaceMaxConcurrentReconciles: 25,
 			TimeoutMaxConcurrentReconciles:   15,
+			ResourcePressureEviction:         resourcePressureEviction,
 		},
 		Content: struct {
 			Storage storageconfig.StorageConfig `json:"storage"`
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gitpod-io/gitpod/installer/pkg/components/auth"
+	contentservice "github.com/gitpod-io/gitpod/installer/pkg/components/content-service"
 	"github.com/gitpod-io/gitpod/installer/pkg/components/workspace"
 	wsmanagermk2 "github.com/gitpod-io/gitpod/installer/pkg/components/ws-manager-mk2"
 	configv1 "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
@@ -18,6 +20,7 @@ import (
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	"github.com/gitpod-io/gitpod/ws-proxy/pkg/config"
 	"github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/sshproxy"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -128,6 +131,37 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		wspcfg.Proxy.SSHGatewayCAKeyFile = "/mnt/ca-key/ca.key"
 	}
 
+	if portTunnelEnabled(ctx) {
+		wspcfg.Ingress.TunnelAddress = fmt.Sprintf("0.0.0.0:%d", PortTunnelPort)
+	}
+
+	if sessionTokensEnabled(ctx) {
+		_, _, keys := auth.GetPublicKeys(authPKIDir)
+		wspcfg.Proxy.WorkspaceSession = &proxy.SessionTokenConfig{
+			Issuer: fmt.Sprintf("https://%s", ctx.Config.Domain),
+		}
+		for _, key := range keys {
+			wspcfg.Proxy.WorkspaceSession.Keys = append(wspcfg.Proxy.WorkspaceSession.Keys, proxy.SessionTokenKey{
+				ID:            key.ID,
+				PublicKeyPath: key.PublicKeyPath,
+			})
+		}
+	}
+
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSProxy.SSHGatewayAudit == nil {
+			return nil
+		}
+		wspcfg.Proxy.SSHGatewayAudit = &sshproxy.AuditConfig{
+			RecordSessions: ucfg.Workspace.WSProxy.SSHGatewayAudit.RecordSessions,
+			ContentService: &sshproxy.ContentServiceAuditSinkConfig{
+				Addr:    common.ClusterAddress(contentservice.Component, ctx.Namespace, contentservice.RPCPort),
+				OwnerID: sshAuditOwnerID,
+			},
+		}
+		return nil
+	})
+
 	fc, err := common.ToJSONString(wspcfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal ws-proxy config: %w", err)
@@ -148,3 +182,23 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 	}, nil
 }
+
+// portTunnelEnabled returns true if ws-proxy forwards ports exposed as tcp or udp through the port tunnel
+func portTunnelEnabled(ctx *common.RenderContext) bool {
+	var enabled bool
+	ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		enabled = ucfg.Workspace != nil && ucfg.Workspace.WSProxy.PortTunnel
+		return nil
+	})
+	return enabled
+}
+
+// sessionTokensEnabled returns true if ws-proxy verifies workspace session or port share tokens
+func sessionTokensEnabled(ctx *common.RenderContext) bool {
+	var enabled bool
+	ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		enabled = ucfg.Workspace != nil && (ucfg.Workspace.WSProxy.OrganizationPorts || ucfg.Workspace.WSProxy.PortShareLinks)
+		return nil
+	})
+	return enabled
+}
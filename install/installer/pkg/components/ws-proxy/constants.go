@@ -20,5 +20,12 @@ const (
 	SSHServicePort       = 22
 	SSHTargetPort        = 2200
 	SSHPortName          = "ssh"
+	PortTunnelPort       = 9443
+	PortTunnelPortName   = "port-tunnel"
 	ReadinessPort        = 8086
+
+	// authPKIDir is where the public keys of the auth PKI are mounted
+	authPKIDir = "/mnt/auth-pki"
+	// sshAuditOwnerID owns the storage the SSH gateway audit log is uploaded to, which is not a user's
+	sshAuditOwnerID = "ssh-audit"
 )
@@ -8,6 +8,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/installer/pkg/cluster"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/components/auth"
 
 	wsmanagermk2 "github.com/gitpod-io/gitpod/installer/pkg/components/ws-manager-mk2"
 
@@ -61,6 +62,13 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		})
 	}
 
+	if sessionTokensEnabled(ctx) {
+		// ws-proxy only needs the public keys to verify workspace session and port share tokens
+		authVolumes, authMounts, _ := auth.GetPublicKeys(authPKIDir)
+		volumes = append(volumes, authVolumes...)
+		volumeMounts = append(volumeMounts, authMounts...)
+	}
+
 	if ctx.Config.SSHGatewayCAKey != nil {
 		volumes = append(volumes, corev1.Volume{
 			Name: "ca-key",
@@ -80,6 +88,26 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		})
 	}
 
+	ports := []corev1.ContainerPort{{
+		Name:          HTTPProxyPortName,
+		ContainerPort: HTTPProxyPort,
+	}, {
+		Name:          HTTPSProxyPortName,
+		ContainerPort: HTTPSProxyPort,
+	}, {
+		Name:          baseserver.BuiltinMetricsPortName,
+		ContainerPort: baseserver.BuiltinMetricsPort,
+	}, {
+		Name:          SSHPortName,
+		ContainerPort: SSHServicePort,
+	}}
+	if portTunnelEnabled(ctx) {
+		ports = append(ports, corev1.ContainerPort{
+			Name:          PortTunnelPortName,
+			ContainerPort: PortTunnelPort,
+		})
+	}
+
 	podSpec := corev1.PodSpec{
 		PriorityClassName:         common.SystemNodeCritical,
 		Affinity:                  cluster.WithNodeAffinityHostnameAntiAffinity(Component, cluster.AffinityLabelServices),
@@ -120,19 +148,7 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 					"memory": resource.MustParse("32Mi"),
 				},
 			}),
-			Ports: []corev1.ContainerPort{{
-				Name:          HTTPProxyPortName,
-				ContainerPort: HTTPProxyPort,
-			}, {
-				Name:          HTTPSProxyPortName,
-				ContainerPort: HTTPSProxyPort,
-			}, {
-				Name:          baseserver.BuiltinMetricsPortName,
-				ContainerPort: baseserver.BuiltinMetricsPort,
-			}, {
-				Name:          SSHPortName,
-				ContainerPort: SSHServicePort,
-			}},
+			Ports: ports,
 			SecurityContext: &corev1.SecurityContext{
 				Privileged:               pointer.Bool(false),
 				AllowPrivilegeEscalation: pointer.Bool(false),
@@ -16,6 +16,25 @@ import (
 func networkpolicy(ctx *common.RenderContext) ([]runtime.Object, error) {
 	labels := common.DefaultLabels(Component)
 
+	ports := []networkingv1.NetworkPolicyPort{
+		{
+			Protocol: common.TCPProtocol,
+			Port:     &intstr.IntOrString{IntVal: HTTPProxyPort},
+		}, {
+			Protocol: common.TCPProtocol,
+			Port:     &intstr.IntOrString{IntVal: HTTPSProxyPort},
+		}, {
+			Protocol: common.TCPProtocol,
+			Port:     &intstr.IntOrString{IntVal: SSHTargetPort},
+		},
+	}
+	if portTunnelEnabled(ctx) {
+		ports = append(ports, networkingv1.NetworkPolicyPort{
+			Protocol: common.TCPProtocol,
+			Port:     &intstr.IntOrString{IntVal: PortTunnelPort},
+		})
+	}
+
 	return []runtime.Object{&networkingv1.NetworkPolicy{
 		TypeMeta: common.TypeMetaNetworkPolicy,
 		ObjectMeta: metav1.ObjectMeta{
@@ -27,18 +46,7 @@ func networkpolicy(ctx *common.RenderContext) ([]runtime.Object, error) {
 			PodSelector: metav1.LabelSelector{MatchLabels: labels},
 			PolicyTypes: []networkingv1.PolicyType{"Ingress"},
 			Ingress: []networkingv1.NetworkPolicyIngressRule{{
-				Ports: []networkingv1.NetworkPolicyPort{
-					{
-						Protocol: common.TCPProtocol,
-						Port:     &intstr.IntOrString{IntVal: HTTPProxyPort},
-					}, {
-						Protocol: common.TCPProtocol,
-						Port:     &intstr.IntOrString{IntVal: HTTPSProxyPort},
-					}, {
-						Protocol: common.TCPProtocol,
-						Port:     &intstr.IntOrString{IntVal: SSHTargetPort},
-					},
-				},
+				Ports: ports,
 			}},
 		},
 	}}, nil
@@ -40,6 +40,13 @@ var Objects = common.CompositeRenderFunc(
 				ServicePort:   SSHServicePort,
 			},
 		}
+		if portTunnelEnabled(cfg) {
+			ports = append(ports, common.ServicePort{
+				Name:          PortTunnelPortName,
+				ContainerPort: PortTunnelPort,
+				ServicePort:   PortTunnelPort,
+			})
+		}
 		return common.GenerateService(Component, ports)(cfg)
 	},
 	common.DefaultServiceAccount(Component),
@@ -16,7 +16,10 @@ import (
 	agentSmith "github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/common-go/grpc"
 	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
+	wsmancfg "github.com/gitpod-io/gitpod/ws-manager/api/config"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -75,12 +78,18 @@ type WorkspaceConfig struct {
 		Enforce              bool  `json:"enforce"`
 		ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 		BucketSize           int64 `json:"bucketSize"`
+		// Egress configures per-workspace egress policies, selected by organization or workspace class
+		Egress *netlimit.EgressConfig `json:"egress,omitempty"`
 	} `json:"networkLimits"`
 	OOMScores struct {
 		Enabled bool `json:"enabled"`
 		Tier1   int  `json:"tier1"`
 		Tier2   int  `json:"tier2"`
 	} `json:"oomScores"`
+	// ResourcePressure configures when ws-daemon considers a workspace to be under memory or IO pressure
+	ResourcePressure *cgroup.ResourcePressureConfig `json:"resourcePressure,omitempty"`
+	// ResourcePressureEviction lets ws-manager evict the workspace under the highest memory pressure on a node
+	ResourcePressureEviction *wsmancfg.ResourcePressureEvictionConfiguration `json:"resourcePressureEviction,omitempty"`
 
 	ProcLimit int64 `json:"procLimit"`
 
@@ -105,6 +114,8 @@ type WorkspaceConfig struct {
 		Runtime struct {
 			NodeToContainerMapping []NodeToContainerMappingValues `json:"nodeToContainerMapping"`
 		} `json:"runtime"`
+		ChunkedBackups         bool `json:"chunkedBackups"`
+		MaxSnapshotChainLength int  `json:"maxSnapshotChainLength"`
 	} `json:"wsDaemon"`
 
 	WorkspaceClasses        map[string]WorkspaceClass `json:"classes,omitempty"`
@@ -116,6 +127,15 @@ type WorkspaceConfig struct {
 		GitpodInstallationHostName                 string `json:"gitpodInstallationHostName"`
 		GitpodInstallationWorkspaceHostSuffix      string `json:"gitpodInstallationWorkspaceHostSuffix"`
 		GitpodInstallationWorkspaceHostSuffixRegex string `json:"gitpodInstallationWorkspaceHostSuffixRegex"`
+		// OrganizationPorts enables ports with organization visibility, which ws-proxy authenticates using
+		// workspace session tokens signed with the auth PKI.
+		OrganizationPorts bool `json:"organizationPorts"`
+		// PortShareLinks enables share links for ports, which ws-proxy verifies using the auth PKI.
+		PortShareLinks bool `json:"portShareLinks"`
+		// PortTunnel enables the port tunnel which forwards ports exposed as tcp or udp
+		PortTunnel bool `json:"portTunnel"`
+		// SSHGatewayAudit enables the audit log of the SSH gateway, which is uploaded to content-service
+		SSHGatewayAudit *SSHGatewayAuditConfig `json:"sshGatewayAudit,omitempty"`
 	} `json:"wsProxy"`
 
 	ContentService struct {
@@ -131,6 +151,11 @@ type WorkspaceConfig struct {
 	} `json:"imageBuilderMk3"`
 }
 
+type SSHGatewayAuditConfig struct {
+	// RecordSessions captures the input and output of shell sessions in asciicast format
+	RecordSessions bool `json:"recordSessions"`
+}
+
 type WorkspaceClass struct {
 	Name        string             `json:"name" validate:"required"`
 	Description string             `json:"description"`
@@ -144,10 +169,19 @@ type WorkspaceResources struct {
 }
 
 type WorkspaceLimits struct {
-	Cpu              WorkspaceCpuLimits `json:"cpu"`
-	Memory           string             `json:"memory"`
-	Storage          string             `json:"storage"`
-	EphemeralStorage string             `json:"ephemeral-storage"`
+	Cpu              WorkspaceCpuLimits        `json:"cpu"`
+	Memory           string                    `json:"memory"`
+	Storage          string                    `json:"storage"`
+	EphemeralStorage string                    `json:"ephemeral-storage"`
+	Bandwidth        *WorkspaceBandwidthLimits `json:"bandwidth,omitempty"`
+	// MaxStorage is the size the disk of a running workspace can be resized to, e.g. 100Gi
+	MaxStorage string `json:"maxStorage,omitempty"`
+}
+
+// WorkspaceBandwidthLimits are quantities in bytes per second, e.g. 50Mi
+type WorkspaceBandwidthLimits struct {
+	Ingress string `json:"ingress,omitempty"`
+	Egress  string `json:"egress,omitempty"`
 }
 
 type WorkspaceCpuLimits struct {